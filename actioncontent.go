@@ -0,0 +1,148 @@
+package birdactyl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	DefaultMaxStreamedFileSize = 1 << 30
+
+	capStreamedContent = "addon.stream_content"
+	actionChunkSize    = 256 << 10
+)
+
+type contentStream struct {
+	index int32
+	path  string
+	r     io.Reader
+}
+
+func WithMaxStreamedFileSize(n int64) Option {
+	return func(p *Plugin) {
+		p.streamedLimit = n
+	}
+}
+
+func WriteFileFromReader(path string, r io.Reader) AddonInstallAction {
+	return AddonInstallAction{Type: ActionWriteFile, Path: path, reader: r}
+}
+
+func (a AddonInstallAction) ContentReader() io.Reader {
+	if a.reader != nil {
+		return a.reader
+	}
+	return bytes.NewReader(a.Content)
+}
+
+func knownSize(r io.Reader) (int64, bool) {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len()), true
+	case interface{ Stat() (fs.FileInfo, error) }:
+		if fi, err := v.Stat(); err == nil && fi.Mode().IsRegular() {
+			return fi.Size(), true
+		}
+	}
+	return 0, false
+}
+
+func contentTooLarge(path string, limit int64) error {
+	return fmt.Errorf("%w: content for %s exceeds the %d byte limit", ErrFileTooLarge, path, limit)
+}
+
+func (p *Plugin) contentLimit(streaming bool) int64 {
+	if streaming {
+		return p.streamedLimit
+	}
+	return min(p.streamedLimit, int64(p.inlineLimit()))
+}
+
+func (p *Plugin) readContent(a AddonInstallAction) ([]byte, error) {
+	defer closeReader(a.reader)
+	limit := p.contentLimit(false)
+	data, err := io.ReadAll(io.LimitReader(a.reader, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading content for %s: %w", a.Path, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, contentTooLarge(a.Path, limit)
+	}
+	return data, nil
+}
+
+func closeReader(r io.Reader) {
+	if c, ok := r.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+func closeReaders(actions []AddonInstallAction) {
+	for _, a := range actions {
+		closeReader(a.reader)
+	}
+}
+
+func (p *Plugin) queueActionContent(requestID string, streams []contentStream) {
+	p.actionContentMu.Lock()
+	if p.actionContent == nil {
+		p.actionContent = make(map[string][]contentStream)
+	}
+	p.actionContent[requestID] = streams
+	p.actionContentMu.Unlock()
+}
+
+func (p *Plugin) sendActionContent(requestID string) {
+	p.actionContentMu.Lock()
+	streams := p.actionContent[requestID]
+	delete(p.actionContent, requestID)
+	p.actionContentMu.Unlock()
+	for _, s := range streams {
+		p.streamContent(requestID, s)
+	}
+}
+
+func (p *Plugin) streamContent(requestID string, s contentStream) {
+	defer closeReader(s.r)
+	chunk := actionChunkSize
+	if p.maxSendMsg > 0 && p.maxSendMsg/2 < chunk {
+		chunk = p.maxSendMsg / 2
+	}
+	send := func(c *pb.ActionContent) error {
+		c.Action = s.index
+		return p.send(&pb.PluginMessage{RequestId: requestID, Payload: &pb.PluginMessage_ActionContent{ActionContent: c}})
+	}
+	fail := func(err error) {
+		p.printf(LevelError, "streaming %s for request %s: %v", s.path, requestID, err)
+		send(&pb.ActionContent{Eof: true, Error: err.Error()})
+	}
+
+	buf := make([]byte, chunk)
+	var total int64
+	for {
+		n, err := io.ReadFull(s.r, buf)
+		if n > 0 {
+			if total += int64(n); total > p.streamedLimit {
+				fail(contentTooLarge(s.path, p.streamedLimit))
+				return
+			}
+			if err := send(&pb.ActionContent{Data: buf[:n]}); err != nil {
+				p.printf(LevelError, "streaming %s for request %s: %v", s.path, requestID, err)
+				return
+			}
+		}
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			send(&pb.ActionContent{Eof: true})
+			return
+		default:
+			fail(err)
+			return
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package birdactyl
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+type unsizedReader struct{ r io.Reader }
+
+func (u unsizedReader) Read(b []byte) (int, error) { return u.r.Read(b) }
+
+func TestInlineContentCappedWithoutStreaming(t *testing.T) {
+	p := New("content", "1.0.0", WithMaxSendMsgSize(1<<20))
+	limit := 1 << 19
+	tests := []struct {
+		name    string
+		r       io.Reader
+		wantErr bool
+	}{
+		{"sized within limit", bytes.NewReader(make([]byte, limit)), false},
+		{"sized over limit", bytes.NewReader(make([]byte, limit+1)), true},
+		{"unsized within limit", unsizedReader{strings.NewReader(strings.Repeat("a", limit))}, false},
+		{"unsized over limit", unsizedReader{strings.NewReader(strings.Repeat("a", limit+1))}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, _, err := p.addonActions([]AddonInstallAction{WriteFileFromReader("big.bin", tt.r)}, nil)
+			if tt.wantErr {
+				if !errors.Is(err, ErrFileTooLarge) {
+					t.Fatalf("err = %v, want ErrFileTooLarge", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("addonActions: %v", err)
+			}
+			if len(out) != 1 || len(out[0].Content) != limit {
+				t.Fatalf("inlined %d actions, want one with %d bytes", len(out), limit)
+			}
+		})
+	}
+}
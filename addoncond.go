@@ -0,0 +1,129 @@
+package birdactyl
+
+import (
+	"fmt"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const capActionConditions = "addon.conditions"
+
+type ConditionKind int32
+
+const (
+	CondVariableEquals ConditionKind = 1
+	CondFileExists     ConditionKind = 2
+	CondFileMissing    ConditionKind = 3
+)
+
+type ActionCondition struct {
+	Kind     ConditionKind
+	Variable string
+	Value    string
+	Path     string
+}
+
+func WhenVariableEquals(name, value string) ActionCondition {
+	return ActionCondition{Kind: CondVariableEquals, Variable: name, Value: value}
+}
+
+func WhenFileExists(path string) ActionCondition {
+	return ActionCondition{Kind: CondFileExists, Path: path}
+}
+
+func WhenFileMissing(path string) ActionCondition {
+	return ActionCondition{Kind: CondFileMissing, Path: path}
+}
+
+func (a AddonInstallAction) When(conds ...ActionCondition) AddonInstallAction {
+	a.Conditions = append(append([]ActionCondition(nil), a.Conditions...), conds...)
+	return a
+}
+
+func (c ActionCondition) String() string {
+	switch c.Kind {
+	case CondVariableEquals:
+		return fmt.Sprintf("%s == %q", c.Variable, c.Value)
+	case CondFileExists:
+		return "exists " + c.Path
+	case CondFileMissing:
+		return "missing " + c.Path
+	}
+	return fmt.Sprintf("condition(%d)", c.Kind)
+}
+
+func (p *Plugin) addonActions(actions []AddonInstallAction, vars map[string]string) ([]*pb.AddonInstallAction, []contentStream, error) {
+	native := p.PanelHasCapability(capActionConditions)
+	streaming := p.PanelHasCapability(capStreamedContent)
+	out := make([]*pb.AddonInstallAction, 0, len(actions))
+	var streams []contentStream
+	for i, a := range actions {
+		conds := a.Conditions
+		for _, c := range conds {
+			if c.Kind < CondVariableEquals || c.Kind > CondFileMissing {
+				closeReaders(actions)
+				return nil, nil, fmt.Errorf("action #%d: %w: unknown %s", i, ErrInvalidArgument, c)
+			}
+		}
+		if !native && len(conds) > 0 {
+			for _, c := range conds {
+				if c.Kind != CondVariableEquals {
+					closeReaders(actions)
+					return nil, nil, fmt.Errorf("action #%d: panel cannot evaluate condition %s", i, c)
+				}
+			}
+			if !variablesMatch(conds, vars) {
+				closeReader(a.reader)
+				continue
+			}
+			conds = nil
+		}
+		if a.reader != nil {
+			if size, ok := knownSize(a.reader); ok && size > p.contentLimit(streaming) {
+				closeReaders(actions)
+				return nil, nil, fmt.Errorf("action #%d: %w", i, contentTooLarge(a.Path, p.contentLimit(streaming)))
+			}
+			if !streaming {
+				data, err := p.readContent(a)
+				if err != nil {
+					closeReaders(actions)
+					return nil, nil, fmt.Errorf("action #%d: %w", i, err)
+				}
+				a.Content, a.reader = data, nil
+			}
+		}
+		pa := &pb.AddonInstallAction{
+			Type:         pb.AddonInstallAction_ActionType(a.Type),
+			Url:          a.URL,
+			Path:         a.Path,
+			Content:      a.Content,
+			Command:      a.Command,
+			Headers:      a.Headers,
+			NodePayload:  a.NodePayload,
+			NodeEndpoint: a.NodeEndpoint,
+		}
+		if a.reader != nil {
+			pa.Streamed = true
+			streams = append(streams, contentStream{index: int32(len(out)), path: a.Path, r: a.reader})
+		}
+		for _, c := range conds {
+			pa.Conditions = append(pa.Conditions, &pb.ActionCondition{
+				Kind:     pb.ActionCondition_Kind(c.Kind),
+				Variable: c.Variable,
+				Value:    c.Value,
+				Path:     c.Path,
+			})
+		}
+		out = append(out, pa)
+	}
+	return out, streams, nil
+}
+
+func variablesMatch(conds []ActionCondition, vars map[string]string) bool {
+	for _, c := range conds {
+		if c.Kind == CondVariableEquals && vars[c.Variable] != c.Value {
+			return false
+		}
+	}
+	return true
+}
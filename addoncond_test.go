@@ -0,0 +1,29 @@
+package birdactyl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAddonActionsRejectUnspecifiedCondition(t *testing.T) {
+	p := New("conds", "1.0.0")
+	actions := []AddonInstallAction{DeleteFile("cache").When(ActionCondition{Path: "cache"})}
+	if _, _, err := p.addonActions(actions, nil); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("err = %v, want ErrInvalidArgument", err)
+	}
+}
+
+func TestAddonActionsKeepVariableCondition(t *testing.T) {
+	p := New("conds", "1.0.0")
+	actions := []AddonInstallAction{
+		DeleteFile("a").When(WhenVariableEquals("LOADER", "forge")),
+		DeleteFile("b").When(WhenVariableEquals("LOADER", "fabric")),
+	}
+	out, _, err := p.addonActions(actions, map[string]string{"LOADER": "forge"})
+	if err != nil {
+		t.Fatalf("addonActions: %v", err)
+	}
+	if len(out) != 1 || out[0].Path != "a" {
+		t.Fatalf("actions = %v, want only a", out)
+	}
+}
@@ -0,0 +1,94 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc/codes"
+)
+
+type PortRange struct {
+	Min int
+	Max int
+}
+
+type AllocFilter struct {
+	IP         string
+	ServerID   string
+	Unassigned bool
+	Ports      PortRange
+	Limit      int
+}
+
+func (r PortRange) validate() error {
+	if r.Min < 0 || r.Max > 65535 || (r.Max != 0 && r.Min > r.Max) {
+		return fmt.Errorf("%w: port range %d-%d", ErrInvalidArgument, r.Min, r.Max)
+	}
+	return nil
+}
+
+func allocErr(err error) error {
+	err = panelErr(err)
+	if pe, ok := err.(*PanelError); ok && pe.Code == codes.ResourceExhausted {
+		return pe.withKind(ErrNoFreeAllocation)
+	}
+	return err
+}
+
+func (a *API) Allocations(ctx context.Context, nodeID string, filter AllocFilter) ([]Allocation, error) {
+	if err := filter.Ports.validate(); err != nil {
+		return nil, err
+	}
+	r, err := a.panel.ListAllocations(a.outgoing(ctx), &pb.ListAllocationsRequest{
+		NodeId:     nodeID,
+		Ip:         filter.IP,
+		ServerId:   filter.ServerID,
+		Unassigned: filter.Unassigned,
+		PortMin:    int32(filter.Ports.Min),
+		PortMax:    int32(filter.Ports.Max),
+		Limit:      int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]Allocation, len(r.Allocations))
+	for i, al := range r.Allocations {
+		out[i] = allocationFromProto(al)
+	}
+	return out, nil
+}
+
+func (a *API) CreateAllocation(ctx context.Context, nodeID, ip string, ports []int) error {
+	req := &pb.CreateAllocationsRequest{NodeId: nodeID, Ip: ip}
+	for _, port := range ports {
+		if port <= 0 || port > 65535 {
+			return fmt.Errorf("%w: port %d", ErrInvalidArgument, port)
+		}
+		req.Ports = append(req.Ports, int32(port))
+	}
+	_, err := a.panel.CreateAllocations(a.outgoing(ctx), req)
+	return panelErr(err)
+}
+
+func (a *API) AssignAllocation(ctx context.Context, serverID, allocationID string) error {
+	_, err := a.panel.AssignAllocation(a.outgoing(ctx), &pb.AssignAllocationRequest{ServerId: serverID, AllocationId: allocationID})
+	return panelErr(err)
+}
+
+func (a *API) ReleaseAllocation(ctx context.Context, allocationID string) error {
+	_, err := a.panel.ReleaseAllocation(a.outgoing(ctx), &pb.IDRequest{Id: allocationID})
+	return panelErr(err)
+}
+
+func (a *API) FindFreeAllocation(ctx context.Context, nodeID string, ports PortRange) (*Allocation, error) {
+	if err := ports.validate(); err != nil {
+		return nil, err
+	}
+	r, err := a.panel.ReserveAllocation(a.outgoing(ctx), &pb.ReserveAllocationRequest{NodeId: nodeID, PortMin: int32(ports.Min), PortMax: int32(ports.Max)})
+	if err != nil {
+		return nil, allocErr(err)
+	}
+	al := allocationFromProto(r)
+	return &al, nil
+}
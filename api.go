@@ -4,21 +4,43 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
+	"strings"
+	"time"
 
 	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 type API struct {
-	panel    pb.PanelServiceClient
-	pluginID string
+	panel        pb.PanelServiceClient
+	pluginID     string
+	instance     string
+	conn         grpc.ClientConnInterface
+	invoker      *apiConn
+	opts         callOptions
+	clock        Clock
+	subuserPerms *subuserPermCache
+}
+
+func newAPI(conn grpc.ClientConnInterface, p *Plugin) *API {
+	a := &API{conn: conn, pluginID: p.id, instance: p.instance, clock: p.clock(), opts: callOptions{guard: p.outbound, plugin: p}, subuserPerms: &subuserPermCache{}}
+	a.invoker = &apiConn{base: conn, opts: a.opts, clock: a.clock}
+	a.panel = pb.NewPanelServiceClient(a.invoker)
+	return a
 }
 
 func (a *API) ctx() context.Context {
-	return metadata.AppendToOutgoingContext(context.Background(), "x-plugin-id", a.pluginID)
+	return pluginContext(context.Background(), a.pluginID, a.instance)
+}
+
+func (a *API) outgoing(ctx context.Context) context.Context {
+	return pluginContext(ctx, a.pluginID, a.instance)
 }
 
 func (a *API) Log(level, message string) {
+	a.opts.plugin.writeLocal(level, message)
 	a.panel.Log(a.ctx(), &pb.LogRequest{Level: level, Message: message})
 }
 
@@ -34,6 +56,26 @@ type Server struct {
 	CPU               int32
 	PackageID         string
 	PrimaryAllocation string
+	Allocations       []Allocation
+}
+
+type Allocation struct {
+	ID            string
+	NodeID        string
+	IP            string
+	Port          int32
+	ServerID      string
+	Primary       bool
+	ReservedBy    string
+	ReservedUntil time.Time
+}
+
+type ServerFilter struct {
+	UserID string
+	NodeID string
+	Search string
+	Limit  int
+	Offset int
 }
 
 type User struct {
@@ -57,15 +99,34 @@ type Node struct {
 	Port          int32
 	IsOnline      bool
 	LastHeartbeat string
+	Maintenance   bool
+}
+
+type NodeStats struct {
+	NodeID           string
+	Servers          int32
+	AllocationsTotal int32
+	AllocationsUsed  int32
+	MemoryTotal      int64
+	MemoryAllocated  int64
+	MemoryUsed       int64
+	DiskTotal        int64
+	DiskAllocated    int64
+	DiskUsed         int64
+	CPUPercent       float64
+	Maintenance      bool
+	Time             time.Time
 }
 
 type Database struct {
-	ID       string
-	Name     string
-	Username string
-	Host     string
-	Port     int32
-	Password string
+	ID          string
+	Name        string
+	Username    string
+	Host        string
+	Port        int32
+	Password    string
+	ServerID    string
+	AllowedHost string
 }
 
 type DatabaseHost struct {
@@ -79,10 +140,26 @@ type DatabaseHost struct {
 }
 
 type Backup struct {
-	ID        string
-	Name      string
-	Size      int64
-	CreatedAt string
+	ID          string
+	ServerID    string
+	Name        string
+	Size        int64
+	CreatedAt   string
+	CompletedAt string
+	State       BackupState
+	Error       string
+}
+
+type BackupState string
+
+const (
+	BackupInProgress BackupState = "in_progress"
+	BackupCompleted  BackupState = "completed"
+	BackupFailed     BackupState = "failed"
+)
+
+func (b Backup) Done() bool {
+	return b.State == BackupCompleted || b.State == BackupFailed
 }
 
 type File struct {
@@ -105,6 +182,9 @@ type Package struct {
 	CPU            int32
 	Disk           int32
 	IsPublic       bool
+	DockerImages   []string
+	Variables      []PackageVariable
+	Group          string
 }
 
 type IPBan struct {
@@ -164,6 +244,54 @@ func (a *API) ListServersByUser(userID string) []*Server {
 	return out
 }
 
+func (a *API) Server(ctx context.Context, id string) (*Server, error) {
+	r, err := a.panel.GetServer(a.outgoing(ctx), &pb.IDRequest{Id: id})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	s := serverFromProto(r)
+	return &s, nil
+}
+
+func (a *API) Servers(ctx context.Context, filter ServerFilter) ([]Server, error) {
+	servers, _, err := a.listServers(ctx, filter)
+	return servers, err
+}
+
+func (a *API) listServers(ctx context.Context, filter ServerFilter) ([]Server, int, error) {
+	r, err := a.panel.ListServers(a.outgoing(ctx), &pb.ListServersRequest{
+		UserId: filter.UserID,
+		NodeId: filter.NodeID,
+		Search: filter.Search,
+		Limit:  int32(filter.Limit),
+		Offset: int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, 0, panelErr(err)
+	}
+	out := make([]Server, len(r.Servers))
+	for i, s := range r.Servers {
+		out[i] = serverFromProto(s)
+	}
+	return out, int(r.Total), nil
+}
+
+func (a *API) SearchServers(ctx context.Context, query string) ([]Server, error) {
+	return a.Servers(ctx, ServerFilter{Search: query})
+}
+
+func serverFromProto(s *pb.Server) Server {
+	out := Server{ID: s.Id, Name: s.Name, OwnerID: s.UserId, NodeID: s.NodeId, Status: s.Status, Suspended: s.Suspended, Memory: s.Memory, Disk: s.Disk, CPU: s.Cpu, PackageID: s.PackageId, PrimaryAllocation: s.PrimaryAllocation}
+	for _, al := range s.Allocations {
+		out.Allocations = append(out.Allocations, allocationFromProto(al))
+	}
+	return out
+}
+
+func allocationFromProto(a *pb.Allocation) Allocation {
+	return Allocation{ID: a.Id, NodeID: a.NodeId, IP: a.Ip, Port: a.Port, ServerID: a.ServerId, Primary: a.Primary, ReservedBy: a.ReservedBy, ReservedUntil: unixTime(a.ReservedUntil)}
+}
+
 func (a *API) CreateServer(name, userID, nodeID, packageID string, memory, cpu, disk int32) (*Server, error) {
 	r, err := a.panel.CreateServer(a.ctx(), &pb.CreateServerRequest{Name: name, UserId: userID, NodeId: nodeID, PackageId: packageID, Memory: memory, Cpu: cpu, Disk: disk})
 	if err != nil {
@@ -194,7 +322,7 @@ func (a *API) UpdateServer(id string, name *string, memory, cpu, disk *int32) (*
 }
 
 func (a *API) DeleteServer(id string) error {
-	_, err := a.panel.DeleteServer(a.ctx(), &pb.IDRequest{Id: id})
+	_, err := a.panel.DeleteServer(a.ctx(), &pb.DeleteServerRequest{Id: id})
 	return err
 }
 
@@ -228,6 +356,97 @@ func (a *API) UnsuspendServer(id string) error {
 	return err
 }
 
+type ServerSpec struct {
+	Name          string
+	OwnerID       string
+	NodeID        string
+	Deployment    *Deployment
+	PackageID     string
+	Image         string
+	Startup       string
+	Memory        int32
+	Swap          int32
+	CPU           int32
+	Disk          int32
+	Ports         []int32
+	AllocationIDs []string
+	Variables     map[string]string
+}
+
+type Deployment struct {
+	NodeIDs            []string
+	Tags               []string
+	ExcludeMaintenance bool
+}
+
+func (s ServerSpec) validate() error {
+	var v []FieldViolation
+	if strings.TrimSpace(s.Name) == "" {
+		v = append(v, FieldViolation{Field: "name", Description: "must not be empty"})
+	}
+	if s.OwnerID == "" {
+		v = append(v, FieldViolation{Field: "owner_id", Description: "must not be empty"})
+	}
+	if s.NodeID != "" && s.Deployment != nil {
+		v = append(v, FieldViolation{Field: "node_id", Description: "cannot be combined with deployment"})
+	}
+	if s.PackageID == "" && s.Image == "" {
+		v = append(v, FieldViolation{Field: "package_id", Description: "package or image is required"})
+	}
+	if s.Memory < 0 || s.Swap < -1 || s.CPU < 0 || s.Disk < 0 {
+		v = append(v, FieldViolation{Field: "limits", Description: "must not be negative"})
+	}
+	if len(v) > 0 {
+		return &SpecError{Violations: v}
+	}
+	return nil
+}
+
+func (a *API) CreateServerContext(ctx context.Context, spec ServerSpec) (*Server, error) {
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+	req := &pb.CreateServerRequest{
+		Name:          spec.Name,
+		UserId:        spec.OwnerID,
+		NodeId:        spec.NodeID,
+		PackageId:     spec.PackageID,
+		Image:         spec.Image,
+		Startup:       spec.Startup,
+		Memory:        spec.Memory,
+		Swap:          spec.Swap,
+		Cpu:           spec.CPU,
+		Disk:          spec.Disk,
+		Ports:         spec.Ports,
+		AllocationIds: spec.AllocationIDs,
+		Variables:     spec.Variables,
+	}
+	if d := spec.Deployment; d != nil {
+		req.Deployment = &pb.Deployment{NodeIds: d.NodeIDs, Tags: d.Tags, ExcludeMaintenance: d.ExcludeMaintenance}
+	}
+	r, err := a.panel.CreateServer(a.outgoing(ctx), req)
+	if err != nil {
+		return nil, specErr(err)
+	}
+	s := serverFromProto(r)
+	return &s, nil
+}
+
+func (a *API) DeleteServerContext(ctx context.Context, id string, force bool) error {
+	_, err := a.panel.DeleteServer(a.outgoing(ctx), &pb.DeleteServerRequest{Id: id, Force: force})
+	return panelErr(err)
+}
+
+func (a *API) SuspendServerContext(ctx context.Context, id string) error {
+	_, err := a.panel.SuspendServer(a.outgoing(ctx), &pb.IDRequest{Id: id})
+	return panelErr(err)
+}
+
+func (a *API) UnsuspendServerContext(ctx context.Context, id string) error {
+	_, err := a.panel.UnsuspendServer(a.outgoing(ctx), &pb.IDRequest{Id: id})
+	return panelErr(err)
+}
+
 func (a *API) ReinstallServer(id string) error {
 	_, err := a.panel.ReinstallServer(a.ctx(), &pb.IDRequest{Id: id})
 	return err
@@ -238,6 +457,38 @@ func (a *API) TransferServer(id, targetNodeID string) error {
 	return err
 }
 
+type PowerAction string
+
+const (
+	PowerStart   PowerAction = "start"
+	PowerStop    PowerAction = "stop"
+	PowerRestart PowerAction = "restart"
+	PowerKill    PowerAction = "kill"
+)
+
+func (a *API) PowerAction(ctx context.Context, serverID string, action PowerAction) error {
+	req := &pb.IDRequest{Id: serverID}
+	var err error
+	switch action {
+	case PowerStart:
+		_, err = a.panel.StartServer(a.outgoing(ctx), req)
+	case PowerStop:
+		_, err = a.panel.StopServer(a.outgoing(ctx), req)
+	case PowerRestart:
+		_, err = a.panel.RestartServer(a.outgoing(ctx), req)
+	case PowerKill:
+		_, err = a.panel.KillServer(a.outgoing(ctx), req)
+	default:
+		return fmt.Errorf("%w: unknown power action %q", ErrInvalidArgument, action)
+	}
+	return serverErr(err)
+}
+
+func (a *API) SendCommandContext(ctx context.Context, serverID, command string) error {
+	_, err := a.panel.SendCommand(a.outgoing(ctx), &pb.SendCommandRequest{ServerId: serverID, Command: command})
+	return serverErr(err)
+}
+
 func (a *API) GetConsoleLog(serverID string, lines int32) ([]string, error) {
 	r, err := a.panel.GetConsoleLog(a.ctx(), &pb.ConsoleLogRequest{ServerId: serverID, Lines: lines})
 	if err != nil {
@@ -332,6 +583,64 @@ func (a *API) ListUsers() []*User {
 	return out
 }
 
+type UserFilter struct {
+	Email    string
+	Username string
+	Search   string
+	Limit    int
+	Offset   int
+}
+
+type UserPage struct {
+	Users  []User
+	Total  int
+	Limit  int
+	Offset int
+}
+
+func (a *API) User(ctx context.Context, id string) (*User, error) {
+	r, err := a.panel.GetUser(a.outgoing(ctx), &pb.IDRequest{Id: id})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	u := userFromProto(r)
+	return &u, nil
+}
+
+func (a *API) Users(ctx context.Context, filter UserFilter) (UserPage, error) {
+	r, err := a.panel.ListUsers(a.outgoing(ctx), &pb.ListUsersRequest{
+		Email:    filter.Email,
+		Username: filter.Username,
+		Search:   filter.Search,
+		Limit:    int32(filter.Limit),
+		Offset:   int32(filter.Offset),
+	})
+	if err != nil {
+		return UserPage{}, panelErr(err)
+	}
+	page := UserPage{Users: make([]User, len(r.Users)), Total: int(r.Total), Limit: filter.Limit, Offset: filter.Offset}
+	for i, u := range r.Users {
+		page.Users[i] = userFromProto(u)
+	}
+	return page, nil
+}
+
+func (a *API) UserServers(ctx context.Context, userID string) ([]Server, error) {
+	r, err := a.panel.ListServers(a.outgoing(ctx), &pb.ListServersRequest{UserId: userID, IncludeShared: true})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]Server, len(r.Servers))
+	for i, s := range r.Servers {
+		out[i] = serverFromProto(s)
+	}
+	return out, nil
+}
+
+func userFromProto(u *pb.User) User {
+	return User{ID: u.Id, Username: u.Username, Email: u.Email, IsAdmin: u.IsAdmin, IsBanned: u.IsBanned, ForcePasswordReset: u.ForcePasswordReset, RamLimit: u.RamLimit, CpuLimit: u.CpuLimit, DiskLimit: u.DiskLimit, ServerLimit: u.ServerLimit, CreatedAt: u.CreatedAt}
+}
+
 func (a *API) CreateUser(email, username, password string) (*User, error) {
 	r, err := a.panel.CreateUser(a.ctx(), &pb.CreateUserRequest{Email: email, Username: username, Password: password})
 	if err != nil {
@@ -441,6 +750,91 @@ func (a *API) ResetNodeToken(id string) (string, error) {
 	return r.Token, nil
 }
 
+func (a *API) Nodes(ctx context.Context) ([]Node, error) {
+	r, err := a.panel.ListNodes(a.outgoing(ctx), &pb.Empty{})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]Node, len(r.Nodes))
+	for i, n := range r.Nodes {
+		out[i] = Node{ID: n.Id, Name: n.Name, FQDN: n.Fqdn, Port: n.Port, IsOnline: n.IsOnline, LastHeartbeat: n.LastHeartbeat, Maintenance: n.Maintenance}
+	}
+	return out, nil
+}
+
+func (a *API) NodeStats(ctx context.Context, nodeID string) (*NodeStats, error) {
+	r, err := a.panel.GetNodeStats(a.outgoing(ctx), &pb.IDRequest{Id: nodeID})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	st := nodeStatsFromProto(r)
+	return &st, nil
+}
+
+func (a *API) WatchNodeStats(ctx context.Context, interval time.Duration) (<-chan []NodeStats, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w: watch interval must be positive, got %s", ErrInvalidArgument, interval)
+	}
+	first, err := a.allNodeStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan []NodeStats, 1)
+	out <- first
+	go func() {
+		defer close(out)
+		t := a.clock.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C():
+			}
+			snap, err := a.allNodeStats(ctx)
+			if err != nil {
+				continue
+			}
+			select {
+			case <-out:
+			default:
+			}
+			out <- snap
+		}
+	}()
+	return out, nil
+}
+
+func (a *API) allNodeStats(ctx context.Context) ([]NodeStats, error) {
+	r, err := a.panel.ListNodeStats(a.outgoing(ctx), &pb.Empty{})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]NodeStats, len(r.Stats))
+	for i, st := range r.Stats {
+		out[i] = nodeStatsFromProto(st)
+	}
+	return out, nil
+}
+
+func nodeStatsFromProto(s *pb.NodeStats) NodeStats {
+	return NodeStats{
+		NodeID:           s.NodeId,
+		Servers:          s.Servers,
+		AllocationsTotal: s.AllocationsTotal,
+		AllocationsUsed:  s.AllocationsUsed,
+		MemoryTotal:      s.MemoryTotal,
+		MemoryAllocated:  s.MemoryAllocated,
+		MemoryUsed:       s.MemoryUsed,
+		DiskTotal:        s.DiskTotal,
+		DiskAllocated:    s.DiskAllocated,
+		DiskUsed:         s.DiskUsed,
+		CPUPercent:       s.CpuPercent,
+		Maintenance:      s.Maintenance,
+		Time:             unixTime(s.Timestamp),
+	}
+}
+
 func (a *API) ListFiles(serverID, path string) []*File {
 	r, _ := a.panel.ListFiles(a.ctx(), &pb.FilePathRequest{ServerId: serverID, Path: path})
 	out := make([]*File, len(r.GetFiles()))
@@ -483,6 +877,90 @@ func (a *API) CopyFile(serverID, from, to string) error {
 	return err
 }
 
+const MaxFileSize = 32 << 20
+
+type FileEntry struct {
+	Name    string
+	Path    string
+	Size    int64
+	IsDir   bool
+	ModTime string
+	Mime    string
+}
+
+func cleanServerPath(p string) (string, error) {
+	if strings.ContainsRune(p, 0) {
+		return "", fmt.Errorf("%w: %q contains a NUL byte", ErrInvalidPath, p)
+	}
+	p = strings.ReplaceAll(p, "\\", "/")
+	depth := 0
+	for _, seg := range strings.Split(p, "/") {
+		switch seg {
+		case "", ".":
+		case "..":
+			depth--
+			if depth < 0 {
+				return "", fmt.Errorf("%w: %q escapes the server root", ErrInvalidPath, p)
+			}
+		default:
+			depth++
+		}
+	}
+	return path.Clean("/" + p), nil
+}
+
+func (a *API) ReadFileContext(ctx context.Context, serverID, filePath string) ([]byte, error) {
+	clean, err := cleanServerPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	r, err := a.panel.ReadFile(a.outgoing(ctx), &pb.FilePathRequest{ServerId: serverID, Path: clean}, grpc.MaxCallRecvMsgSize(MaxFileSize+4096))
+	if err != nil {
+		return nil, fileErr(err)
+	}
+	return r.Content, nil
+}
+
+func (a *API) WriteFileContext(ctx context.Context, serverID, filePath string, data []byte) error {
+	clean, err := cleanServerPath(filePath)
+	if err != nil {
+		return err
+	}
+	if len(data) > MaxFileSize {
+		return fmt.Errorf("%w: %d bytes exceeds the %d byte limit", ErrFileTooLarge, len(data), MaxFileSize)
+	}
+	_, err = a.panel.WriteFile(a.outgoing(ctx), &pb.WriteFileRequest{ServerId: serverID, Path: clean, Content: data})
+	return fileErr(err)
+}
+
+func (a *API) ListDir(ctx context.Context, serverID, dirPath string) ([]FileEntry, error) {
+	clean, err := cleanServerPath(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	r, err := a.panel.ListFiles(a.outgoing(ctx), &pb.FilePathRequest{ServerId: serverID, Path: clean})
+	if err != nil {
+		return nil, fileErr(err)
+	}
+	out := make([]FileEntry, len(r.Files))
+	for i, f := range r.Files {
+		out[i] = FileEntry{Name: f.Name, Path: path.Join(clean, f.Name), Size: f.Size, IsDir: f.IsDir, ModTime: f.Modified, Mime: f.Mime}
+	}
+	return out, nil
+}
+
+func (a *API) DeleteFileContext(ctx context.Context, serverID, filePath string) error {
+	clean, err := cleanServerPath(filePath)
+	if err != nil {
+		return err
+	}
+	if clean == "/" {
+		return fmt.Errorf("%w: refusing to delete the server root", ErrInvalidPath)
+	}
+	_, err = a.panel.DeleteFile(a.outgoing(ctx), &pb.FilePathRequest{ServerId: serverID, Path: clean})
+	return fileErr(err)
+}
+
 func (a *API) ListDatabases(serverID string) []*Database {
 	r, _ := a.panel.ListDatabases(a.ctx(), &pb.IDRequest{Id: serverID})
 	out := make([]*Database, len(r.GetDatabases()))
@@ -541,11 +1019,55 @@ func (a *API) DeleteBackup(serverID, backupID string) error {
 	return err
 }
 
+func (a *API) Backups(ctx context.Context, serverID string) ([]Backup, error) {
+	r, err := a.panel.ListBackups(a.outgoing(ctx), &pb.IDRequest{Id: serverID})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]Backup, len(r.Backups))
+	for i, b := range r.Backups {
+		out[i] = backupFromProto(b)
+	}
+	return out, nil
+}
+
+func (a *API) Backup(ctx context.Context, serverID, backupID string) (*Backup, error) {
+	r, err := a.panel.GetBackup(a.outgoing(ctx), &pb.BackupRequest{ServerId: serverID, BackupId: backupID})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	b := backupFromProto(r)
+	return &b, nil
+}
+
+func (a *API) CreateBackupContext(ctx context.Context, serverID, name string) (*Backup, error) {
+	r, err := a.panel.CreateBackup(a.outgoing(ctx), &pb.CreateBackupRequest{ServerId: serverID, Name: name})
+	if err != nil {
+		return nil, serverErr(err)
+	}
+	b := backupFromProto(r)
+	return &b, nil
+}
+
+func (a *API) DeleteBackupContext(ctx context.Context, serverID, backupID string) error {
+	_, err := a.panel.DeleteBackup(a.outgoing(ctx), &pb.DeleteBackupRequest{ServerId: serverID, BackupId: backupID})
+	return panelErr(err)
+}
+
+func (a *API) RestoreBackup(ctx context.Context, serverID, backupID string, truncate bool) error {
+	_, err := a.panel.RestoreBackup(a.outgoing(ctx), &pb.RestoreBackupRequest{ServerId: serverID, BackupId: backupID, Truncate: truncate})
+	return serverErr(err)
+}
+
+func backupFromProto(b *pb.Backup) Backup {
+	return Backup{ID: b.Id, ServerID: b.ServerId, Name: b.Name, Size: b.Size, CreatedAt: b.CreatedAt, CompletedAt: b.CompletedAt, State: BackupState(b.State), Error: b.Error}
+}
+
 func (a *API) ListPackages() []*Package {
 	r, _ := a.panel.ListPackages(a.ctx(), &pb.Empty{})
 	out := make([]*Package, len(r.GetPackages()))
 	for i, p := range r.GetPackages() {
-		out[i] = &Package{ID: p.Id, Name: p.Name, Description: p.Description, DockerImage: p.DockerImage, StartupCommand: p.StartupCommand, StopCommand: p.StopCommand, ConfigFiles: p.ConfigFiles, Memory: p.DefaultMemory, CPU: p.DefaultCpu, Disk: p.DefaultDisk, IsPublic: p.IsPublic}
+		out[i] = packageFromProto(p)
 	}
 	return out
 }
@@ -555,7 +1077,7 @@ func (a *API) GetPackage(id string) (*Package, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Package{ID: r.Id, Name: r.Name, Description: r.Description, DockerImage: r.DockerImage, StartupCommand: r.StartupCommand, StopCommand: r.StopCommand, ConfigFiles: r.ConfigFiles, Memory: r.DefaultMemory, CPU: r.DefaultCpu, Disk: r.DefaultDisk, IsPublic: r.IsPublic}, nil
+	return packageFromProto(r), nil
 }
 
 func (a *API) CreatePackage(name, description, dockerImage, startupCmd, stopCmd, configFiles string, memory, cpu, disk int32, isPublic bool) (*Package, error) {
@@ -567,7 +1089,7 @@ func (a *API) CreatePackage(name, description, dockerImage, startupCmd, stopCmd,
 	if err != nil {
 		return nil, err
 	}
-	return &Package{ID: r.Id, Name: r.Name, Description: r.Description, DockerImage: r.DockerImage, StartupCommand: r.StartupCommand, StopCommand: r.StopCommand, ConfigFiles: r.ConfigFiles, Memory: r.DefaultMemory, CPU: r.DefaultCpu, Disk: r.DefaultDisk, IsPublic: r.IsPublic}, nil
+	return packageFromProto(r), nil
 }
 
 func (a *API) UpdatePackage(id string, name, description *string, memory, cpu, disk *int32) (*Package, error) {
@@ -591,7 +1113,7 @@ func (a *API) UpdatePackage(id string, name, description *string, memory, cpu, d
 	if err != nil {
 		return nil, err
 	}
-	return &Package{ID: r.Id, Name: r.Name, Description: r.Description, DockerImage: r.DockerImage, StartupCommand: r.StartupCommand, StopCommand: r.StopCommand, ConfigFiles: r.ConfigFiles, Memory: r.DefaultMemory, CPU: r.DefaultCpu, Disk: r.DefaultDisk, IsPublic: r.IsPublic}, nil
+	return packageFromProto(r), nil
 }
 
 func (a *API) DeletePackage(id string) error {
@@ -672,6 +1194,72 @@ func (a *API) GetActivityLogs(limit int32) []*ActivityLog {
 	return out
 }
 
+const (
+	AuditActorPlugin = "plugin"
+	maxAuditBatch    = 500
+)
+
+type AuditEntry struct {
+	Action     string
+	ActorID    string
+	TargetType string
+	TargetID   string
+	Metadata   map[string]string
+}
+
+func (e AuditEntry) proto() (*pb.AuditEntry, error) {
+	if e.Action == "" {
+		return nil, fmt.Errorf("%w: audit action is required", ErrInvalidArgument)
+	}
+	actor := e.ActorID
+	if actor == "" {
+		actor = AuditActorPlugin
+	}
+	return &pb.AuditEntry{Action: e.Action, ActorId: actor, TargetType: e.TargetType, TargetId: e.TargetID, Metadata: e.Metadata}, nil
+}
+
+func (a *API) Audit(ctx context.Context, entry AuditEntry) error {
+	return a.AuditBatch(ctx, []AuditEntry{entry})
+}
+
+func (a *API) AuditBatch(ctx context.Context, entries []AuditEntry) error {
+	out := make([]*pb.AuditEntry, len(entries))
+	for i, e := range entries {
+		pe, err := e.proto()
+		if err != nil {
+			return err
+		}
+		out[i] = pe
+	}
+	for len(out) > 0 {
+		n := min(len(out), maxAuditBatch)
+		if _, err := a.panel.WriteAudit(a.outgoing(ctx), &pb.AuditRequest{Entries: out[:n]}); err != nil {
+			return panelErr(err)
+		}
+		out = out[n:]
+	}
+	return nil
+}
+
+func (a *API) NotifyUser(ctx context.Context, userID string, n Notification) error {
+	_, err := a.panel.SendNotification(a.outgoing(ctx), &pb.NotificationRequest{UserId: userID, Title: n.Title, Message: n.Message, Type: n.Type})
+	return panelErr(err)
+}
+
+func (a *API) NotifyAdmins(ctx context.Context, n Notification) error {
+	_, err := a.panel.SendNotification(a.outgoing(ctx), &pb.NotificationRequest{Admins: true, Title: n.Title, Message: n.Message, Type: n.Type})
+	return panelErr(err)
+}
+
+func (a *API) SendEmail(ctx context.Context, userID, subject, htmlBody string) error {
+	_, err := a.panel.SendEmail(a.outgoing(ctx), &pb.SendEmailRequest{UserId: userID, Subject: subject, HtmlBody: htmlBody})
+	err = panelErr(err)
+	if pe, ok := err.(*PanelError); ok && (pe.Code == codes.FailedPrecondition || pe.Code == codes.Unimplemented) {
+		return pe.withKind(ErrMailerDisabled)
+	}
+	return err
+}
+
 func (a *API) GetKV(key string) (string, bool) {
 	r, _ := a.panel.GetKV(a.ctx(), &pb.KVRequest{Key: key})
 	return r.GetValue(), r.GetFound()
@@ -754,6 +1342,38 @@ func (a *API) CallPlugin(pluginID, method string, data []byte) ([]byte, error) {
 	return r.Data, nil
 }
 
+type PluginCallResponse struct {
+	Status  int
+	Headers map[string]string
+	Body    []byte
+}
+
+type PluginSummary struct {
+	ID      string
+	Name    string
+	Version string
+}
+
+func (a *API) CallPluginRoute(ctx context.Context, pluginID, method, path string, body []byte) (*PluginCallResponse, error) {
+	r, err := a.panel.CallPluginRoute(a.outgoing(ctx), &pb.PluginRouteRequest{PluginId: pluginID, Method: method, Path: path, Body: body})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	return &PluginCallResponse{Status: int(r.Status), Headers: r.Headers, Body: r.Body}, nil
+}
+
+func (a *API) Plugins(ctx context.Context) ([]PluginSummary, error) {
+	r, err := a.panel.ListPlugins(a.outgoing(ctx), &pb.Empty{})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]PluginSummary, len(r.Plugins))
+	for i, pl := range r.Plugins {
+		out[i] = PluginSummary{ID: pl.Id, Name: pl.Name, Version: pl.Version}
+	}
+	return out, nil
+}
+
 type LogMatch struct {
 	Line       string
 	LineNumber int32
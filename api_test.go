@@ -0,0 +1,17 @@
+package birdactyl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWatchNodeStatsRejectsInterval(t *testing.T) {
+	a := &API{}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := a.WatchNodeStats(context.Background(), interval); !errors.Is(err, ErrInvalidArgument) {
+			t.Fatalf("WatchNodeStats(%s) = %v, want ErrInvalidArgument", interval, err)
+		}
+	}
+}
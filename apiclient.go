@@ -0,0 +1,180 @@
+package birdactyl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type RequestHook func(method string)
+type ResponseHook func(method string, elapsed time.Duration, err error)
+
+type callOptions struct {
+	timeout      time.Duration
+	retryMax     int
+	retryBackoff time.Duration
+	guard        *outboundGuard
+	plugin       *Plugin
+}
+
+func (o callOptions) hooks() ([]RequestHook, []ResponseHook) {
+	if o.plugin == nil {
+		return nil, nil
+	}
+	o.plugin.regMu.RLock()
+	defer o.plugin.regMu.RUnlock()
+	return o.plugin.onAPIRequest, o.plugin.onAPIResponse
+}
+
+func (p *Plugin) OnAPIRequest(fn RequestHook) *Plugin {
+	p.regMu.Lock()
+	p.onAPIRequest = append(p.onAPIRequest, fn)
+	p.regMu.Unlock()
+	return p
+}
+
+func (p *Plugin) OnAPIResponse(fn ResponseHook) *Plugin {
+	p.regMu.Lock()
+	p.onAPIResponse = append(p.onAPIResponse, fn)
+	p.regMu.Unlock()
+	return p
+}
+
+func (a *API) with(fn func(*callOptions)) *API {
+	b := *a
+	fn(&b.opts)
+	b.invoker = &apiConn{base: b.conn, opts: b.opts, clock: b.clock}
+	b.panel = pb.NewPanelServiceClient(b.invoker)
+	return &b
+}
+
+func (a *API) WithTimeout(d time.Duration) *API {
+	return a.with(func(o *callOptions) { o.timeout = d })
+}
+
+func (a *API) WithRetry(max int, backoff time.Duration) *API {
+	return a.with(func(o *callOptions) {
+		o.retryMax = max
+		o.retryBackoff = backoff
+	})
+}
+
+func (a *API) OnRequest(fn RequestHook) *API {
+	if a.opts.plugin != nil {
+		a.opts.plugin.OnAPIRequest(fn)
+	}
+	return a
+}
+
+func (a *API) OnResponse(fn ResponseHook) *API {
+	if a.opts.plugin != nil {
+		a.opts.plugin.OnAPIResponse(fn)
+	}
+	return a
+}
+
+type apiConn struct {
+	base  grpc.ClientConnInterface
+	opts  callOptions
+	clock Clock
+}
+
+func (c *apiConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) (err error) {
+	onRequest, onResponse := c.opts.hooks()
+	for _, fn := range onRequest {
+		fn(method)
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span := parent.tracer.child(parent, method[strings.LastIndex(method, "/")+1:], spanKindClient)
+		span.SetAttribute("rpc.method", method)
+		ctx = metadata.AppendToOutgoingContext(ctx, "traceparent", span.traceparent())
+		defer span.End()
+		defer func() { span.RecordError(err) }()
+	}
+	start := c.clock.Now()
+	err = panelErr(c.invoke(ctx, method, args, reply, opts...))
+	for _, fn := range onResponse {
+		fn(method, c.clock.Now().Sub(start), err)
+	}
+	return err
+}
+
+func (c *apiConn) invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	retries := 0
+	if idempotentCall(method, args) {
+		retries = c.opts.retryMax
+	}
+	for attempt := 0; ; attempt++ {
+		err := c.attempt(ctx, method, args, reply, opts...)
+		if err == nil || attempt >= retries || !retryable(err) {
+			return err
+		}
+		t := c.clock.NewTimer(c.opts.retryBackoff << attempt)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C():
+		}
+	}
+}
+
+func (c *apiConn) attempt(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) (err error) {
+	if g := c.opts.guard; g != nil && !isUrgent(ctx) {
+		if !g.allow(c.clock.Now()) {
+			return ErrCircuitOpen
+		}
+		if err := g.wait(ctx, c.clock, method); err != nil {
+			g.release()
+			return err
+		}
+		defer func() { g.record(c.clock.Now(), err) }()
+	}
+	if _, ok := ctx.Deadline(); !ok && c.opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.opts.timeout)
+		defer cancel()
+	}
+	return c.base.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (c *apiConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.base.NewStream(ctx, desc, method, opts...)
+}
+
+func idempotentCall(method string, args any) bool {
+	batch, ok := args.(*pb.ApiBatch)
+	if !ok {
+		return idempotent(method)
+	}
+	for _, c := range batch.Calls {
+		if !idempotent(c.Method) {
+			return false
+		}
+	}
+	return true
+}
+
+func idempotent(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range []string{"Get", "List", "Search", "Read"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted:
+		return true
+	}
+	return false
+}
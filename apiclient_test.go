@@ -0,0 +1,90 @@
+package birdactyl_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+type hookRecorder struct {
+	mu        sync.Mutex
+	requests  []string
+	responses []string
+}
+
+func (h *hookRecorder) request(method string) {
+	h.mu.Lock()
+	h.requests = append(h.requests, method)
+	h.mu.Unlock()
+}
+
+func (h *hookRecorder) response(method string, elapsed time.Duration, err error) {
+	h.mu.Lock()
+	h.responses = append(h.responses, method)
+	h.mu.Unlock()
+}
+
+func (h *hookRecorder) counts() (int, int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.requests), len(h.responses)
+}
+
+func TestAPIHooksSurviveReconnect(t *testing.T) {
+	var h hookRecorder
+	p := birdactyl.New("hooks", "1.0.0").OnAPIRequest(h.request).OnAPIResponse(h.response)
+	tp := birdactyltest.NewPanel(t)
+	tp.AddUser(birdactyl.User{ID: "u1", Username: "alice"})
+	tp.StartPlugin(p)
+
+	if _, err := p.API().GetUser("u1"); err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if req, resp := h.counts(); req != 1 || resp != 1 {
+		t.Fatalf("hooks fired %d/%d times before reconnect, want 1/1", req, resp)
+	}
+
+	tp.Disconnect()
+	deadline := time.Now().Add(5 * time.Second)
+	for p.ConnectionState() != birdactyl.StateConnected {
+		if time.Now().After(deadline) {
+			t.Fatal("plugin did not reconnect")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := p.API().GetUser("u1"); err != nil {
+		t.Fatalf("GetUser after reconnect: %v", err)
+	}
+	if req, resp := h.counts(); req != 2 || resp != 2 {
+		t.Fatalf("hooks fired %d/%d times after reconnect, want 2/2", req, resp)
+	}
+}
+
+func TestAPIHooksRegisteredConcurrently(t *testing.T) {
+	p := birdactyl.New("hooks", "1.0.0")
+	tp := birdactyltest.NewPanel(t)
+	tp.AddUser(birdactyl.User{ID: "u1"})
+	tp.StartPlugin(p)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				p.API().OnRequest(func(string) {})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				p.API().GetUser("u1")
+			}
+		}()
+	}
+	wg.Wait()
+}
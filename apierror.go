@@ -0,0 +1,115 @@
+package birdactyl
+
+import (
+	"errors"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	CodeInternal         = "INTERNAL"
+	CodeNotFound         = "NOT_FOUND"
+	CodeForbidden        = "FORBIDDEN"
+	CodeInvalidArgument  = "INVALID_ARGUMENT"
+	CodeAlreadyExists    = "ALREADY_EXISTS"
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodePayloadTooLarge  = "PAYLOAD_TOO_LARGE"
+	CodeUnavailable      = "UNAVAILABLE"
+	CodeRateLimited      = "RATE_LIMITED"
+)
+
+type RouteHandlerE func(Request) (Response, error)
+
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+	Details interface{}
+}
+
+func NewAPIError(status int, code, message string) *APIError {
+	return &APIError{Status: status, Code: code, Message: message}
+}
+
+func (e *APIError) WithDetails(details interface{}) *APIError {
+	c := *e
+	c.Details = details
+	return &c
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return e.Message
+	}
+	return e.Code + ": " + e.Message
+}
+
+var sentinelErrors = []struct {
+	err error
+	api APIError
+}{
+	{ErrNotFound, APIError{Status: 404, Code: CodeNotFound, Message: "not found"}},
+	{ErrPermissionDenied, APIError{Status: 403, Code: CodeForbidden, Message: "permission denied"}},
+	{ErrInvalidArgument, APIError{Status: 400, Code: CodeInvalidArgument, Message: "invalid argument"}},
+	{ErrAlreadyExists, APIError{Status: 409, Code: CodeAlreadyExists, Message: "already exists"}},
+	{ErrBodyTooLarge, APIError{Status: 413, Code: CodePayloadTooLarge, Message: "request body too large"}},
+	{ErrRateLimited, APIError{Status: 429, Code: CodeRateLimited, Message: "rate limited"}},
+	{ErrUnavailable, APIError{Status: 503, Code: CodeUnavailable, Message: "service unavailable"}},
+	{ErrCircuitOpen, APIError{Status: 503, Code: CodeUnavailable, Message: "service unavailable"}},
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return ae, true
+	}
+	var verrs ValidationErrors
+	if errors.As(err, &verrs) {
+		return &APIError{Status: 422, Code: CodeValidationFailed, Message: "validation failed", Details: verrs}, true
+	}
+	for _, s := range sentinelErrors {
+		if errors.Is(err, s.err) {
+			api := s.api
+			return &api, true
+		}
+	}
+	return &APIError{Status: 500, Code: CodeInternal, Message: "internal error"}, false
+}
+
+func ErrorFrom(err error) Response {
+	if err == nil {
+		return Response{Status: 204}
+	}
+	ae, _ := asAPIError(err)
+	status := ae.Status
+	if status == 0 {
+		status = 500
+	}
+	body := map[string]interface{}{"success": false, "error": ae.Message}
+	if ae.Code != "" {
+		body["code"] = ae.Code
+	}
+	if ae.Details != nil {
+		body["details"] = ae.Details
+	}
+	return Response{Status: status, Headers: map[string]string{"Content-Type": "application/json"}, body: marshalJSON(body)}
+}
+
+func (p *Plugin) RouteE(method, path string, handler RouteHandlerE) *RouteBuilder {
+	return p.Route(method, path, p.wrapRouteE(method, path, handler))
+}
+
+func (p *Plugin) wrapRouteE(method, path string, handler RouteHandlerE) RouteHandler {
+	source := "route:" + method + " " + path
+	return func(r Request) Response {
+		resp, err := handler(r)
+		if err == nil {
+			return resp
+		}
+		if _, known := asAPIError(err); !known {
+			p.printf(LevelError, "%s: %v", source, err)
+			p.report(&pb.ErrorReport{Message: err.Error(), Source: source, RequestId: r.RequestID})
+		}
+		return ErrorFrom(err)
+	}
+}
@@ -3,18 +3,21 @@ package birdactyl
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
-	"google.golang.org/grpc/metadata"
 )
 
 type AsyncAPI struct {
 	panel    pb.PanelServiceClient
 	pluginID string
+	api      *API
+	plugin   *Plugin
 }
 
 func (a *AsyncAPI) ctx() context.Context {
-	return metadata.AppendToOutgoingContext(context.Background(), "x-plugin-id", a.pluginID)
+	return pluginContext(context.Background(), a.pluginID, a.api.instance)
 }
 
 type Future[T any] struct {
@@ -67,6 +70,60 @@ func (f *Future[T]) Catch(fn func(error)) *Future[T] {
 	return f
 }
 
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+func resultChan[T any](fn func() (T, error)) <-chan Result[T] {
+	ch := make(chan Result[T], 1)
+	go func() {
+		val, err := fn()
+		ch <- Result[T]{Value: val, Err: err}
+		close(ch)
+	}()
+	return ch
+}
+
+func (a *AsyncAPI) PowerAction(ctx context.Context, serverID string, action PowerAction) <-chan Result[struct{}] {
+	return resultChan(func() (struct{}, error) {
+		return struct{}{}, a.api.PowerAction(ctx, serverID, action)
+	})
+}
+
+func (a *AsyncAPI) SendCommandContext(ctx context.Context, serverID, command string) <-chan Result[struct{}] {
+	return resultChan(func() (struct{}, error) {
+		return struct{}{}, a.api.SendCommandContext(ctx, serverID, command)
+	})
+}
+
+const backupPollInterval = 2 * time.Second
+
+func (a *AsyncAPI) CreateBackup(ctx context.Context, serverID, name string) <-chan Result[Backup] {
+	return resultChan(func() (Backup, error) {
+		b, err := a.api.CreateBackupContext(ctx, serverID, name)
+		if err != nil {
+			return Backup{}, err
+		}
+		t := a.api.clock.NewTicker(backupPollInterval)
+		defer t.Stop()
+		for !b.Done() {
+			select {
+			case <-ctx.Done():
+				return *b, ctx.Err()
+			case <-t.C():
+			}
+			if b, err = a.api.Backup(ctx, serverID, b.ID); err != nil {
+				return Backup{}, err
+			}
+		}
+		if b.State == BackupFailed {
+			return *b, fmt.Errorf("%w: %s", ErrBackupFailed, b.Error)
+		}
+		return *b, nil
+	})
+}
+
 func (a *AsyncAPI) GetServer(id string) *Future[*Server] {
 	return newFuture(func() (*Server, error) {
 		r, err := a.panel.GetServer(a.ctx(), &pb.IDRequest{Id: id})
@@ -121,7 +178,7 @@ func (a *AsyncAPI) KillServer(id string) *Future[struct{}] {
 
 func (a *AsyncAPI) DeleteServer(id string) *Future[struct{}] {
 	return newFuture(func() (struct{}, error) {
-		_, err := a.panel.DeleteServer(a.ctx(), &pb.IDRequest{Id: id})
+		_, err := a.panel.DeleteServer(a.ctx(), &pb.DeleteServerRequest{Id: id})
 		return struct{}{}, err
 	})
 }
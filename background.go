@@ -0,0 +1,116 @@
+package birdactyl
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const backgroundFlushPoll = 10 * time.Millisecond
+
+type Background struct {
+	p *Plugin
+}
+
+func (p *Plugin) Background() *Background {
+	return &Background{p: p}
+}
+
+func (b *Background) Log(level, message string) {
+	if b != nil {
+		b.p.writeLocal(level, message)
+	}
+	b.enqueue(pb.PanelService_Log_FullMethodName, &pb.LogRequest{Level: level, Message: message})
+}
+
+func (b *Background) Notify(userID string, n Notification) {
+	b.enqueue(pb.PanelService_SendNotification_FullMethodName, &pb.NotificationRequest{UserId: userID, Title: n.Title, Message: n.Message, Type: n.Type})
+}
+
+func (b *Background) NotifyAdmins(n Notification) {
+	b.enqueue(pb.PanelService_SendNotification_FullMethodName, &pb.NotificationRequest{Admins: true, Title: n.Title, Message: n.Message, Type: n.Type})
+}
+
+func (b *Background) EmitEvent(eventType string, data map[string]string) {
+	b.enqueue(pb.PanelService_BroadcastEvent_FullMethodName, &pb.BroadcastEventRequest{EventType: eventType, Data: data})
+}
+
+func (b *Background) Audit(entry AuditEntry) {
+	if b == nil || b.p == nil {
+		return
+	}
+	pe, err := entry.proto()
+	if err != nil {
+		b.p.bgDropped.Add(1)
+		b.p.printf(LevelWarn, "dropping background audit %q: %v", entry.Action, err)
+		return
+	}
+	b.enqueue(pb.PanelService_WriteAudit_FullMethodName, &pb.AuditRequest{Entries: []*pb.AuditEntry{pe}})
+}
+
+func (b *Background) Dropped() uint64 {
+	if b == nil || b.p == nil {
+		return 0
+	}
+	return b.p.bgDropped.Load()
+}
+
+func (b *Background) Pending() int {
+	if b == nil || b.p == nil {
+		return 0
+	}
+	return b.p.BufferedCalls()
+}
+
+func (b *Background) Flush(ctx context.Context) error {
+	if b == nil || b.p == nil {
+		return nil
+	}
+	p := b.p
+	ticker := p.clock().NewTicker(backgroundFlushPoll)
+	defer ticker.Stop()
+	for {
+		if p.BufferedCalls() == 0 {
+			return nil
+		}
+		p.kickOutbox()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+func (b *Background) enqueue(method string, msg proto.Message) {
+	if b == nil || b.p == nil {
+		return
+	}
+	p := b.p
+	limit := p.outbox.max
+	if limit <= 0 {
+		limit = DefaultOutageBuffer
+	}
+	ctx := pluginContext(context.Background(), p.id, p.instance)
+	if !p.outbox.pushLimit(ctx, method, msg, limit) {
+		p.bgDropped.Add(1)
+		return
+	}
+	p.kickOutbox()
+}
+
+func (p *Plugin) kickOutbox() {
+	_, conn := p.connSnapshot()
+	if p.ConnectionState() != StateConnected || conn == nil {
+		return
+	}
+	p.outbox.mu.Lock()
+	flushing := p.outbox.flushing
+	p.outbox.mu.Unlock()
+	if flushing {
+		return
+	}
+	go p.drainOutbox(scopedConn{base: conn, scope: &p.perms})
+}
@@ -0,0 +1,42 @@
+package birdactyl_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestBackgroundDuringReconnect(t *testing.T) {
+	p := birdactyl.New("background", "1.0.0")
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				p.Background().Log("info", "tick")
+				time.Sleep(100 * time.Microsecond)
+			}
+		}
+	}()
+	tp.Disconnect()
+	close(stop)
+	wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := p.Background().Flush(ctx); err != nil {
+		t.Fatalf("Flush after reconnect: %v", err)
+	}
+}
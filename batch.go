@@ -0,0 +1,202 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	MaxBatchSize = 100
+	capAPIBatch  = "api.batch"
+	panelService = "/plugins.PanelService/"
+	batchMethod  = panelService + "ApiBatch"
+)
+
+type BatchRequest struct {
+	method string
+	req    proto.Message
+	reply  proto.Message
+	decode func(proto.Message) interface{}
+	err    error
+}
+
+type BatchResult struct {
+	Value interface{}
+	Err   error
+}
+
+type Batch struct {
+	async *AsyncAPI
+	calls []BatchRequest
+}
+
+func NewBatchRequest(method string, req, reply proto.Message) BatchRequest {
+	if !strings.HasPrefix(method, "/") {
+		method = panelService + method
+	}
+	if reply == nil {
+		reply = &pb.Empty{}
+	}
+	return BatchRequest{method: method, req: req, reply: reply}
+}
+
+func BatchGetServer(id string) BatchRequest {
+	r := NewBatchRequest("GetServer", &pb.IDRequest{Id: id}, &pb.Server{})
+	r.decode = func(m proto.Message) interface{} {
+		s := serverFromProto(m.(*pb.Server))
+		return &s
+	}
+	return r
+}
+
+func BatchUpdateServerVariables(serverID string, variables map[string]string) BatchRequest {
+	return NewBatchRequest("UpdateServerVariables", &pb.UpdateVariablesRequest{ServerId: serverID, Variables: variables}, &pb.Empty{})
+}
+
+func BatchAudit(entry AuditEntry) BatchRequest {
+	pe, err := entry.proto()
+	r := NewBatchRequest("WriteAudit", &pb.AuditRequest{Entries: []*pb.AuditEntry{pe}}, &pb.Empty{})
+	r.err = err
+	return r
+}
+
+func BatchLog(level, message string) BatchRequest {
+	return NewBatchRequest("Log", &pb.LogRequest{Level: level, Message: message}, &pb.Empty{})
+}
+
+func (a *AsyncAPI) Batch() *Batch {
+	return &Batch{async: a}
+}
+
+func (b *Batch) Add(req BatchRequest) *Batch {
+	b.calls = append(b.calls, req)
+	return b
+}
+
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+func (b *Batch) Execute(ctx context.Context) []BatchResult {
+	results := make([]BatchResult, len(b.calls))
+	for start := 0; start < len(b.calls); start += MaxBatchSize {
+		end := min(start+MaxBatchSize, len(b.calls))
+		b.async.execute(ctx, b.calls[start:end], results[start:end])
+	}
+	return results
+}
+
+func (a *AsyncAPI) execute(ctx context.Context, calls []BatchRequest, results []BatchResult) {
+	p := a.plugin
+	stream, _ := p.connSnapshot()
+	native := stream != nil && p.PanelHasCapability(capAPIBatch)
+
+	ctx = a.api.outgoing(ctx)
+	scope := scopedConn{scope: &p.perms}
+	batch := &pb.ApiBatch{}
+	var sent []int
+	for i, c := range calls {
+		if c.err != nil {
+			results[i].Err = c.err
+			continue
+		}
+		if lr, ok := c.req.(*pb.LogRequest); ok {
+			p.writeLocal(lr.Level, lr.Message)
+		}
+		if !native {
+			reply := c.reply.ProtoReflect().New().Interface()
+			results[i] = c.result(reply, a.api.invoker.Invoke(ctx, c.method, c.req, reply))
+			continue
+		}
+		if _, err := scope.authorize(ctx, c.method); err != nil {
+			results[i].Err = err
+			continue
+		}
+		payload, err := proto.Marshal(c.req)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		batch.Calls = append(batch.Calls, &pb.ApiCall{Method: c.method, Payload: payload})
+		sent = append(sent, i)
+	}
+	if len(sent) == 0 {
+		return
+	}
+
+	res := &pb.ApiBatchResult{}
+	invoker := &apiConn{base: batchConn{p}, opts: a.api.opts, clock: a.api.clock}
+	err := invoker.Invoke(ctx, batchMethod, batch, res)
+	if err == nil && len(res.Results) != len(sent) {
+		err = fmt.Errorf("birdactyl: batch returned %d results for %d calls", len(res.Results), len(sent))
+	}
+	for n, i := range sent {
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		c := calls[i]
+		reply := c.reply.ProtoReflect().New().Interface()
+		results[i] = c.result(reply, panelErr(deniedPermission(decodeAPIResult(res.Results[n], reply), c.method)))
+	}
+}
+
+func (p *Plugin) sendBatch(ctx context.Context, batch *pb.ApiBatch) (*pb.ApiBatchResult, error) {
+	id, call, err := p.addPending()
+	if err != nil {
+		return nil, err
+	}
+	defer p.removePending(id)
+	if err := p.send(&pb.PluginMessage{RequestId: id, Payload: &pb.PluginMessage_ApiBatch{ApiBatch: batch}}); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case msg, ok := <-call.ch:
+		if !ok {
+			return nil, call.err
+		}
+		if res := msg.GetApiBatchResult(); res != nil {
+			return res, nil
+		}
+		if err := decodeAPIResult(msg.GetApiResult(), &pb.Empty{}); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("birdactyl: panel answered batch without a batch result")
+	}
+}
+
+type batchConn struct {
+	p *Plugin
+}
+
+func (c batchConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	res, err := c.p.sendBatch(ctx, args.(*pb.ApiBatch))
+	if err != nil {
+		return err
+	}
+	proto.Merge(reply.(*pb.ApiBatchResult), res)
+	return nil
+}
+
+func (c batchConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "birdactyl: batches do not support streams")
+}
+
+func (c BatchRequest) result(reply proto.Message, err error) BatchResult {
+	switch {
+	case err != nil:
+		return BatchResult{Err: err}
+	case c.decode != nil:
+		return BatchResult{Value: c.decode(reply)}
+	}
+	return BatchResult{Value: reply}
+}
@@ -0,0 +1,42 @@
+package birdactyl_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchRejectedByPanel(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	tp.SetVersion("1.0.0", "api.batch")
+	tp.RejectBatches(status.Error(codes.PermissionDenied, "batches disabled"))
+	p := birdactyl.New("batch", "1.0.0")
+	tp.StartPlugin(p)
+
+	results := p.Async().Batch().
+		Add(birdactyl.BatchLog("info", "one")).
+		Add(birdactyl.BatchLog("info", "two")).
+		Execute(context.Background())
+	for i, r := range results {
+		if !errors.Is(r.Err, birdactyl.ErrPermissionDenied) {
+			t.Errorf("result %d: err = %v, want permission denied", i, r.Err)
+		}
+	}
+}
+
+func TestBatchNative(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	tp.SetVersion("1.0.0", "api.batch")
+	p := birdactyl.New("batch", "1.0.0")
+	tp.StartPlugin(p)
+
+	results := p.Async().Batch().Add(birdactyl.BatchLog("info", "hello")).Execute(context.Background())
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("results = %+v", results)
+	}
+}
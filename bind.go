@@ -0,0 +1,233 @@
+package birdactyl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type ValidatorFunc func(value interface{}, param string) error
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type ValidationErrors []FieldError
+
+func (v ValidationErrors) Error() string {
+	parts := make([]string, len(v))
+	for i, e := range v {
+		parts[i] = e.Field + ": " + e.Message
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+func ValidationError(err error) Response {
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		return Error(400, err.Error())
+	}
+	b := marshalJSON(map[string]interface{}{"success": false, "error": "validation failed", "fields": verrs})
+	return Response{Status: 422, Headers: map[string]string{"Content-Type": "application/json"}, body: b}
+}
+
+func (p *Plugin) RegisterValidator(name string, fn ValidatorFunc) *Plugin {
+	p.regMu.Lock()
+	if p.validators == nil {
+		p.validators = make(map[string]ValidatorFunc)
+	}
+	p.validators[name] = fn
+	p.regMu.Unlock()
+	return p
+}
+
+func (p *Plugin) validator(name string) ValidatorFunc {
+	if p == nil {
+		return nil
+	}
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+	return p.validators[name]
+}
+
+func (r Request) BindJSON(v interface{}) error {
+	if err := json.Unmarshal(r.RawBody, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+func (r Request) BindValid(v interface{}) error {
+	if err := r.BindJSON(v); err != nil {
+		return err
+	}
+	return r.plugin.ValidateStruct(v)
+}
+
+func (p *Plugin) ValidateStruct(v interface{}) error {
+	var errs ValidationErrors
+	p.validateValue(reflect.ValueOf(v), "", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (p *Plugin) validateValue(v reflect.Value, prefix string, errs *ValidationErrors) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name := fieldName(f)
+			if name == "-" {
+				continue
+			}
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			fv := v.Field(i)
+			if tag := f.Tag.Get("validate"); tag != "" {
+				if !p.checkField(fv, name, tag, errs) {
+					continue
+				}
+			}
+			p.validateValue(fv, name, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			p.validateValue(v.Index(i), fmt.Sprintf("%s[%d]", prefix, i), errs)
+		}
+	}
+}
+
+func fieldName(f reflect.StructField) string {
+	if tag := f.Tag.Get("json"); tag != "" {
+		if name, _, _ := strings.Cut(tag, ","); name != "" {
+			return name
+		}
+	}
+	return f.Name
+}
+
+func (p *Plugin) checkField(v reflect.Value, field, tag string, errs *ValidationErrors) bool {
+	empty := v.IsZero()
+	for !empty && v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "" {
+			continue
+		}
+		if name == "required" {
+			if empty {
+				*errs = append(*errs, FieldError{Field: field, Rule: name, Message: "is required"})
+				return false
+			}
+			continue
+		}
+		if empty && name != "omitempty" {
+			continue
+		}
+		msg := p.checkRule(v, name, param)
+		if msg != "" {
+			*errs = append(*errs, FieldError{Field: field, Rule: name, Message: msg})
+		}
+	}
+	return true
+}
+
+func (p *Plugin) checkRule(v reflect.Value, rule, param string) string {
+	switch rule {
+	case "omitempty":
+		return ""
+	case "min", "max", "len":
+		limit, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return fmt.Sprintf("has an invalid %s rule %q", rule, param)
+		}
+		n, isLen := measure(v)
+		what := "must be"
+		if isLen {
+			what = "length must be"
+		}
+		switch {
+		case rule == "min" && n < limit:
+			return fmt.Sprintf("%s at least %s", what, param)
+		case rule == "max" && n > limit:
+			return fmt.Sprintf("%s at most %s", what, param)
+		case rule == "len" && n != limit:
+			return fmt.Sprintf("%s exactly %s", what, param)
+		}
+	case "oneof":
+		s := fmt.Sprint(v.Interface())
+		for _, opt := range strings.Fields(param) {
+			if s == opt {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(strings.Fields(param), ", ")
+	case "email":
+		if _, err := mail.ParseAddress(fmt.Sprint(v.Interface())); err != nil {
+			return "must be a valid email address"
+		}
+	case "cron":
+		if p.validator(rule) != nil {
+			return p.customRule(v, rule, param)
+		}
+		if _, err := parseCron(fmt.Sprint(v.Interface())); err != nil {
+			return "must be a valid cron expression"
+		}
+	case "url":
+		if u, err := url.Parse(fmt.Sprint(v.Interface())); err != nil || u.Scheme == "" || u.Host == "" {
+			return "must be a valid URL"
+		}
+	default:
+		return p.customRule(v, rule, param)
+	}
+	return ""
+}
+
+func (p *Plugin) customRule(v reflect.Value, rule, param string) string {
+	fn := p.validator(rule)
+	if fn == nil {
+		return fmt.Sprintf("has unknown validation rule %q", rule)
+	}
+	if err := fn(v.Interface(), param); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func measure(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return float64(len([]rune(v.String()))), true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(v.Len()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), false
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), false
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), false
+	}
+	return 0, false
+}
@@ -0,0 +1,171 @@
+package birdactyltest
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type ActionFS interface {
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte) error
+	MkdirAll(name string) error
+	Remove(name string) error
+	Exists(name string) bool
+}
+
+type MemFS struct {
+	mu    sync.Mutex
+	files map[string][]byte
+	dirs  map[string]bool
+}
+
+func NewMemFS() *MemFS {
+	return &MemFS{files: make(map[string][]byte), dirs: map[string]bool{".": true}}
+}
+
+func (m *MemFS) ReadFile(name string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.files[path.Clean(name)]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+	return append([]byte(nil), data...), nil
+}
+
+func (m *MemFS) WriteFile(name string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name = path.Clean(name)
+	m.mkdirAll(path.Dir(name))
+	m.files[name] = append([]byte(nil), data...)
+	return nil
+}
+
+func (m *MemFS) MkdirAll(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mkdirAll(path.Clean(name))
+	return nil
+}
+
+func (m *MemFS) mkdirAll(dir string) {
+	for dir != "." && dir != "/" && !m.dirs[dir] {
+		m.dirs[dir] = true
+		dir = path.Dir(dir)
+	}
+}
+
+func (m *MemFS) Remove(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name = path.Clean(name)
+	if _, ok := m.files[name]; ok {
+		delete(m.files, name)
+		return nil
+	}
+	if !m.dirs[name] {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+	prefix := name + "/"
+	for f := range m.files {
+		if strings.HasPrefix(f, prefix) {
+			delete(m.files, f)
+		}
+	}
+	for d := range m.dirs {
+		if d == name || strings.HasPrefix(d, prefix) {
+			delete(m.dirs, d)
+		}
+	}
+	return nil
+}
+
+func (m *MemFS) Exists(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name = path.Clean(name)
+	_, ok := m.files[name]
+	return ok || m.dirs[name]
+}
+
+func (m *MemFS) Files() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]string, 0, len(m.files))
+	for f := range m.files {
+		out = append(out, f)
+	}
+	sort.Strings(out)
+	return out
+}
+
+type dirFS struct {
+	root string
+}
+
+func DirFS(root string) ActionFS {
+	return dirFS{root: root}
+}
+
+func (d dirFS) path(name string) (string, error) {
+	clean := path.Clean("/" + name)
+	full := filepath.Join(d.root, filepath.FromSlash(clean))
+	if rel, err := filepath.Rel(d.root, full); err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("path %q escapes %s", name, d.root)
+	}
+	return full, nil
+}
+
+func (d dirFS) ReadFile(name string) ([]byte, error) {
+	p, err := d.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(p)
+}
+
+func (d dirFS) WriteFile(name string, data []byte) error {
+	p, err := d.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0644)
+}
+
+func (d dirFS) MkdirAll(name string) error {
+	p, err := d.path(name)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(p, 0755)
+}
+
+func (d dirFS) Remove(name string) error {
+	p, err := d.path(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(p); err != nil {
+		return err
+	}
+	return os.RemoveAll(p)
+}
+
+func (d dirFS) Exists(name string) bool {
+	p, err := d.path(name)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(p)
+	return err == nil
+}
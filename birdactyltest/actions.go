@@ -0,0 +1,302 @@
+package birdactyltest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+)
+
+type ActionFailure struct {
+	Index  int
+	Action birdactyl.AddonInstallAction
+	Reason string
+}
+
+func (f ActionFailure) Error() string {
+	return fmt.Sprintf("action #%d (%s %s): %s", f.Index, actionName(f.Action.Type), f.Action.Path, f.Reason)
+}
+
+type NodeCall struct {
+	Endpoint string
+	Payload  []byte
+}
+
+type ActionResult struct {
+	Commands  []string
+	NodeCalls []NodeCall
+	Skipped   []int
+	Failures  []ActionFailure
+}
+
+func (r *ActionResult) OK() bool {
+	return len(r.Failures) == 0
+}
+
+type ExecOption func(*executor)
+
+func WithDownloads(handlers map[string]http.Handler) ExecOption {
+	return func(e *executor) {
+		for url, h := range handlers {
+			e.downloads[url] = h
+		}
+	}
+}
+
+func WithDownloadFiles(files map[string][]byte) ExecOption {
+	return func(e *executor) {
+		for url, data := range files {
+			data := data
+			e.downloads[url] = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write(data) })
+		}
+	}
+}
+
+func WithServerVariables(vars map[string]string) ExecOption {
+	return func(e *executor) {
+		e.vars = vars
+	}
+}
+
+type executor struct {
+	fsys      ActionFS
+	downloads map[string]http.Handler
+	vars      map[string]string
+	result    *ActionResult
+}
+
+func ExecuteActions(t testing.TB, actions []birdactyl.AddonInstallAction, fsys ActionFS, opts ...ExecOption) *ActionResult {
+	t.Helper()
+	e := &executor{fsys: fsys, downloads: make(map[string]http.Handler), result: &ActionResult{}}
+	for _, opt := range opts {
+		opt(e)
+	}
+	for i, a := range actions {
+		match, reason := e.matches(a.Conditions)
+		if reason == "" && !match {
+			e.result.Skipped = append(e.result.Skipped, i)
+			continue
+		}
+		if reason == "" {
+			reason = e.run(a)
+		}
+		if reason != "" {
+			f := ActionFailure{Index: i, Action: a, Reason: reason}
+			e.result.Failures = append(e.result.Failures, f)
+			t.Logf("birdactyltest: %v", f)
+		}
+	}
+	return e.result
+}
+
+func (e *executor) matches(conds []birdactyl.ActionCondition) (bool, string) {
+	for _, c := range conds {
+		switch c.Kind {
+		case birdactyl.CondVariableEquals:
+			if e.vars[c.Variable] != c.Value {
+				return false, ""
+			}
+		case birdactyl.CondFileExists, birdactyl.CondFileMissing:
+			p, ok := safePath(c.Path)
+			if !ok {
+				return false, fmt.Sprintf("condition path %q escapes the server root", c.Path)
+			}
+			if e.fsys.Exists(p) != (c.Kind == birdactyl.CondFileExists) {
+				return false, ""
+			}
+		default:
+			return false, fmt.Sprintf("unknown condition kind %d", c.Kind)
+		}
+	}
+	return true, ""
+}
+
+func (e *executor) run(a birdactyl.AddonInstallAction) string {
+	switch a.Type {
+	case birdactyl.ActionRunCommand:
+		if strings.TrimSpace(a.Command) == "" {
+			return "empty command"
+		}
+		e.result.Commands = append(e.result.Commands, a.Command)
+		return ""
+	case birdactyl.ActionProxyToNode:
+		if a.NodeEndpoint == "" {
+			return "empty node endpoint"
+		}
+		e.result.NodeCalls = append(e.result.NodeCalls, NodeCall{Endpoint: a.NodeEndpoint, Payload: a.NodePayload})
+		return ""
+	}
+
+	p, ok := safePath(a.Path)
+	if !ok {
+		return fmt.Sprintf("path %q escapes the server root", a.Path)
+	}
+
+	switch a.Type {
+	case birdactyl.ActionDownloadFile:
+		h, ok := e.downloads[a.URL]
+		if !ok {
+			return fmt.Sprintf("no test download registered for %s", a.URL)
+		}
+		req := httptest.NewRequest(http.MethodGet, a.URL, nil)
+		for k, v := range a.Headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code >= 300 {
+			return fmt.Sprintf("download of %s returned %d", a.URL, rec.Code)
+		}
+		return errReason(e.fsys.WriteFile(p, rec.Body.Bytes()))
+	case birdactyl.ActionExtractArchive:
+		if !e.fsys.Exists(p) {
+			return "extracting a file that was never downloaded or written"
+		}
+		data, err := e.fsys.ReadFile(p)
+		if err != nil {
+			return err.Error()
+		}
+		return e.extract(p, data)
+	case birdactyl.ActionDeleteFile:
+		if !e.fsys.Exists(p) {
+			return "deleting a path that does not exist"
+		}
+		return errReason(e.fsys.Remove(p))
+	case birdactyl.ActionCreateFolder:
+		return errReason(e.fsys.MkdirAll(p))
+	case birdactyl.ActionWriteFile:
+		r := a.ContentReader()
+		if c, ok := r.(io.Closer); ok {
+			defer c.Close()
+		}
+		content, err := io.ReadAll(io.LimitReader(r, birdactyl.DefaultMaxStreamedFileSize+1))
+		if err != nil {
+			return "reading content: " + err.Error()
+		}
+		if len(content) > birdactyl.DefaultMaxStreamedFileSize {
+			return fmt.Sprintf("content exceeds the %d byte limit", birdactyl.DefaultMaxStreamedFileSize)
+		}
+		return errReason(e.fsys.WriteFile(p, content))
+	}
+	return fmt.Sprintf("unknown action type %d", a.Type)
+}
+
+func (e *executor) extract(archive string, data []byte) string {
+	dir := path.Dir(archive)
+	write := func(name string, r io.Reader) string {
+		joined := path.Join(dir, name)
+		target, ok := safePath(joined)
+		if _, entryOK := safePath(name); !ok || !entryOK || (dir != "." && !strings.HasPrefix(joined, dir+"/")) {
+			return fmt.Sprintf("archive entry %q escapes the extraction directory", name)
+		}
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return err.Error()
+		}
+		return errReason(e.fsys.WriteFile(target, content))
+	}
+
+	switch {
+	case strings.HasSuffix(archive, ".zip"):
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return "invalid zip: " + err.Error()
+		}
+		for _, f := range zr.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return err.Error()
+			}
+			reason := write(f.Name, rc)
+			rc.Close()
+			if reason != "" {
+				return reason
+			}
+		}
+		return ""
+	case strings.HasSuffix(archive, ".tar.gz"), strings.HasSuffix(archive, ".tgz"), strings.HasSuffix(archive, ".tar"):
+		var r io.Reader = bytes.NewReader(data)
+		if !strings.HasSuffix(archive, ".tar") {
+			gz, err := gzip.NewReader(r)
+			if err != nil {
+				return "invalid gzip: " + err.Error()
+			}
+			r = gz
+		}
+		tr := tar.NewReader(r)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return ""
+			}
+			if err != nil {
+				return "invalid tar: " + err.Error()
+			}
+			if hdr.Typeflag != tar.TypeReg {
+				continue
+			}
+			if reason := write(hdr.Name, tr); reason != "" {
+				return reason
+			}
+		}
+	}
+	return fmt.Sprintf("unsupported archive format %q", path.Base(archive))
+}
+
+func safePath(p string) (string, bool) {
+	if p == "" || path.IsAbs(p) || filepath.IsAbs(p) || volumePrefixed(p) || strings.HasPrefix(p, "\\") {
+		return "", false
+	}
+	clean := path.Clean(p)
+	if clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", false
+	}
+	return clean, true
+}
+
+func volumePrefixed(p string) bool {
+	if filepath.VolumeName(p) != "" {
+		return true
+	}
+	return len(p) >= 2 && p[1] == ':' && (p[0] >= 'a' && p[0] <= 'z' || p[0] >= 'A' && p[0] <= 'Z')
+}
+
+func errReason(err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func actionName(t birdactyl.AddonActionType) string {
+	switch t {
+	case birdactyl.ActionDownloadFile:
+		return "download"
+	case birdactyl.ActionExtractArchive:
+		return "extract"
+	case birdactyl.ActionDeleteFile:
+		return "delete"
+	case birdactyl.ActionCreateFolder:
+		return "mkdir"
+	case birdactyl.ActionWriteFile:
+		return "write"
+	case birdactyl.ActionRunCommand:
+		return "command"
+	case birdactyl.ActionProxyToNode:
+		return "proxy"
+	}
+	return "unknown"
+}
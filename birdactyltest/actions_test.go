@@ -0,0 +1,37 @@
+package birdactyltest_test
+
+import (
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestExecuteActionsRejectsAbsolutePaths(t *testing.T) {
+	tests := []string{
+		"/etc/passwd",
+		`\windows\system32`,
+		`C:\Windows\win.ini`,
+		"c:relative.txt",
+		`\\server\share\file`,
+		"../outside.txt",
+	}
+	for _, p := range tests {
+		fsys := birdactyltest.NewMemFS()
+		res := birdactyltest.ExecuteActions(t, []birdactyl.AddonInstallAction{birdactyl.WriteFile(p, []byte("x"))}, fsys)
+		if res.OK() {
+			t.Errorf("WriteFile(%q) succeeded, want a path failure", p)
+		}
+		if files := fsys.Files(); len(files) != 0 {
+			t.Errorf("WriteFile(%q) wrote %v", p, files)
+		}
+	}
+}
+
+func TestExecuteActionsAllowsRelativePaths(t *testing.T) {
+	fsys := birdactyltest.NewMemFS()
+	res := birdactyltest.ExecuteActions(t, []birdactyl.AddonInstallAction{birdactyl.WriteFile("plugins/config.yml", []byte("x"))}, fsys)
+	if !res.OK() || !fsys.Exists("plugins/config.yml") {
+		t.Fatalf("relative write failed: %v", res.Failures)
+	}
+}
@@ -0,0 +1,80 @@
+package birdactyltest
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func (tp *Panel) RejectBatches(err error) {
+	tp.mu.Lock()
+	tp.batchErr = err
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) runBatch(requestID string, batch *pb.ApiBatch) {
+	tp.mu.Lock()
+	if err := tp.batchErr; err != nil {
+		tp.mu.Unlock()
+		tp.send(&pb.PanelMessage{RequestId: requestID, Payload: &pb.PanelMessage_ApiResult{ApiResult: errorResult(err)}})
+		return
+	}
+	md := metadata.Pairs("x-plugin-id", tp.info.GetId())
+	if instance := tp.info.GetInstanceId(); instance != "" {
+		md.Set("x-plugin-instance", instance)
+	}
+	tp.mu.Unlock()
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	out := &pb.ApiBatchResult{Results: make([]*pb.ApiResult, len(batch.Calls))}
+	for i, call := range batch.Calls {
+		out.Results[i] = tp.invoke(ctx, call)
+	}
+	tp.send(&pb.PanelMessage{RequestId: requestID, Payload: &pb.PanelMessage_ApiBatchResult{ApiBatchResult: out}})
+}
+
+func (tp *Panel) invoke(ctx context.Context, call *pb.ApiCall) *pb.ApiResult {
+	name := call.Method[strings.LastIndex(call.Method, "/")+1:]
+	md := pb.File_plugin_proto.Services().ByName("PanelService").Methods().ByName(protoreflect.Name(name))
+	if md == nil || md.IsStreamingClient() || md.IsStreamingServer() {
+		return unimplemented(call.Method)
+	}
+	req, err := newMessage(md.Input().FullName())
+	if err != nil {
+		return unimplemented(call.Method)
+	}
+	reply, err := newMessage(md.Output().FullName())
+	if err != nil {
+		return unimplemented(call.Method)
+	}
+	if err := proto.Unmarshal(call.Payload, req); err != nil {
+		return errorResult(err)
+	}
+	if err := tp.conn.Invoke(ctx, call.Method, req, reply); err != nil {
+		return errorResult(err)
+	}
+	payload, err := proto.Marshal(reply)
+	if err != nil {
+		return errorResult(err)
+	}
+	return &pb.ApiResult{Payload: payload}
+}
+
+func newMessage(name protoreflect.FullName) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+func errorResult(err error) *pb.ApiResult {
+	st, _ := proto.Marshal(status.Convert(err).Proto())
+	return &pb.ApiResult{Status: st}
+}
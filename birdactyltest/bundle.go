@@ -0,0 +1,70 @@
+package birdactyltest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (tp *Panel) FailBundleUploads(n int) {
+	tp.mu.Lock()
+	tp.bundleFails = n
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) Bundles() []*pb.BundleUpload {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return append([]*pb.BundleUpload(nil), tp.bundles...)
+}
+
+func (tp *Panel) UploadBundle(stream pb.PanelService_UploadBundleServer) error {
+	var buf bytes.Buffer
+	var hash, checksum string
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		hash = chunk.Hash
+		buf.Write(chunk.Data)
+		if chunk.Eof {
+			checksum = chunk.Checksum
+		}
+	}
+
+	tp.mu.Lock()
+	fail := tp.bundleFails > 0
+	if fail {
+		tp.bundleFails--
+	}
+	tp.mu.Unlock()
+	if fail {
+		return status.Error(codes.Unavailable, "birdactyltest: bundle upload failed")
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	if checksum != hex.EncodeToString(sum[:]) {
+		return stream.SendAndClose(&pb.BundleUploadResult{Error: "checksum mismatch"})
+	}
+	var upload pb.BundleUpload
+	if err := proto.Unmarshal(buf.Bytes(), &upload); err != nil {
+		return stream.SendAndClose(&pb.BundleUploadResult{Error: err.Error()})
+	}
+	if upload.Hash != hash {
+		return stream.SendAndClose(&pb.BundleUploadResult{Error: "hash mismatch"})
+	}
+	tp.mu.Lock()
+	tp.bundles = append(tp.bundles, &upload)
+	tp.mu.Unlock()
+	return stream.SendAndClose(&pb.BundleUploadResult{Stored: true})
+}
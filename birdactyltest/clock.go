@@ -0,0 +1,169 @@
+package birdactyltest
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+)
+
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	clock  *FakeClock
+	at     time.Time
+	period time.Duration
+	ch     chan time.Time
+	active bool
+}
+
+var _ birdactyl.Clock = (*FakeClock)(nil)
+
+func NewFakeClock(start time.Time) *FakeClock {
+	c := &FakeClock{now: start}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) NewTimer(d time.Duration) birdactyl.Timer {
+	return c.add(d, 0)
+}
+
+func (c *FakeClock) NewTicker(d time.Duration) birdactyl.Ticker {
+	if d <= 0 {
+		panic("birdactyltest: non-positive ticker interval")
+	}
+	return fakeTicker{c.add(d, d)}
+}
+
+func (c *FakeClock) add(d, period time.Duration) *fakeWaiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &fakeWaiter{clock: c, at: c.now.Add(d), period: period, ch: make(chan time.Time, 1), active: true}
+	c.waiters = append(c.waiters, w)
+	if d <= 0 {
+		c.fire(w)
+	}
+	c.cond.Broadcast()
+	return w
+}
+
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	target := c.now.Add(d)
+	for {
+		due := c.due(target)
+		if due == nil {
+			break
+		}
+		c.now = due.at
+		c.fire(due)
+	}
+	c.now = target
+}
+
+func (c *FakeClock) due(target time.Time) *fakeWaiter {
+	var active []*fakeWaiter
+	for _, w := range c.waiters {
+		if w.active {
+			active = append(active, w)
+		}
+	}
+	c.waiters = active
+	sort.SliceStable(active, func(i, j int) bool { return active[i].at.Before(active[j].at) })
+	if len(active) > 0 && !active[0].at.After(target) {
+		return active[0]
+	}
+	return nil
+}
+
+func (c *FakeClock) fire(w *fakeWaiter) {
+	select {
+	case w.ch <- w.at:
+	default:
+	}
+	if w.period > 0 {
+		w.at = w.at.Add(w.period)
+	} else {
+		w.active = false
+	}
+}
+
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.pending() < n {
+		c.cond.Wait()
+	}
+}
+
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pending()
+}
+
+func (c *FakeClock) pending() int {
+	n := 0
+	for _, w := range c.waiters {
+		if w.active {
+			n++
+		}
+	}
+	return n
+}
+
+func (w *fakeWaiter) C() <-chan time.Time {
+	return w.ch
+}
+
+func (w *fakeWaiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	was := w.active
+	w.active = false
+	return was
+}
+
+func (w *fakeWaiter) Reset(d time.Duration) bool {
+	c := w.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	was := w.active
+	w.at = c.now.Add(d)
+	w.active = true
+	listed := false
+	for _, other := range c.waiters {
+		listed = listed || other == w
+	}
+	if !listed {
+		c.waiters = append(c.waiters, w)
+	}
+	c.cond.Broadcast()
+	return was
+}
+
+type fakeTicker struct {
+	w *fakeWaiter
+}
+
+func (t fakeTicker) C() <-chan time.Time {
+	return t.w.ch
+}
+
+func (t fakeTicker) Stop() {
+	t.w.Stop()
+}
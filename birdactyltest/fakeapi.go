@@ -0,0 +1,657 @@
+package birdactyltest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+)
+
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+type FakeAPI struct {
+	ServerFunc                        func(ctx context.Context, id string) (*birdactyl.Server, error)
+	ServersFunc                       func(ctx context.Context, filter birdactyl.ServerFilter) ([]birdactyl.Server, error)
+	SearchServersFunc                 func(ctx context.Context, query string) ([]birdactyl.Server, error)
+	CreateServerContextFunc           func(ctx context.Context, spec birdactyl.ServerSpec) (*birdactyl.Server, error)
+	DeleteServerContextFunc           func(ctx context.Context, id string, force bool) error
+	SuspendServerContextFunc          func(ctx context.Context, id string) error
+	UnsuspendServerContextFunc        func(ctx context.Context, id string) error
+	PowerActionFunc                   func(ctx context.Context, serverID string, action birdactyl.PowerAction) error
+	SendCommandContextFunc            func(ctx context.Context, serverID, command string) error
+	StreamConsoleContextFunc          func(ctx context.Context, serverID string) (<-chan birdactyl.ConsoleLine, error)
+	StreamStatusFunc                  func(ctx context.Context, serverID string) (<-chan birdactyl.StatusChange, error)
+	ReadFileContextFunc               func(ctx context.Context, serverID, filePath string) ([]byte, error)
+	WriteFileContextFunc              func(ctx context.Context, serverID, filePath string, data []byte) error
+	ListDirFunc                       func(ctx context.Context, serverID, dirPath string) ([]birdactyl.FileEntry, error)
+	DeleteFileContextFunc             func(ctx context.Context, serverID, filePath string) error
+	UserFunc                          func(ctx context.Context, id string) (*birdactyl.User, error)
+	UsersFunc                         func(ctx context.Context, filter birdactyl.UserFilter) (birdactyl.UserPage, error)
+	UserServersFunc                   func(ctx context.Context, userID string) ([]birdactyl.Server, error)
+	NodesFunc                         func(ctx context.Context) ([]birdactyl.Node, error)
+	NodeStatsFunc                     func(ctx context.Context, nodeID string) (*birdactyl.NodeStats, error)
+	WatchNodeStatsFunc                func(ctx context.Context, interval time.Duration) (<-chan []birdactyl.NodeStats, error)
+	AllocationsFunc                   func(ctx context.Context, nodeID string, filter birdactyl.AllocFilter) ([]birdactyl.Allocation, error)
+	CreateAllocationFunc              func(ctx context.Context, nodeID, ip string, ports []int) error
+	AssignAllocationFunc              func(ctx context.Context, serverID, allocationID string) error
+	ReleaseAllocationFunc             func(ctx context.Context, allocationID string) error
+	FindFreeAllocationFunc            func(ctx context.Context, nodeID string, ports birdactyl.PortRange) (*birdactyl.Allocation, error)
+	PackagesFunc                      func(ctx context.Context) ([]birdactyl.Package, error)
+	PackageFunc                       func(ctx context.Context, id string) (*birdactyl.Package, error)
+	DatabasesFunc                     func(ctx context.Context, serverID string) ([]birdactyl.Database, error)
+	CreateDatabaseContextFunc         func(ctx context.Context, serverID, name, allowedHost string) (*birdactyl.DatabaseCredentials, error)
+	RotateDatabasePasswordContextFunc func(ctx context.Context, databaseID string) (*birdactyl.DatabaseCredentials, error)
+	DeleteDatabaseContextFunc         func(ctx context.Context, databaseID string) error
+	BackupsFunc                       func(ctx context.Context, serverID string) ([]birdactyl.Backup, error)
+	BackupFunc                        func(ctx context.Context, serverID, backupID string) (*birdactyl.Backup, error)
+	CreateBackupContextFunc           func(ctx context.Context, serverID, name string) (*birdactyl.Backup, error)
+	DeleteBackupContextFunc           func(ctx context.Context, serverID, backupID string) error
+	RestoreBackupFunc                 func(ctx context.Context, serverID, backupID string, truncate bool) error
+	ServerSchedulesFunc               func(ctx context.Context, serverID string) ([]birdactyl.ServerSchedule, error)
+	CreateServerScheduleFunc          func(ctx context.Context, serverID string, spec birdactyl.ScheduleSpec) (*birdactyl.ServerSchedule, error)
+	DeleteServerScheduleFunc          func(ctx context.Context, scheduleID string) error
+	AddScheduleTaskFunc               func(ctx context.Context, scheduleID string, task birdactyl.TaskSpec) (*birdactyl.ScheduleTask, error)
+	DeleteScheduleTaskFunc            func(ctx context.Context, taskID string) error
+	SubusersFunc                      func(ctx context.Context, serverID string) ([]birdactyl.Subuser, error)
+	AddSubuserContextFunc             func(ctx context.Context, serverID, email string, permissions []string) (*birdactyl.Subuser, error)
+	UpdateSubuserPermissionsFunc      func(ctx context.Context, serverID, subuserID string, permissions []string) error
+	RemoveSubuserContextFunc          func(ctx context.Context, serverID, subuserID string) error
+	SubuserPermissionInfoFunc         func(ctx context.Context) ([]birdactyl.SubuserPermissionInfo, error)
+	ValidateSubuserPermissionsFunc    func(ctx context.Context, permissions []string) error
+	NotifyUserFunc                    func(ctx context.Context, userID string, n birdactyl.Notification) error
+	NotifyAdminsFunc                  func(ctx context.Context, n birdactyl.Notification) error
+	SendEmailFunc                     func(ctx context.Context, userID, subject, htmlBody string) error
+	AuditFunc                         func(ctx context.Context, entry birdactyl.AuditEntry) error
+	AuditBatchFunc                    func(ctx context.Context, entries []birdactyl.AuditEntry) error
+	CallPluginRouteFunc               func(ctx context.Context, pluginID, method, path string, body []byte) (*birdactyl.PluginCallResponse, error)
+	PluginsFunc                       func(ctx context.Context) ([]birdactyl.PluginSummary, error)
+	WatchServerSettingsFunc           func(ctx context.Context, serverID string) (<-chan birdactyl.ServerSettingChange, error)
+	KVStore                           birdactyl.KV
+
+	mu       sync.Mutex
+	calls    []Call
+	seq      int
+	settings map[string]map[string]string
+}
+
+var _ birdactyl.PanelAPI = (*FakeAPI)(nil)
+
+func NewFakeAPI() *FakeAPI {
+	return &FakeAPI{KVStore: birdactyl.NewMemoryKV()}
+}
+
+func (f *FakeAPI) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Call(nil), f.calls...)
+}
+
+func (f *FakeAPI) CallsTo(method string) []Call {
+	var out []Call
+	for _, c := range f.Calls() {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (f *FakeAPI) Reset() {
+	f.mu.Lock()
+	f.calls = nil
+	f.mu.Unlock()
+}
+
+func (f *FakeAPI) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+	f.mu.Unlock()
+}
+
+func (f *FakeAPI) nextID(kind string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.seq++
+	return fmt.Sprintf("fake-%s-%d", kind, f.seq)
+}
+
+func (f *FakeAPI) KV() birdactyl.KV {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.KVStore == nil {
+		f.KVStore = birdactyl.NewMemoryKV()
+	}
+	return f.KVStore
+}
+
+func closedChan[T any]() <-chan T {
+	ch := make(chan T)
+	close(ch)
+	return ch
+}
+
+func (f *FakeAPI) Server(ctx context.Context, id string) (*birdactyl.Server, error) {
+	f.record("Server", id)
+	if f.ServerFunc != nil {
+		return f.ServerFunc(ctx, id)
+	}
+	return nil, birdactyl.ErrNotFound
+}
+
+func (f *FakeAPI) Servers(ctx context.Context, filter birdactyl.ServerFilter) ([]birdactyl.Server, error) {
+	f.record("Servers", filter)
+	if f.ServersFunc != nil {
+		return f.ServersFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) SearchServers(ctx context.Context, query string) ([]birdactyl.Server, error) {
+	f.record("SearchServers", query)
+	if f.SearchServersFunc != nil {
+		return f.SearchServersFunc(ctx, query)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) CreateServerContext(ctx context.Context, spec birdactyl.ServerSpec) (*birdactyl.Server, error) {
+	f.record("CreateServerContext", spec)
+	if f.CreateServerContextFunc != nil {
+		return f.CreateServerContextFunc(ctx, spec)
+	}
+	return &birdactyl.Server{ID: f.nextID("server"), Name: spec.Name, OwnerID: spec.OwnerID, NodeID: spec.NodeID}, nil
+}
+
+func (f *FakeAPI) DeleteServerContext(ctx context.Context, id string, force bool) error {
+	f.record("DeleteServerContext", id, force)
+	if f.DeleteServerContextFunc != nil {
+		if err := f.DeleteServerContextFunc(ctx, id, force); err != nil {
+			return err
+		}
+	}
+	f.mu.Lock()
+	delete(f.settings, id)
+	f.mu.Unlock()
+	return nil
+}
+
+func (f *FakeAPI) SuspendServerContext(ctx context.Context, id string) error {
+	f.record("SuspendServerContext", id)
+	if f.SuspendServerContextFunc != nil {
+		return f.SuspendServerContextFunc(ctx, id)
+	}
+	return nil
+}
+
+func (f *FakeAPI) UnsuspendServerContext(ctx context.Context, id string) error {
+	f.record("UnsuspendServerContext", id)
+	if f.UnsuspendServerContextFunc != nil {
+		return f.UnsuspendServerContextFunc(ctx, id)
+	}
+	return nil
+}
+
+func (f *FakeAPI) PowerAction(ctx context.Context, serverID string, action birdactyl.PowerAction) error {
+	f.record("PowerAction", serverID, action)
+	if f.PowerActionFunc != nil {
+		return f.PowerActionFunc(ctx, serverID, action)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SendCommandContext(ctx context.Context, serverID, command string) error {
+	f.record("SendCommandContext", serverID, command)
+	if f.SendCommandContextFunc != nil {
+		return f.SendCommandContextFunc(ctx, serverID, command)
+	}
+	return nil
+}
+
+func (f *FakeAPI) StreamConsoleContext(ctx context.Context, serverID string) (<-chan birdactyl.ConsoleLine, error) {
+	f.record("StreamConsoleContext", serverID)
+	if f.StreamConsoleContextFunc != nil {
+		return f.StreamConsoleContextFunc(ctx, serverID)
+	}
+	return closedChan[birdactyl.ConsoleLine](), nil
+}
+
+func (f *FakeAPI) StreamStatus(ctx context.Context, serverID string) (<-chan birdactyl.StatusChange, error) {
+	f.record("StreamStatus", serverID)
+	if f.StreamStatusFunc != nil {
+		return f.StreamStatusFunc(ctx, serverID)
+	}
+	return closedChan[birdactyl.StatusChange](), nil
+}
+
+func (f *FakeAPI) ReadFileContext(ctx context.Context, serverID, filePath string) ([]byte, error) {
+	f.record("ReadFileContext", serverID, filePath)
+	if f.ReadFileContextFunc != nil {
+		return f.ReadFileContextFunc(ctx, serverID, filePath)
+	}
+	return nil, birdactyl.ErrNotFound
+}
+
+func (f *FakeAPI) WriteFileContext(ctx context.Context, serverID, filePath string, data []byte) error {
+	f.record("WriteFileContext", serverID, filePath, data)
+	if f.WriteFileContextFunc != nil {
+		return f.WriteFileContextFunc(ctx, serverID, filePath, data)
+	}
+	return nil
+}
+
+func (f *FakeAPI) ListDir(ctx context.Context, serverID, dirPath string) ([]birdactyl.FileEntry, error) {
+	f.record("ListDir", serverID, dirPath)
+	if f.ListDirFunc != nil {
+		return f.ListDirFunc(ctx, serverID, dirPath)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) DeleteFileContext(ctx context.Context, serverID, filePath string) error {
+	f.record("DeleteFileContext", serverID, filePath)
+	if f.DeleteFileContextFunc != nil {
+		return f.DeleteFileContextFunc(ctx, serverID, filePath)
+	}
+	return nil
+}
+
+func (f *FakeAPI) User(ctx context.Context, id string) (*birdactyl.User, error) {
+	f.record("User", id)
+	if f.UserFunc != nil {
+		return f.UserFunc(ctx, id)
+	}
+	return nil, birdactyl.ErrNotFound
+}
+
+func (f *FakeAPI) Users(ctx context.Context, filter birdactyl.UserFilter) (birdactyl.UserPage, error) {
+	f.record("Users", filter)
+	if f.UsersFunc != nil {
+		return f.UsersFunc(ctx, filter)
+	}
+	return birdactyl.UserPage{Limit: filter.Limit, Offset: filter.Offset}, nil
+}
+
+func (f *FakeAPI) UserServers(ctx context.Context, userID string) ([]birdactyl.Server, error) {
+	f.record("UserServers", userID)
+	if f.UserServersFunc != nil {
+		return f.UserServersFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) Nodes(ctx context.Context) ([]birdactyl.Node, error) {
+	f.record("Nodes")
+	if f.NodesFunc != nil {
+		return f.NodesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) NodeStats(ctx context.Context, nodeID string) (*birdactyl.NodeStats, error) {
+	f.record("NodeStats", nodeID)
+	if f.NodeStatsFunc != nil {
+		return f.NodeStatsFunc(ctx, nodeID)
+	}
+	return nil, birdactyl.ErrNotFound
+}
+
+func (f *FakeAPI) WatchNodeStats(ctx context.Context, interval time.Duration) (<-chan []birdactyl.NodeStats, error) {
+	f.record("WatchNodeStats", interval)
+	if f.WatchNodeStatsFunc != nil {
+		return f.WatchNodeStatsFunc(ctx, interval)
+	}
+	return closedChan[[]birdactyl.NodeStats](), nil
+}
+
+func (f *FakeAPI) Allocations(ctx context.Context, nodeID string, filter birdactyl.AllocFilter) ([]birdactyl.Allocation, error) {
+	f.record("Allocations", nodeID, filter)
+	if f.AllocationsFunc != nil {
+		return f.AllocationsFunc(ctx, nodeID, filter)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) CreateAllocation(ctx context.Context, nodeID, ip string, ports []int) error {
+	f.record("CreateAllocation", nodeID, ip, ports)
+	if f.CreateAllocationFunc != nil {
+		return f.CreateAllocationFunc(ctx, nodeID, ip, ports)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AssignAllocation(ctx context.Context, serverID, allocationID string) error {
+	f.record("AssignAllocation", serverID, allocationID)
+	if f.AssignAllocationFunc != nil {
+		return f.AssignAllocationFunc(ctx, serverID, allocationID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) ReleaseAllocation(ctx context.Context, allocationID string) error {
+	f.record("ReleaseAllocation", allocationID)
+	if f.ReleaseAllocationFunc != nil {
+		return f.ReleaseAllocationFunc(ctx, allocationID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) FindFreeAllocation(ctx context.Context, nodeID string, ports birdactyl.PortRange) (*birdactyl.Allocation, error) {
+	f.record("FindFreeAllocation", nodeID, ports)
+	if f.FindFreeAllocationFunc != nil {
+		return f.FindFreeAllocationFunc(ctx, nodeID, ports)
+	}
+	return nil, birdactyl.ErrNoFreeAllocation
+}
+
+func (f *FakeAPI) Packages(ctx context.Context) ([]birdactyl.Package, error) {
+	f.record("Packages")
+	if f.PackagesFunc != nil {
+		return f.PackagesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) Package(ctx context.Context, id string) (*birdactyl.Package, error) {
+	f.record("Package", id)
+	if f.PackageFunc != nil {
+		return f.PackageFunc(ctx, id)
+	}
+	if f.PackagesFunc != nil {
+		packages, err := f.PackagesFunc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range packages {
+			if p.ID == id {
+				return &p, nil
+			}
+		}
+	}
+	return nil, birdactyl.ErrNotFound
+}
+
+func (f *FakeAPI) PackageGroups(ctx context.Context) ([]birdactyl.PackageGroup, error) {
+	f.record("PackageGroups")
+	if f.PackagesFunc == nil {
+		return nil, nil
+	}
+	packages, err := f.PackagesFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return birdactyl.GroupPackages(packages), nil
+}
+
+func (f *FakeAPI) Databases(ctx context.Context, serverID string) ([]birdactyl.Database, error) {
+	f.record("Databases", serverID)
+	if f.DatabasesFunc != nil {
+		return f.DatabasesFunc(ctx, serverID)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) CreateDatabaseContext(ctx context.Context, serverID, name, allowedHost string) (*birdactyl.DatabaseCredentials, error) {
+	f.record("CreateDatabaseContext", serverID, name, allowedHost)
+	if f.CreateDatabaseContextFunc != nil {
+		return f.CreateDatabaseContextFunc(ctx, serverID, name, allowedHost)
+	}
+	return &birdactyl.DatabaseCredentials{DatabaseID: f.nextID("db"), Name: name, Username: name, Password: f.nextID("pw")}, nil
+}
+
+func (f *FakeAPI) RotateDatabasePasswordContext(ctx context.Context, databaseID string) (*birdactyl.DatabaseCredentials, error) {
+	f.record("RotateDatabasePasswordContext", databaseID)
+	if f.RotateDatabasePasswordContextFunc != nil {
+		return f.RotateDatabasePasswordContextFunc(ctx, databaseID)
+	}
+	return &birdactyl.DatabaseCredentials{DatabaseID: databaseID, Password: f.nextID("pw")}, nil
+}
+
+func (f *FakeAPI) DeleteDatabaseContext(ctx context.Context, databaseID string) error {
+	f.record("DeleteDatabaseContext", databaseID)
+	if f.DeleteDatabaseContextFunc != nil {
+		return f.DeleteDatabaseContextFunc(ctx, databaseID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) Backups(ctx context.Context, serverID string) ([]birdactyl.Backup, error) {
+	f.record("Backups", serverID)
+	if f.BackupsFunc != nil {
+		return f.BackupsFunc(ctx, serverID)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) Backup(ctx context.Context, serverID, backupID string) (*birdactyl.Backup, error) {
+	f.record("Backup", serverID, backupID)
+	if f.BackupFunc != nil {
+		return f.BackupFunc(ctx, serverID, backupID)
+	}
+	return nil, birdactyl.ErrNotFound
+}
+
+func (f *FakeAPI) CreateBackupContext(ctx context.Context, serverID, name string) (*birdactyl.Backup, error) {
+	f.record("CreateBackupContext", serverID, name)
+	if f.CreateBackupContextFunc != nil {
+		return f.CreateBackupContextFunc(ctx, serverID, name)
+	}
+	return &birdactyl.Backup{ID: f.nextID("backup"), ServerID: serverID, Name: name, State: birdactyl.BackupCompleted}, nil
+}
+
+func (f *FakeAPI) DeleteBackupContext(ctx context.Context, serverID, backupID string) error {
+	f.record("DeleteBackupContext", serverID, backupID)
+	if f.DeleteBackupContextFunc != nil {
+		return f.DeleteBackupContextFunc(ctx, serverID, backupID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) RestoreBackup(ctx context.Context, serverID, backupID string, truncate bool) error {
+	f.record("RestoreBackup", serverID, backupID, truncate)
+	if f.RestoreBackupFunc != nil {
+		return f.RestoreBackupFunc(ctx, serverID, backupID, truncate)
+	}
+	return nil
+}
+
+func (f *FakeAPI) ServerSchedules(ctx context.Context, serverID string) ([]birdactyl.ServerSchedule, error) {
+	f.record("ServerSchedules", serverID)
+	if f.ServerSchedulesFunc != nil {
+		return f.ServerSchedulesFunc(ctx, serverID)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) CreateServerSchedule(ctx context.Context, serverID string, spec birdactyl.ScheduleSpec) (*birdactyl.ServerSchedule, error) {
+	f.record("CreateServerSchedule", serverID, spec)
+	if f.CreateServerScheduleFunc != nil {
+		return f.CreateServerScheduleFunc(ctx, serverID, spec)
+	}
+	return &birdactyl.ServerSchedule{ID: f.nextID("schedule"), ServerID: serverID, Name: spec.Name, Cron: spec.Cron, Enabled: !spec.Disabled, OnlyWhenOnline: spec.OnlyWhenOnline}, nil
+}
+
+func (f *FakeAPI) DeleteServerSchedule(ctx context.Context, scheduleID string) error {
+	f.record("DeleteServerSchedule", scheduleID)
+	if f.DeleteServerScheduleFunc != nil {
+		return f.DeleteServerScheduleFunc(ctx, scheduleID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AddScheduleTask(ctx context.Context, scheduleID string, task birdactyl.TaskSpec) (*birdactyl.ScheduleTask, error) {
+	f.record("AddScheduleTask", scheduleID, task)
+	if f.AddScheduleTaskFunc != nil {
+		return f.AddScheduleTaskFunc(ctx, scheduleID, task)
+	}
+	return &birdactyl.ScheduleTask{ID: f.nextID("task"), ScheduleID: scheduleID, Action: task.Action, Delay: task.Delay, ContinueOnFailure: task.ContinueOnFailure}, nil
+}
+
+func (f *FakeAPI) DeleteScheduleTask(ctx context.Context, taskID string) error {
+	f.record("DeleteScheduleTask", taskID)
+	if f.DeleteScheduleTaskFunc != nil {
+		return f.DeleteScheduleTaskFunc(ctx, taskID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) Subusers(ctx context.Context, serverID string) ([]birdactyl.Subuser, error) {
+	f.record("Subusers", serverID)
+	if f.SubusersFunc != nil {
+		return f.SubusersFunc(ctx, serverID)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) AddSubuserContext(ctx context.Context, serverID, email string, permissions []string) (*birdactyl.Subuser, error) {
+	f.record("AddSubuserContext", serverID, email, permissions)
+	if f.AddSubuserContextFunc != nil {
+		return f.AddSubuserContextFunc(ctx, serverID, email, permissions)
+	}
+	return &birdactyl.Subuser{ID: f.nextID("subuser"), Email: email, Permissions: permissions}, nil
+}
+
+func (f *FakeAPI) UpdateSubuserPermissions(ctx context.Context, serverID, subuserID string, permissions []string) error {
+	f.record("UpdateSubuserPermissions", serverID, subuserID, permissions)
+	if f.UpdateSubuserPermissionsFunc != nil {
+		return f.UpdateSubuserPermissionsFunc(ctx, serverID, subuserID, permissions)
+	}
+	return nil
+}
+
+func (f *FakeAPI) RemoveSubuserContext(ctx context.Context, serverID, subuserID string) error {
+	f.record("RemoveSubuserContext", serverID, subuserID)
+	if f.RemoveSubuserContextFunc != nil {
+		return f.RemoveSubuserContextFunc(ctx, serverID, subuserID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SubuserPermissionInfo(ctx context.Context) ([]birdactyl.SubuserPermissionInfo, error) {
+	f.record("SubuserPermissionInfo")
+	if f.SubuserPermissionInfoFunc != nil {
+		return f.SubuserPermissionInfoFunc(ctx)
+	}
+	out := make([]birdactyl.SubuserPermissionInfo, len(birdactyl.SubuserPermissions))
+	for i, k := range birdactyl.SubuserPermissions {
+		out[i] = birdactyl.SubuserPermissionInfo{Key: k}
+	}
+	return out, nil
+}
+
+func (f *FakeAPI) ValidateSubuserPermissions(ctx context.Context, permissions []string) error {
+	f.record("ValidateSubuserPermissions", permissions)
+	if f.ValidateSubuserPermissionsFunc != nil {
+		return f.ValidateSubuserPermissionsFunc(ctx, permissions)
+	}
+	return nil
+}
+
+func (f *FakeAPI) NotifyUser(ctx context.Context, userID string, n birdactyl.Notification) error {
+	f.record("NotifyUser", userID, n)
+	if f.NotifyUserFunc != nil {
+		return f.NotifyUserFunc(ctx, userID, n)
+	}
+	return nil
+}
+
+func (f *FakeAPI) NotifyAdmins(ctx context.Context, n birdactyl.Notification) error {
+	f.record("NotifyAdmins", n)
+	if f.NotifyAdminsFunc != nil {
+		return f.NotifyAdminsFunc(ctx, n)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SendEmail(ctx context.Context, userID, subject, htmlBody string) error {
+	f.record("SendEmail", userID, subject, htmlBody)
+	if f.SendEmailFunc != nil {
+		return f.SendEmailFunc(ctx, userID, subject, htmlBody)
+	}
+	return nil
+}
+
+func (f *FakeAPI) Audit(ctx context.Context, entry birdactyl.AuditEntry) error {
+	f.record("Audit", entry)
+	if f.AuditFunc != nil {
+		return f.AuditFunc(ctx, entry)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AuditBatch(ctx context.Context, entries []birdactyl.AuditEntry) error {
+	f.record("AuditBatch", entries)
+	if f.AuditBatchFunc != nil {
+		return f.AuditBatchFunc(ctx, entries)
+	}
+	return nil
+}
+
+func (f *FakeAPI) CallPluginRoute(ctx context.Context, pluginID, method, path string, body []byte) (*birdactyl.PluginCallResponse, error) {
+	f.record("CallPluginRoute", pluginID, method, path, body)
+	if f.CallPluginRouteFunc != nil {
+		return f.CallPluginRouteFunc(ctx, pluginID, method, path, body)
+	}
+	return nil, birdactyl.ErrNotFound
+}
+
+func (f *FakeAPI) Plugins(ctx context.Context) ([]birdactyl.PluginSummary, error) {
+	f.record("Plugins")
+	if f.PluginsFunc != nil {
+		return f.PluginsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (f *FakeAPI) ServerSettings(ctx context.Context, serverID string) (map[string]string, error) {
+	f.record("ServerSettings", serverID)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make(map[string]string, len(f.settings[serverID]))
+	for k, v := range f.settings[serverID] {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (f *FakeAPI) SetServerSetting(ctx context.Context, serverID, key, value string) error {
+	return f.SetServerSettings(ctx, serverID, map[string]string{key: value})
+}
+
+func (f *FakeAPI) SetServerSettings(ctx context.Context, serverID string, values map[string]string) error {
+	f.record("SetServerSettings", serverID, values)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.settings == nil {
+		f.settings = make(map[string]map[string]string)
+	}
+	if f.settings[serverID] == nil {
+		f.settings[serverID] = make(map[string]string)
+	}
+	for k, v := range values {
+		f.settings[serverID][k] = v
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteServerSettings(ctx context.Context, serverID string, keys ...string) error {
+	f.record("DeleteServerSettings", serverID, keys)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, k := range keys {
+		delete(f.settings[serverID], k)
+	}
+	return nil
+}
+
+func (f *FakeAPI) WatchServerSettings(ctx context.Context, serverID string) (<-chan birdactyl.ServerSettingChange, error) {
+	f.record("WatchServerSettings", serverID)
+	if f.WatchServerSettingsFunc != nil {
+		return f.WatchServerSettingsFunc(ctx, serverID)
+	}
+	return closedChan[birdactyl.ServerSettingChange](), nil
+}
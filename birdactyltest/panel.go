@@ -0,0 +1,654 @@
+package birdactyltest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+const waitTimeout = 5 * time.Second
+
+type Response struct {
+	Status  int
+	Headers map[string]string
+	Body    []byte
+}
+
+type MixinResult struct {
+	Action        pb.MixinResponse_Action
+	Output        map[string]interface{}
+	Error         string
+	ModifiedInput map[string]interface{}
+	OutputPatch   map[string]interface{}
+	DeletedKeys   []string
+	Notifications []birdactyl.Notification
+	Skipped       []birdactyl.SkippedEffect
+}
+
+type LogEntry struct {
+	Level   string
+	Message string
+	Fields  map[string]string
+}
+
+type UIPush struct {
+	Channel string
+	Payload json.RawMessage
+}
+
+type Panel struct {
+	pb.UnimplementedPanelServiceServer
+
+	t      testing.TB
+	lis    *bufconn.Listener
+	server *grpc.Server
+	conn   *grpc.ClientConn
+
+	mu       sync.Mutex
+	info     *pb.PluginInfo
+	infos    []*pb.PluginInfo
+	stream   pb.PanelService_ConnectServer
+	sendMu   sync.Mutex
+	ready    chan struct{}
+	drop     chan struct{}
+	gone     chan struct{}
+	connects int
+	waiters  map[string]chan *pb.PluginMessage
+	seq      int
+	logs     []LogEntry
+	reports  []*pb.ErrorReport
+	health   []birdactyl.HealthStatus
+	initErr  string
+	pushes   []UIPush
+	users    map[string]*pb.User
+	bundles  []*pb.BundleUpload
+
+	version      string
+	capabilities []string
+	protocol     int32
+	environment  *pb.PanelEnvironment
+	installed    map[string]string
+	grant        *pb.PermissionGrant
+	flags        map[string]bool
+	bundleFails  int
+	batchErr     error
+	holdCalls    bool
+	held         int
+
+	readyOnce sync.Once
+	cancel    context.CancelFunc
+	done      chan error
+	stopped   bool
+}
+
+func NewPanel(t testing.TB) *Panel {
+	t.Helper()
+	tp := &Panel{
+		t:       t,
+		lis:     bufconn.Listen(1 << 20),
+		server:  grpc.NewServer(),
+		ready:   make(chan struct{}),
+		waiters: make(map[string]chan *pb.PluginMessage),
+
+		protocol: birdactyl.ProtocolVersion,
+	}
+	pb.RegisterPanelServiceServer(tp.server, tp)
+	go tp.server.Serve(tp.lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return tp.lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("birdactyltest: dial: %v", err)
+	}
+	tp.conn = conn
+	t.Cleanup(tp.close)
+	return tp
+}
+
+func (tp *Panel) SetVersion(version string, capabilities ...string) {
+	tp.mu.Lock()
+	tp.version = version
+	tp.capabilities = capabilities
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) SetPanelInfo(info birdactyl.PanelInfo) {
+	tp.mu.Lock()
+	if info.Version != "" {
+		tp.version = info.Version
+	}
+	tp.environment = &pb.PanelEnvironment{AppUrl: info.AppURL, DefaultLocale: info.DefaultLocale, Timezone: info.Timezone, Features: info.Features}
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) SetProtocolVersion(version int) {
+	tp.mu.Lock()
+	tp.protocol = int32(version)
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) InstallPlugin(pluginID, version string) {
+	tp.mu.Lock()
+	if tp.installed == nil {
+		tp.installed = make(map[string]string)
+	}
+	tp.installed[pluginID] = version
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) GrantPermissions(perms ...birdactyl.Permission) {
+	grant := &pb.PermissionGrant{ApiToken: "birdactyltest-token"}
+	for _, perm := range perms {
+		grant.Granted = append(grant.Granted, string(perm))
+	}
+	tp.mu.Lock()
+	tp.grant = grant
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) SetFlags(values map[string]bool) {
+	tp.mu.Lock()
+	if tp.flags == nil {
+		tp.flags = make(map[string]bool)
+	}
+	for k, v := range values {
+		tp.flags[k] = v
+	}
+	stream := tp.stream
+	tp.mu.Unlock()
+	if stream != nil {
+		tp.send(&pb.PanelMessage{Payload: &pb.PanelMessage_FlagsChanged{FlagsChanged: &pb.FlagValues{Values: values}}})
+	}
+}
+
+func (tp *Panel) AddUser(u birdactyl.User) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	if tp.users == nil {
+		tp.users = make(map[string]*pb.User)
+	}
+	tp.users[u.ID] = &pb.User{Id: u.ID, Username: u.Username, Email: u.Email, IsAdmin: u.IsAdmin, IsBanned: u.IsBanned, ForcePasswordReset: u.ForcePasswordReset, RamLimit: u.RamLimit, CpuLimit: u.CpuLimit, DiskLimit: u.DiskLimit, ServerLimit: u.ServerLimit, CreatedAt: u.CreatedAt}
+}
+
+func (tp *Panel) SendThrottled(info birdactyl.RouteThrottleInfo) {
+	tp.t.Helper()
+	tp.mu.Lock()
+	stream := tp.stream
+	tp.mu.Unlock()
+	if stream == nil {
+		tp.t.Fatalf("birdactyltest: no plugin connected, call StartPlugin first")
+	}
+	tp.send(&pb.PanelMessage{Payload: &pb.PanelMessage_RouteThrottled{RouteThrottled: &pb.RouteThrottled{
+		Method:       info.Method,
+		Path:         info.Path,
+		Route:        info.Route,
+		UserId:       info.UserID,
+		ClientIp:     info.ClientIP,
+		Limit:        int32(info.Limit),
+		Scope:        string(info.Scope),
+		RetryAfterMs: info.RetryAfter.Milliseconds(),
+		Count:        uint32(info.Count),
+	}}})
+}
+
+func (tp *Panel) Conn() grpc.ClientConnInterface {
+	return tp.conn
+}
+
+func (tp *Panel) StartPlugin(p *birdactyl.Plugin) {
+	tp.t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	tp.cancel = cancel
+	tp.done = make(chan error, 1)
+	go func() { tp.done <- p.Serve(ctx, tp.conn) }()
+
+	select {
+	case <-tp.ready:
+	case err := <-tp.done:
+		tp.t.Fatalf("birdactyltest: plugin exited before registering: %v", err)
+	case <-time.After(waitTimeout):
+		tp.t.Fatalf("birdactyltest: plugin did not register within %s", waitTimeout)
+	}
+	waitCtx, stop := context.WithTimeout(context.Background(), waitTimeout)
+	defer stop()
+	if err := p.WaitConnected(waitCtx); err != nil {
+		tp.t.Fatalf("birdactyltest: plugin did not finish connecting within %s", waitTimeout)
+	}
+}
+
+func (tp *Panel) Info() *pb.PluginInfo {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return tp.info
+}
+
+func (tp *Panel) Registrations() []*pb.PluginInfo {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return append([]*pb.PluginInfo(nil), tp.infos...)
+}
+
+func (tp *Panel) Logs() []LogEntry {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return append([]LogEntry(nil), tp.logs...)
+}
+
+func (tp *Panel) ErrorReports() []*pb.ErrorReport {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return append([]*pb.ErrorReport(nil), tp.reports...)
+}
+
+func (tp *Panel) HealthReports() []birdactyl.HealthStatus {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return append([]birdactyl.HealthStatus(nil), tp.health...)
+}
+
+func (tp *Panel) StartError() string {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return tp.initErr
+}
+
+func (tp *Panel) UIPushes() []UIPush {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return append([]UIPush(nil), tp.pushes...)
+}
+
+func (tp *Panel) ProbeHealth() birdactyl.HealthStatus {
+	tp.t.Helper()
+	return healthStatus(tp.request(&pb.PanelMessage{Payload: &pb.PanelMessage_HealthProbe{HealthProbe: &pb.Empty{}}}).GetHealth())
+}
+
+func appliedRoutes(info *pb.PluginInfo) []*pb.AppliedRoute {
+	var out []*pb.AppliedRoute
+	for _, r := range info.Routes {
+		if r.RateLimit != nil {
+			out = append(out, &pb.AppliedRoute{Method: r.Method, Path: r.Path, RateLimit: r.RateLimit})
+		}
+	}
+	return out
+}
+
+func healthStatus(h *pb.HealthReport) birdactyl.HealthStatus {
+	return birdactyl.HealthStatus{State: birdactyl.HealthState(h.GetState()), Message: h.GetMessage()}
+}
+
+func (tp *Panel) SendEvent(eventType string, data map[string]string, sync bool) birdactyl.EventResult {
+	tp.t.Helper()
+	resp := tp.request(&pb.PanelMessage{Payload: &pb.PanelMessage_Event{Event: &pb.Event{
+		Type:      eventType,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Data:      data,
+		Sync:      sync,
+	}}}).GetEventResponse()
+	if resp.GetAllow() {
+		return birdactyl.Allow()
+	}
+	return birdactyl.Block(resp.GetMessage())
+}
+
+func (tp *Panel) DoHTTP(method, path string, body []byte) Response {
+	tp.t.Helper()
+	return tp.DoHTTPRequest(&pb.HTTPRequest{Method: method, Path: path, Body: body})
+}
+
+func (tp *Panel) DoHTTPStream(method, path string, chunks ...[]byte) Response {
+	tp.t.Helper()
+	follow := make([]*pb.PanelMessage, 0, len(chunks)+1)
+	for _, c := range chunks {
+		follow = append(follow, &pb.PanelMessage{Payload: &pb.PanelMessage_BodyChunk{BodyChunk: &pb.BodyChunk{Data: c}}})
+	}
+	follow = append(follow, &pb.PanelMessage{Payload: &pb.PanelMessage_BodyChunk{BodyChunk: &pb.BodyChunk{Eof: true}}})
+	msg := &pb.PanelMessage{Payload: &pb.PanelMessage_Http{Http: &pb.HTTPRequest{Method: method, Path: path, Streamed: true}}}
+	resp := tp.requestID(tp.nextID(), msg, follow...).GetHttpResponse()
+	return Response{Status: int(resp.GetStatus()), Headers: resp.GetHeaders(), Body: resp.GetBody()}
+}
+
+func (tp *Panel) DoHTTPRequest(req *pb.HTTPRequest) Response {
+	tp.t.Helper()
+	if u, err := url.Parse(req.Path); err == nil && u.RawQuery != "" {
+		req.Path = u.Path
+		if req.Query == nil {
+			req.Query = make(map[string]string)
+		}
+		for k, v := range u.Query() {
+			req.Query[k] = v[0]
+		}
+	}
+	resp := tp.request(&pb.PanelMessage{Payload: &pb.PanelMessage_Http{Http: req}}).GetHttpResponse()
+	return Response{Status: int(resp.GetStatus()), Headers: resp.GetHeaders(), Body: resp.GetBody()}
+}
+
+func (tp *Panel) TriggerSchedule(id string) {
+	tp.t.Helper()
+	tp.request(&pb.PanelMessage{Payload: &pb.PanelMessage_Schedule{Schedule: &pb.ScheduleRequest{ScheduleId: id}}})
+}
+
+func (tp *Panel) RunMixin(target string, input map[string]interface{}) MixinResult {
+	tp.t.Helper()
+	return tp.runMixin(target, input, nil, false)
+}
+
+func (tp *Panel) RunMixinDryRun(target string, input map[string]interface{}) MixinResult {
+	tp.t.Helper()
+	return tp.runMixin(target, input, nil, true)
+}
+
+func (tp *Panel) RunPostMixin(target string, input, output map[string]interface{}) MixinResult {
+	tp.t.Helper()
+	if output == nil {
+		output = map[string]interface{}{}
+	}
+	return tp.runMixin(target, input, output, false)
+}
+
+func (tp *Panel) runMixin(target string, input, output map[string]interface{}, dryRun bool) MixinResult {
+	tp.t.Helper()
+	data, err := json.Marshal(input)
+	if err != nil {
+		tp.t.Fatalf("birdactyltest: encode mixin input: %v", err)
+	}
+	req := &pb.MixinRequest{Target: target, Input: data, DryRun: dryRun}
+	if output != nil {
+		if req.Output, err = json.Marshal(output); err != nil {
+			tp.t.Fatalf("birdactyltest: encode mixin output: %v", err)
+		}
+	}
+	id := tp.nextID()
+	req.RequestId = id
+	resp := tp.requestID(id, &pb.PanelMessage{Payload: &pb.PanelMessage_Mixin{Mixin: req}}).GetMixinResponse()
+
+	out := MixinResult{Action: resp.GetAction(), Error: resp.GetError(), DeletedKeys: resp.GetDeleteOutputKeys()}
+	json.Unmarshal(resp.GetOutput(), &out.Output)
+	json.Unmarshal(resp.GetModifiedInput(), &out.ModifiedInput)
+	json.Unmarshal(resp.GetOutputPatch(), &out.OutputPatch)
+	if output != nil && out.Action == pb.MixinResponse_NEXT {
+		out.Output = birdactyl.MergeOutput(output, out.OutputPatch, out.DeletedKeys)
+	}
+	for _, n := range resp.GetNotifications() {
+		out.Notifications = append(out.Notifications, birdactyl.Notification{Title: n.Title, Message: n.Message, Type: n.Type})
+	}
+	for _, s := range resp.GetSkippedEffects() {
+		out.Skipped = append(out.Skipped, birdactyl.SkippedEffect{Kind: s.Kind, Description: s.Description})
+	}
+	return out
+}
+
+func (tp *Panel) nextID() string {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.seq++
+	return fmt.Sprintf("test-%d", tp.seq)
+}
+
+func (tp *Panel) request(msg *pb.PanelMessage) *pb.PluginMessage {
+	tp.t.Helper()
+	return tp.requestID(tp.nextID(), msg)
+}
+
+func (tp *Panel) requestID(id string, msg *pb.PanelMessage, follow ...*pb.PanelMessage) *pb.PluginMessage {
+	tp.t.Helper()
+	ch := make(chan *pb.PluginMessage, 1)
+	tp.mu.Lock()
+	stream, gone := tp.stream, tp.gone
+	tp.waiters[id] = ch
+	tp.mu.Unlock()
+	defer func() {
+		tp.mu.Lock()
+		delete(tp.waiters, id)
+		tp.mu.Unlock()
+	}()
+	if stream == nil {
+		tp.t.Fatalf("birdactyltest: no plugin connected, call StartPlugin first")
+	}
+
+	msg.RequestId = id
+	for _, m := range append([]*pb.PanelMessage{msg}, follow...) {
+		m.RequestId = id
+		if err := tp.send(m); err != nil {
+			tp.t.Fatalf("birdactyltest: send: %v", err)
+		}
+	}
+	select {
+	case resp := <-ch:
+		return resp
+	case <-gone:
+		return nil
+	case <-time.After(waitTimeout):
+		tp.t.Fatalf("birdactyltest: no response to %s within %s", id, waitTimeout)
+		return nil
+	}
+}
+
+func (tp *Panel) send(msg *pb.PanelMessage) error {
+	tp.sendMu.Lock()
+	defer tp.sendMu.Unlock()
+	return tp.stream.Send(msg)
+}
+
+func (tp *Panel) Connect(stream pb.PanelService_ConnectServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetRegister()
+	if info == nil {
+		return fmt.Errorf("birdactyltest: expected registration, got %T", first.Payload)
+	}
+
+	tp.mu.Lock()
+	tp.info = info
+	tp.infos = append(tp.infos, info)
+	tp.stream = stream
+	registered := &pb.Registered{PanelVersion: tp.version, Capabilities: tp.capabilities, Permissions: tp.grant, AppliedRoutes: appliedRoutes(info), ProtocolVersion: tp.protocol, Environment: tp.environment}
+	if tp.flags != nil {
+		registered.Flags = &pb.FlagValues{Values: tp.flags}
+	}
+	for _, dep := range info.Dependencies {
+		version, ok := tp.installed[dep.PluginId]
+		registered.Dependencies = append(registered.Dependencies, &pb.DependencyStatus{PluginId: dep.PluginId, Present: ok, Version: version})
+	}
+	tp.mu.Unlock()
+	if err := tp.send(&pb.PanelMessage{Payload: &pb.PanelMessage_Registered{Registered: registered}}); err != nil {
+		return err
+	}
+	drop := make(chan struct{})
+	tp.mu.Lock()
+	tp.connects++
+	tp.drop = drop
+	tp.mu.Unlock()
+	tp.readyOnce.Do(func() { close(tp.ready) })
+
+	done := make(chan struct{})
+	tp.mu.Lock()
+	tp.gone = done
+	tp.mu.Unlock()
+	go func() {
+		defer close(done)
+		tp.serveStream(stream)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-drop:
+		return status.Error(codes.Unavailable, "birdactyltest: connection dropped")
+	}
+}
+
+func (tp *Panel) Disconnect() {
+	tp.t.Helper()
+	tp.mu.Lock()
+	drop, n := tp.drop, tp.connects
+	tp.drop = nil
+	tp.mu.Unlock()
+	if drop == nil {
+		tp.t.Fatalf("birdactyltest: Disconnect called without a connected plugin")
+	}
+	close(drop)
+	deadline := time.Now().Add(waitTimeout)
+	for {
+		tp.mu.Lock()
+		reconnected := tp.connects > n
+		tp.mu.Unlock()
+		if reconnected {
+			return
+		}
+		if time.Now().After(deadline) {
+			tp.t.Fatalf("birdactyltest: plugin did not reconnect within %s", waitTimeout)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (tp *Panel) HoldAPICalls(hold bool) {
+	tp.mu.Lock()
+	tp.holdCalls = hold
+	tp.mu.Unlock()
+}
+
+func (tp *Panel) HeldAPICalls() int {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return tp.held
+}
+
+func (tp *Panel) serveStream(stream pb.PanelService_ConnectServer) {
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return
+		}
+		if ping := msg.GetPing(); ping != nil {
+			if !ping.Reply {
+				tp.send(&pb.PanelMessage{Payload: &pb.PanelMessage_Ping{Ping: &pb.Ping{SentUnixMs: ping.SentUnixMs, Reply: true}}})
+			}
+			continue
+		}
+		if h := msg.GetHealth(); h != nil && msg.RequestId == "" {
+			tp.mu.Lock()
+			tp.health = append(tp.health, healthStatus(h))
+			if h.InitError != "" {
+				tp.initErr = h.InitError
+			}
+			tp.mu.Unlock()
+			continue
+		}
+		if push := msg.GetUiPush(); push != nil {
+			tp.mu.Lock()
+			tp.pushes = append(tp.pushes, UIPush{Channel: push.Channel, Payload: push.Payload})
+			tp.mu.Unlock()
+			continue
+		}
+		if update := msg.GetUpdateRegistration(); update != nil {
+			tp.mu.Lock()
+			tp.info = update
+			tp.infos = append(tp.infos, update)
+			tp.mu.Unlock()
+			tp.send(&pb.PanelMessage{RequestId: msg.RequestId, Payload: &pb.PanelMessage_RegistrationUpdated{RegistrationUpdated: &pb.RegistrationUpdated{AppliedRoutes: appliedRoutes(update)}}})
+			continue
+		}
+		if call := msg.GetApiCall(); call != nil {
+			tp.mu.Lock()
+			hold := tp.holdCalls
+			if hold {
+				tp.held++
+			}
+			tp.mu.Unlock()
+			if hold {
+				continue
+			}
+			tp.send(&pb.PanelMessage{RequestId: msg.RequestId, Payload: &pb.PanelMessage_ApiResult{ApiResult: unimplemented(call.Method)}})
+			continue
+		}
+		if batch := msg.GetApiBatch(); batch != nil {
+			go tp.runBatch(msg.RequestId, batch)
+			continue
+		}
+		tp.mu.Lock()
+		ch, ok := tp.waiters[msg.RequestId]
+		tp.mu.Unlock()
+		if ok {
+			ch <- msg
+		}
+	}
+}
+
+func (tp *Panel) Log(ctx context.Context, req *pb.LogRequest) (*pb.Empty, error) {
+	tp.mu.Lock()
+	tp.logs = append(tp.logs, LogEntry{Level: req.Level, Message: req.Message, Fields: req.Fields})
+	tp.mu.Unlock()
+	return &pb.Empty{}, nil
+}
+
+func (tp *Panel) GetUser(ctx context.Context, req *pb.IDRequest) (*pb.User, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	u, ok := tp.users[req.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", req.Id)
+	}
+	return u, nil
+}
+
+func (tp *Panel) ReportError(ctx context.Context, req *pb.ErrorReport) (*pb.Empty, error) {
+	tp.mu.Lock()
+	tp.reports = append(tp.reports, req)
+	tp.mu.Unlock()
+	return &pb.Empty{}, nil
+}
+
+func (tp *Panel) Shutdown() error {
+	tp.mu.Lock()
+	stream, done, stopped := tp.stream, tp.done, tp.stopped
+	tp.stopped = true
+	tp.mu.Unlock()
+	if stream == nil || done == nil || stopped {
+		return nil
+	}
+	if err := tp.send(&pb.PanelMessage{Payload: &pb.PanelMessage_Shutdown{Shutdown: &pb.Empty{}}}); err != nil {
+		return err
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(waitTimeout):
+		return fmt.Errorf("birdactyltest: plugin did not exit within %s", waitTimeout)
+	}
+}
+
+func (tp *Panel) close() {
+	tp.Shutdown()
+	if tp.cancel != nil {
+		tp.cancel()
+	}
+	tp.conn.Close()
+	tp.server.Stop()
+}
+
+func unimplemented(method string) *pb.ApiResult {
+	st, _ := proto.Marshal(&spb.Status{Code: int32(codes.Unimplemented), Message: "birdactyltest: " + method + " is not implemented by the fake panel"})
+	return &pb.ApiResult{Status: st}
+}
@@ -0,0 +1,27 @@
+package birdactyltest_test
+
+import (
+	"reflect"
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestRunPostMixinMergesOutput(t *testing.T) {
+	p := birdactyl.New("mixins", "1.0.0").Mixin("servers.get", func(c *birdactyl.MixinContext) birdactyl.MixinResult {
+		c.ModifyOutputPartial(map[string]interface{}{"status": "ok", "debug": true})
+		return c.DeleteOutputKeys("debug", "secret")
+	})
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	res := tp.RunPostMixin("servers.get", nil, map[string]interface{}{"id": "s1", "secret": "x"})
+	want := map[string]interface{}{"id": "s1", "status": "ok"}
+	if !reflect.DeepEqual(res.Output, want) {
+		t.Fatalf("output = %v, want %v", res.Output, want)
+	}
+	if !reflect.DeepEqual(res.DeletedKeys, []string{"debug", "secret"}) {
+		t.Fatalf("deleted keys = %v", res.DeletedKeys)
+	}
+}
@@ -0,0 +1,110 @@
+package birdactyltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+)
+
+type RequestBuilder struct {
+	req birdactyl.Request
+}
+
+func NewRequest(method, path string) *RequestBuilder {
+	return &RequestBuilder{req: birdactyl.Request{
+		Method:  method,
+		Path:    path,
+		Headers: make(map[string]string),
+		Query:   make(map[string]string),
+	}}
+}
+
+func (b *RequestBuilder) WithJSONBody(v interface{}) *RequestBuilder {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic("birdactyltest: encode body: " + err.Error())
+	}
+	b.req.RawBody = data
+	b.req.Body = nil
+	json.Unmarshal(data, &b.req.Body)
+	b.req.Headers["Content-Type"] = "application/json"
+	return b
+}
+
+func (b *RequestBuilder) WithBody(data []byte) *RequestBuilder {
+	b.req.RawBody = data
+	b.req.Body = nil
+	json.Unmarshal(data, &b.req.Body)
+	return b
+}
+
+func (b *RequestBuilder) WithQuery(key, value string) *RequestBuilder {
+	b.req.Query[key] = value
+	return b
+}
+
+func (b *RequestBuilder) WithHeader(key, value string) *RequestBuilder {
+	b.req.Headers[key] = value
+	return b
+}
+
+func (b *RequestBuilder) WithUser(id string) *RequestBuilder {
+	b.req.UserID = id
+	return b
+}
+
+func (b *RequestBuilder) WithRequestID(id string) *RequestBuilder {
+	b.req.RequestID = id
+	return b
+}
+
+func (b *RequestBuilder) Build() birdactyl.Request {
+	return b.req
+}
+
+func DecodeResponse(resp birdactyl.Response, out interface{}) error {
+	return decodeEnvelope(resp.Body(), out)
+}
+
+func (r Response) Decode(out interface{}) error {
+	return decodeEnvelope(r.Body, out)
+}
+
+func decodeEnvelope(body []byte, out interface{}) error {
+	var env struct {
+		Success *bool           `json:"success"`
+		Data    json.RawMessage `json:"data"`
+		Error   string          `json:"error"`
+	}
+	if err := json.Unmarshal(body, &env); err != nil {
+		return err
+	}
+	if env.Success == nil {
+		return json.Unmarshal(body, out)
+	}
+	if !*env.Success {
+		return fmt.Errorf("birdactyltest: response error: %s", env.Error)
+	}
+	if len(env.Data) == 0 || out == nil {
+		return nil
+	}
+	return json.Unmarshal(env.Data, out)
+}
+
+func AssertStatus(t testing.TB, resp birdactyl.Response, want int) {
+	t.Helper()
+	if resp.Status != want {
+		t.Errorf("status = %d, want %d (body: %s)", resp.Status, want, resp.Body())
+	}
+}
+
+func AssertHeader(t testing.TB, resp birdactyl.Response, key, want string) {
+	t.Helper()
+	if got, ok := resp.Headers[key]; !ok {
+		t.Errorf("header %q missing, want %q", key, want)
+	} else if got != want {
+		t.Errorf("header %q = %q, want %q", key, got, want)
+	}
+}
@@ -0,0 +1,160 @@
+package birdactyl
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	DefaultMaxBodySize = 1 << 30
+
+	bodyWindow = 4 << 20
+)
+
+type bodyStream struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	chunks   [][]byte
+	size     int64
+	buffered int64
+	max      int64
+	err      error
+	closed   bool
+}
+
+func newBodyStream(max int64) *bodyStream {
+	b := &bodyStream{max: max}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+func (b *bodyStream) write(chunk *pb.BodyChunk) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for !b.closed && b.err == nil && b.buffered >= bodyWindow {
+		b.cond.Wait()
+	}
+	if b.closed || b.err != nil {
+		return
+	}
+	b.size += int64(len(chunk.Data))
+	switch {
+	case b.max > 0 && b.size > b.max:
+		b.err = ErrBodyTooLarge
+		b.chunks, b.buffered = nil, 0
+	case chunk.Error != "":
+		b.err = errors.New("birdactyl: body stream aborted: " + chunk.Error)
+	default:
+		if len(chunk.Data) > 0 {
+			b.chunks = append(b.chunks, chunk.Data)
+			b.buffered += int64(len(chunk.Data))
+		}
+		if chunk.Eof {
+			b.err = io.EOF
+		}
+	}
+	b.cond.Broadcast()
+}
+
+func (b *bodyStream) fail(err error) {
+	b.mu.Lock()
+	if b.err == nil {
+		b.err = err
+	}
+	b.closed = true
+	b.chunks, b.buffered = nil, 0
+	b.mu.Unlock()
+	b.cond.Broadcast()
+}
+
+func (b *bodyStream) Read(buf []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for len(b.chunks) == 0 && b.err == nil {
+		b.cond.Wait()
+	}
+	if len(b.chunks) == 0 {
+		return 0, b.err
+	}
+	n := copy(buf, b.chunks[0])
+	if n == len(b.chunks[0]) {
+		b.chunks[0] = nil
+		b.chunks = b.chunks[1:]
+	} else {
+		b.chunks[0] = b.chunks[0][n:]
+	}
+	b.buffered -= int64(n)
+	b.cond.Broadcast()
+	return n, nil
+}
+
+func (rb *RouteBuilder) StreamBody() *RouteBuilder {
+	rb.config.StreamBody = true
+	return rb
+}
+
+func (rb *RouteBuilder) MaxBodySize(n int64) *RouteBuilder {
+	rb.config.MaxBodySize = n
+	return rb
+}
+
+func (cfg *RouteConfig) maxBodySize() int64 {
+	if cfg != nil && cfg.MaxBodySize > 0 {
+		return cfg.MaxBodySize
+	}
+	return DefaultMaxBodySize
+}
+
+func (p *Plugin) openBody(msg *pb.PanelMessage) {
+	req := msg.GetHttp()
+	if req == nil || !req.Streamed {
+		return
+	}
+	cfg := p.findRoute(req.Method, req.Path)
+	p.bodiesMu.Lock()
+	if p.bodies == nil {
+		p.bodies = make(map[string]*bodyStream)
+	}
+	p.bodies[msg.RequestId] = newBodyStream(cfg.maxBodySize())
+	p.bodiesMu.Unlock()
+}
+
+func (p *Plugin) bodyChunk(requestID string, chunk *pb.BodyChunk) {
+	p.bodiesMu.Lock()
+	b := p.bodies[requestID]
+	p.bodiesMu.Unlock()
+	if b == nil {
+		p.printf(LevelDebug, "dropping body chunk for unknown request %s", requestID)
+		return
+	}
+	b.write(chunk)
+}
+
+func (p *Plugin) body(requestID string) *bodyStream {
+	p.bodiesMu.Lock()
+	defer p.bodiesMu.Unlock()
+	return p.bodies[requestID]
+}
+
+func (p *Plugin) closeBody(requestID string) {
+	p.bodiesMu.Lock()
+	b := p.bodies[requestID]
+	delete(p.bodies, requestID)
+	p.bodiesMu.Unlock()
+	if b != nil {
+		b.fail(io.ErrClosedPipe)
+	}
+}
+
+func (p *Plugin) abortBodies() {
+	p.bodiesMu.Lock()
+	bodies := p.bodies
+	p.bodies = nil
+	p.bodiesMu.Unlock()
+	for _, b := range bodies {
+		b.fail(ErrUnavailable)
+	}
+}
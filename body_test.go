@@ -0,0 +1,63 @@
+package birdactyl
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+func TestBodyStreamBackpressure(t *testing.T) {
+	b := newBodyStream(DefaultMaxBodySize)
+	chunk := make([]byte, bodyWindow/4)
+	const chunks = 12
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < chunks; i++ {
+			b.write(&pb.BodyChunk{Data: chunk})
+		}
+		b.write(&pb.BodyChunk{Eof: true})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	b.mu.Lock()
+	buffered := b.buffered
+	b.mu.Unlock()
+	if buffered > bodyWindow {
+		t.Fatalf("buffered %d bytes before any read, window is %d", buffered, bodyWindow)
+	}
+	select {
+	case <-done:
+		t.Fatal("writer finished without a reader draining the window")
+	default:
+	}
+
+	n, err := io.Copy(io.Discard, b)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if n != int64(len(chunk)*chunks) {
+		t.Fatalf("read %d bytes, want %d", n, len(chunk)*chunks)
+	}
+	<-done
+}
+
+func TestBodyStreamCloseReleasesWriter(t *testing.T) {
+	b := newBodyStream(DefaultMaxBodySize)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 8; i++ {
+			b.write(&pb.BodyChunk{Data: make([]byte, bodyWindow/2)})
+		}
+	}()
+	time.Sleep(20 * time.Millisecond)
+	b.fail(io.ErrClosedPipe)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("writer still blocked after the body was closed")
+	}
+}
@@ -0,0 +1,11 @@
+package birdactyl
+
+import "encoding/json"
+
+func marshalJSON(v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+package birdactyl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const benchBodySize = 10 << 20
+
+func benchBody(b *testing.B) []byte {
+	b.Helper()
+	body, err := json.Marshal(map[string]string{"data": strings.Repeat("a", benchBodySize)})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return body
+}
+
+func BenchmarkEncodeJSON10MB(b *testing.B) {
+	payload := strings.Repeat("a", benchBodySize)
+	b.SetBytes(benchBodySize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if resp := JSON(payload); len(resp.body) < benchBodySize {
+			b.Fatalf("encoded %d bytes", len(resp.body))
+		}
+	}
+}
+
+func benchmarkDecode(b *testing.B, raw bool) {
+	p := New("bench", "1.0.0")
+	rb := p.Route("POST", "/upload", func(r Request) Response {
+		return Text("ok")
+	})
+	if raw {
+		rb.Raw()
+	}
+	req := &pb.HTTPRequest{Method: "POST", Path: "/upload", Body: benchBody(b)}
+	b.SetBytes(int64(len(req.Body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp := p.handleHTTP(context.Background(), req, "bench")
+		if !bytes.Equal(resp.GetHttpResponse().GetBody(), []byte("ok")) {
+			b.Fatalf("unexpected response %v", resp)
+		}
+	}
+}
+
+func BenchmarkDecodeBody10MB(b *testing.B) { benchmarkDecode(b, false) }
+
+func BenchmarkDecodeBodyRaw10MB(b *testing.B) { benchmarkDecode(b, true) }
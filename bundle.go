@@ -0,0 +1,58 @@
+package birdactyl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const bundleEncodingGzip = "gzip"
+
+func bundleHash(data []byte, assets []*pb.PluginUIAsset) string {
+	if len(data) == 0 && len(assets) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	h.Write(data)
+	for _, a := range assets {
+		h.Write([]byte{0})
+		h.Write([]byte(a.Path))
+		h.Write([]byte{0})
+		h.Write([]byte(a.ContentType))
+		h.Write([]byte{0})
+		h.Write(a.Data)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (p *Plugin) handleBundleRequest(req *pb.BundleRequest) *pb.PluginMessage {
+	upload := p.bundleUpload()
+	if req.Hash != "" && req.Hash != upload.Hash {
+		p.printf(LevelWarn, "panel requested bundle %s but current bundle is %s", req.Hash, upload.Hash)
+	}
+	return &pb.PluginMessage{Payload: &pb.PluginMessage_BundleUpload{BundleUpload: upload}}
+}
+
+func (p *Plugin) bundleUpload() *pb.BundleUpload {
+	data, assets := p.ui.bundle()
+	upload := &pb.BundleUpload{Hash: bundleHash(data, assets), Encoding: bundleEncodingGzip}
+	upload.BundleData = gzipBytes(data)
+	for _, a := range assets {
+		upload.Assets = append(upload.Assets, &pb.PluginUIAsset{Path: a.Path, ContentType: a.ContentType, Data: gzipBytes(a.Data)})
+	}
+	return upload
+}
+
+func gzipBytes(data []byte) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(data)
+	zw.Close()
+	return buf.Bytes()
+}
@@ -0,0 +1,65 @@
+package birdactyl_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+var testBundle = []byte("console.log('bundle')")
+
+func waitRegistrations(t *testing.T, tp *birdactyltest.Panel, n int) []*pb.PluginInfo {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		infos := tp.Registrations()
+		if len(infos) >= n {
+			return infos
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d registrations, want %d", len(infos), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBundleInlinedAfterRegistered(t *testing.T) {
+	p := birdactyl.New("bundle", "1.0.0")
+	p.UI().BundleBytes(testBundle)
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	infos := waitRegistrations(t, tp, 2)
+	first := infos[0].GetUi()
+	if first.GetBundleHash() == "" || len(first.GetBundleData()) != 0 {
+		t.Fatalf("first registration sent hash %q with %d bytes, want hash only", first.GetBundleHash(), len(first.GetBundleData()))
+	}
+	if data := infos[1].GetUi().GetBundleData(); !bytes.Equal(data, testBundle) {
+		t.Fatalf("follow-up registration carried %q, want the bundle", data)
+	}
+}
+
+func TestBundleUploadedWhenPanelSupportsIt(t *testing.T) {
+	p := birdactyl.New("bundle", "1.0.0")
+	p.UI().BundleBytes(testBundle)
+	tp := birdactyltest.NewPanel(t)
+	tp.SetVersion("1.0.0", "ui.bundle_upload")
+	tp.StartPlugin(p)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(tp.Bundles()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("bundle was not uploaded")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	for _, info := range tp.Registrations() {
+		if len(info.GetUi().GetBundleData()) != 0 {
+			t.Fatal("bundle was inlined although the panel accepts uploads")
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package birdactyl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultBundleInlineLimit = 2 << 20
+
+	capBundleUpload      = "ui.bundle_upload"
+	bundleChunkSize      = 256 << 10
+	bundleUploadAttempts = 5
+	bundleUploadBackoff  = time.Second
+)
+
+type BundleUploadProgress struct {
+	Hash    string
+	Sent    int64
+	Total   int64
+	Attempt int
+	Done    bool
+	Skipped bool
+	Err     error
+}
+
+func (p *Plugin) OnBundleUpload(fn func(BundleUploadProgress)) *Plugin {
+	p.regMu.Lock()
+	p.onBundleUpload = append(p.onBundleUpload, fn)
+	p.regMu.Unlock()
+	return p
+}
+
+func (u *UIBuilder) bundleSize() int {
+	data, assets := u.bundle()
+	n := len(data)
+	for _, a := range assets {
+		n += len(a.Data)
+	}
+	return n
+}
+
+func (p *Plugin) inlineLimit() int {
+	if p.maxSendMsg > 0 {
+		return p.maxSendMsg / 2
+	}
+	return DefaultBundleInlineLimit
+}
+
+func (p *Plugin) inlineBundle(ui *pb.PluginUIInfo) bool {
+	return ui.BundleHash == "" || p.bundleInline.Load()
+}
+
+func bundleDeferred(info *pb.PluginInfo) bool {
+	ui := info.GetUi()
+	return ui.GetHasBundle() && ui.GetBundleHash() != "" && len(ui.GetBundleData()) == 0 && len(ui.GetAssets()) == 0
+}
+
+func (p *Plugin) startBundleUpload(ctx context.Context, info *pb.PluginInfo) {
+	if !bundleDeferred(info) || p.ui.bundleSize() == 0 {
+		return
+	}
+	switch {
+	case p.PanelHasCapability(capBundleUpload):
+		go p.uploadBundle(ctx, info.Ui.BundleHash)
+	case p.bundleCache:
+	case p.ui.bundleSize() > p.inlineLimit():
+		p.printf(LevelWarn, "ui bundle of %d bytes is too large to inline and the panel does not support bundle uploads", p.ui.bundleSize())
+	default:
+		p.bundleInline.Store(true)
+		go func() {
+			if err := p.UpdateRegistration(); err != nil {
+				p.printf(LevelWarn, "sending inline ui bundle: %v", err)
+			}
+		}()
+	}
+}
+
+func (p *Plugin) uploadBundle(ctx context.Context, hash string) {
+	payload, err := proto.Marshal(p.bundleUpload())
+	if err != nil {
+		p.printf(LevelError, "encoding ui bundle: %v", err)
+		return
+	}
+	sum := sha256.Sum256(payload)
+	checksum := hex.EncodeToString(sum[:])
+	total := int64(len(payload))
+
+	for attempt := 1; ; attempt++ {
+		skipped, err := p.sendBundle(ctx, hash, payload, checksum, attempt)
+		if err == nil {
+			p.bundleProgress(BundleUploadProgress{Hash: hash, Sent: total, Total: total, Attempt: attempt, Done: true, Skipped: skipped})
+			if !skipped {
+				p.printf(LevelInfo, "uploaded ui bundle %s (%d bytes)", hash, total)
+			}
+			return
+		}
+		p.bundleProgress(BundleUploadProgress{Hash: hash, Total: total, Attempt: attempt, Err: err})
+		if ctx.Err() != nil {
+			return
+		}
+		if attempt == bundleUploadAttempts {
+			p.printf(LevelError, "ui bundle upload failed after %d attempts: %v", attempt, err)
+			return
+		}
+		backoff := bundleUploadBackoff << (attempt - 1)
+		p.printf(LevelWarn, "ui bundle upload failed (%v), retrying in %s", err, backoff)
+		timer := p.clock().NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+	}
+}
+
+func (p *Plugin) sendBundle(ctx context.Context, hash string, payload []byte, checksum string, attempt int) (bool, error) {
+	ctx, cancel := context.WithCancel(pluginContext(ctx, p.id, p.instance))
+	defer cancel()
+	stream, err := p.panel.UploadBundle(ctx, p.callOptions()...)
+	if err != nil {
+		return false, err
+	}
+	chunk := bundleChunkSize
+	if p.maxSendMsg > 0 && p.maxSendMsg/2 < chunk {
+		chunk = p.maxSendMsg / 2
+	}
+	total := int64(len(payload))
+	closed := false
+	for off := 0; off < len(payload) && !closed; off += chunk {
+		end := min(off+chunk, len(payload))
+		err := stream.Send(&pb.BundleChunk{Hash: hash, TotalSize: total, Data: payload[off:end]})
+		switch {
+		case err == io.EOF:
+			closed = true
+		case err != nil:
+			return false, err
+		default:
+			p.bundleProgress(BundleUploadProgress{Hash: hash, Sent: int64(end), Total: total, Attempt: attempt})
+		}
+	}
+	if !closed {
+		if err := stream.Send(&pb.BundleChunk{Hash: hash, TotalSize: total, Eof: true, Checksum: checksum}); err != nil && err != io.EOF {
+			return false, err
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case res.Error != "":
+		return false, errors.New("birdactyl: panel rejected ui bundle: " + res.Error)
+	case !res.Stored && !res.Skipped:
+		return false, errors.New("birdactyl: panel did not store ui bundle")
+	}
+	return res.Skipped, nil
+}
+
+func (p *Plugin) bundleProgress(progress BundleUploadProgress) {
+	p.regMu.RLock()
+	handlers := p.onBundleUpload
+	p.regMu.RUnlock()
+	for _, fn := range handlers {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					p.reportPanic(r, "bundle-upload", "")
+				}
+			}()
+			fn(progress)
+		}()
+	}
+}
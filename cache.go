@@ -0,0 +1,216 @@
+package birdactyl
+
+import (
+	"container/list"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const DefaultCacheMaxBytes = 32 << 20
+
+const cacheEntryOverhead = 128
+
+type CacheMetrics struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+	Bytes     int64
+}
+
+type responseCache struct {
+	mu       sync.Mutex
+	maxBytes int64
+	bytes    int64
+	gen      uint64
+	lru      *list.List
+	entries  map[string]*list.Element
+	stats    CacheMetrics
+}
+
+type cacheEntry struct {
+	key     string
+	method  string
+	path    string
+	status  int32
+	headers map[string]string
+	body    []byte
+	expires time.Time
+	size    int64
+}
+
+func WithCacheMaxBytes(n int64) Option {
+	return func(p *Plugin) {
+		p.cache.mu.Lock()
+		p.cache.maxBytes = n
+		p.cache.mu.Unlock()
+	}
+}
+
+func (rb *RouteBuilder) Cache(ttl time.Duration) *RouteBuilder {
+	rb.config.CacheTTL = ttl
+	return rb
+}
+
+func (rb *RouteBuilder) CacheShared() *RouteBuilder {
+	rb.config.CacheShared = true
+	return rb
+}
+
+func (p *Plugin) InvalidateRoute(method, path string) *Plugin {
+	p.cache.invalidate(func(e *cacheEntry) bool {
+		return methodMatches(method, e.method) && e.path == path
+	})
+	return p
+}
+
+func (p *Plugin) InvalidateRoutes(method, pattern string) *Plugin {
+	p.cache.invalidate(func(e *cacheEntry) bool {
+		return methodMatches(method, e.method) && matchPath(pattern, e.path)
+	})
+	return p
+}
+
+func (p *Plugin) PurgeCache() *Plugin {
+	p.cache.invalidate(func(*cacheEntry) bool { return true })
+	return p
+}
+
+func methodMatches(want, method string) bool {
+	return want == "" || want == "*" || strings.EqualFold(want, method)
+}
+
+func cacheable(cfg *RouteConfig, method string) bool {
+	return cfg.CacheTTL > 0 && (method == "GET" || method == "HEAD")
+}
+
+func cacheKey(cfg *RouteConfig, r Request) string {
+	var b strings.Builder
+	b.WriteString(r.Method)
+	b.WriteByte(0)
+	b.WriteString(r.Path)
+	b.WriteByte(0)
+	keys := make([]string, 0, len(r.Query))
+	for k := range r.Query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(r.Query[k])
+		b.WriteByte('&')
+	}
+	for _, h := range cacheVary {
+		b.WriteByte(0)
+		b.WriteString(headerValue(r.Headers, h))
+	}
+	if !cfg.CacheShared {
+		b.WriteByte(0)
+		b.WriteString(r.UserID)
+	}
+	return b.String()
+}
+
+var cacheVary = []string{"Authorization", "Accept"}
+
+func (c *responseCache) init() {
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+		c.lru = list.New()
+	}
+}
+
+func (c *responseCache) get(key string, now time.Time) (*pb.HTTPResponse, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*cacheEntry)
+		if now.Before(e.expires) {
+			c.lru.MoveToFront(el)
+			c.stats.Hits++
+			return e.response("HIT"), c.gen, true
+		}
+		c.remove(el)
+	}
+	c.stats.Misses++
+	return nil, c.gen, false
+}
+
+func (c *responseCache) put(gen uint64, e *cacheEntry) {
+	e.size = int64(len(e.key)+len(e.body)) + cacheEntryOverhead
+	for k, v := range e.headers {
+		e.size += int64(len(k) + len(v))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	if gen != c.gen || e.size > c.maxBytes {
+		return
+	}
+	if el, ok := c.entries[e.key]; ok {
+		c.remove(el)
+	}
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.bytes += e.size
+	for c.bytes > c.maxBytes {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *responseCache) invalidate(match func(*cacheEntry) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	c.gen++
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if match(el.Value.(*cacheEntry)) {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+func (c *responseCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*cacheEntry)
+	delete(c.entries, e.key)
+	c.bytes -= e.size
+}
+
+func (c *responseCache) metrics() CacheMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m := c.stats
+	m.Entries = len(c.entries)
+	m.Bytes = c.bytes
+	return m
+}
+
+func (e *cacheEntry) storable() bool {
+	if e.status < 200 || e.status >= 300 {
+		return false
+	}
+	for k := range e.headers {
+		if strings.EqualFold(k, "Set-Cookie") {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *cacheEntry) response(state string) *pb.HTTPResponse {
+	headers := make(map[string]string, len(e.headers)+1)
+	for k, v := range e.headers {
+		headers[k] = v
+	}
+	headers["X-Cache"] = state
+	return &pb.HTTPResponse{Status: e.status, Headers: headers, Body: e.body}
+}
@@ -0,0 +1,90 @@
+package birdactyl_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+func TestCacheSharedSkipsSetCookie(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	p := birdactyl.New("cache", "1.0.0")
+	var calls atomic.Int32
+	p.Route("GET", "/session", func(r birdactyl.Request) birdactyl.Response {
+		calls.Add(1)
+		resp := birdactyl.Text("ok")
+		resp.Headers["Set-Cookie"] = "session=" + r.UserID
+		return resp
+	}).Cache(time.Minute).CacheShared()
+	tp.StartPlugin(p)
+
+	first := tp.DoHTTPRequest(&pb.HTTPRequest{Method: "GET", Path: "/session", UserId: "alice"})
+	second := tp.DoHTTPRequest(&pb.HTTPRequest{Method: "GET", Path: "/session", UserId: "bob"})
+	if got := second.Headers["Set-Cookie"]; got != "session=bob" {
+		t.Fatalf("second Set-Cookie = %q, want session=bob (first was %q)", got, first.Headers["Set-Cookie"])
+	}
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("handler calls = %d, want 2", n)
+	}
+}
+
+func TestCacheVariesOnAuthorization(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	p := birdactyl.New("cache", "1.0.0")
+	p.Route("GET", "/me", func(r birdactyl.Request) birdactyl.Response {
+		return birdactyl.Text(r.Headers["Authorization"])
+	}).Cache(time.Minute).CacheShared()
+	tp.StartPlugin(p)
+
+	a := tp.DoHTTPRequest(&pb.HTTPRequest{Method: "GET", Path: "/me", Headers: map[string]string{"Authorization": "Bearer a"}})
+	b := tp.DoHTTPRequest(&pb.HTTPRequest{Method: "GET", Path: "/me", Headers: map[string]string{"Authorization": "Bearer b"}})
+	if string(a.Body) != "Bearer a" || string(b.Body) != "Bearer b" {
+		t.Fatalf("bodies = %q, %q, want each caller's own token", a.Body, b.Body)
+	}
+	again := tp.DoHTTPRequest(&pb.HTTPRequest{Method: "GET", Path: "/me", Headers: map[string]string{"Authorization": "Bearer a"}})
+	if again.Headers["X-Cache"] != "HIT" {
+		t.Fatalf("repeat X-Cache = %q, want HIT", again.Headers["X-Cache"])
+	}
+}
+
+func TestCacheCoalescesConcurrentMisses(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	p := birdactyl.New("cache", "1.0.0", birdactyl.WithWorkers(4))
+	var calls atomic.Int32
+	release := make(chan struct{})
+	p.Route("GET", "/slow", func(r birdactyl.Request) birdactyl.Response {
+		calls.Add(1)
+		<-release
+		return birdactyl.Text("done")
+	}).Cache(time.Minute).CacheShared()
+	tp.StartPlugin(p)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if resp := tp.DoHTTP("GET", "/slow", nil); string(resp.Body) != "done" {
+				t.Errorf("body = %q, want done", resp.Body)
+			}
+		}()
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for calls.Load() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("handler never ran")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("handler calls = %d, want 1", n)
+	}
+}
@@ -0,0 +1,56 @@
+package birdactyl
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+	NewTicker(d time.Duration) Ticker
+}
+
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+type realClock struct{}
+
+type realTimer struct{ t *time.Timer }
+
+type realTicker struct{ t *time.Ticker }
+
+func (realClock) Now() time.Time                   { return time.Now() }
+func (realClock) NewTimer(d time.Duration) Timer   { return realTimer{time.NewTimer(d)} }
+func (realClock) NewTicker(d time.Duration) Ticker { return realTicker{time.NewTicker(d)} }
+func (t realTimer) C() <-chan time.Time            { return t.t.C }
+func (t realTimer) Stop() bool                     { return t.t.Stop() }
+func (t realTimer) Reset(d time.Duration) bool     { return t.t.Reset(d) }
+func (t realTicker) C() <-chan time.Time           { return t.t.C }
+func (t realTicker) Stop()                         { t.t.Stop() }
+
+func WithClock(c Clock) Option {
+	return func(p *Plugin) {
+		p.clk = c
+	}
+}
+
+func (p *Plugin) clock() Clock {
+	if p.clk == nil {
+		return realClock{}
+	}
+	return p.clk
+}
+
+func sleep(c Clock, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	t := c.NewTimer(d)
+	<-t.C()
+}
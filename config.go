@@ -16,12 +16,14 @@ type HotConfig[T any] struct {
 	lastModified time.Time
 	onChange     func(T)
 	stopCh       chan struct{}
+	clock        Clock
 }
 
 func NewHotConfig[T any](path string, defaultConfig T) *HotConfig[T] {
 	h := &HotConfig[T]{
 		path:   path,
 		config: defaultConfig,
+		clock:  realClock{},
 	}
 	h.load()
 	return h
@@ -45,19 +47,25 @@ func (h *HotConfig[T]) OnChange(fn func(T)) *HotConfig[T] {
 	return h
 }
 
+func (h *HotConfig[T]) WithClock(c Clock) *HotConfig[T] {
+	h.clock = c
+	return h
+}
+
 func (h *HotConfig[T]) DynamicConfig() *HotConfig[T] {
 	if h.stopCh != nil {
 		return h
 	}
-	h.stopCh = make(chan struct{})
+	stop := make(chan struct{})
+	h.stopCh = stop
+	ticker := h.clock.NewTicker(time.Second)
 	go func() {
-		ticker := time.NewTicker(time.Second)
 		defer ticker.Stop()
 		for {
 			select {
-			case <-ticker.C:
+			case <-ticker.C():
 				h.checkReload()
-			case <-h.stopCh:
+			case <-stop:
 				return
 			}
 		}
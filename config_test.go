@@ -0,0 +1,45 @@
+package birdactyl_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+type hotSettings struct {
+	Motd string `yaml:"motd"`
+}
+
+func TestHotConfigReloadsOnClockTick(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	clock := birdactyltest.NewFakeClock(time.Unix(0, 0))
+	changed := make(chan hotSettings, 1)
+	h := birdactyl.NewHotConfig(path, hotSettings{Motd: "hello"}).
+		WithClock(clock).
+		OnChange(func(s hotSettings) { changed <- s }).
+		DynamicConfig()
+	defer h.StopWatching()
+
+	if err := os.WriteFile(path, []byte("motd: updated\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatal(err)
+	}
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+
+	select {
+	case s := <-changed:
+		if s.Motd != "updated" {
+			t.Fatalf("Motd = %q, want updated", s.Motd)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("config was not reloaded after the clock ticked")
+	}
+}
@@ -0,0 +1,186 @@
+package birdactyl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	DefaultHeartbeatInterval = 15 * time.Second
+	DefaultStaleTimeout      = 45 * time.Second
+	DefaultKeepaliveTime     = time.Minute
+	DefaultKeepaliveTimeout  = 20 * time.Second
+
+	reconnectMinBackoff = time.Second
+	reconnectMaxBackoff = 30 * time.Second
+)
+
+var errStale = errors.New("birdactyl: no contact from panel, connection is stale")
+
+type State int32
+
+const (
+	StateConnecting State = iota
+	StateConnected
+	StateDisconnected
+	StateDraining
+)
+
+func (s State) String() string {
+	switch s {
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	case StateDisconnected:
+		return "disconnected"
+	case StateDraining:
+		return "draining"
+	}
+	return "unknown"
+}
+
+func WithHeartbeat(interval, staleAfter time.Duration) Option {
+	return func(p *Plugin) {
+		p.heartbeat = interval
+		p.staleAfter = staleAfter
+	}
+}
+
+func WithKeepalive(interval, timeout time.Duration) Option {
+	return func(p *Plugin) {
+		p.keepalive = keepalive.ClientParameters{Time: interval, Timeout: timeout}
+	}
+}
+
+func (p *Plugin) ConnectionState() State {
+	return State(p.state.Load())
+}
+
+func (p *Plugin) LastContact() time.Time {
+	ns := p.lastContact.Load()
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+func (p *Plugin) WaitConnected(ctx context.Context) error {
+	for {
+		p.stateMu.Lock()
+		if p.ConnectionState() == StateConnected {
+			p.stateMu.Unlock()
+			return nil
+		}
+		if p.stateChanged == nil {
+			p.stateChanged = make(chan struct{})
+		}
+		changed := p.stateChanged
+		p.stateMu.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (p *Plugin) setState(s State) {
+	p.stateMu.Lock()
+	p.state.Store(int32(s))
+	if p.stateChanged != nil {
+		close(p.stateChanged)
+		p.stateChanged = nil
+	}
+	p.stateMu.Unlock()
+}
+
+func (p *Plugin) touch() {
+	p.lastContact.Store(p.clock().Now().UnixNano())
+}
+
+func (p *Plugin) keepaliveOption() grpc.DialOption {
+	params := p.keepalive
+	if params.Time == 0 {
+		params.Time = DefaultKeepaliveTime
+	}
+	if params.Timeout == 0 {
+		params.Timeout = DefaultKeepaliveTimeout
+	}
+	return grpc.WithKeepaliveParams(params)
+}
+
+func (p *Plugin) connect(ctx context.Context, conn grpc.ClientConnInterface) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	backoff := reconnectMinBackoff
+	for {
+		err := p.run(ctx, conn)
+		wasConnected := p.ConnectionState() != StateConnecting
+		p.setState(StateDisconnected)
+		var reqErr *RequirementError
+		if err == errShutdown || ctx.Err() != nil || errors.As(err, &reqErr) {
+			if wasConnected {
+				p.fireDisconnect(nil)
+			}
+			if !p.lifecycle.drain(p.clock(), p.drainTimeout) {
+				p.printf(LevelWarn, "lifecycle callbacks still running at exit")
+			}
+			return err
+		}
+		if wasConnected {
+			backoff = reconnectMinBackoff
+			p.fireDisconnect(err)
+		}
+		p.printf(LevelWarn, "disconnected from panel (%v), reconnecting in %s", err, backoff)
+		timer := p.clock().NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C():
+		}
+		if backoff *= 2; backoff > reconnectMaxBackoff {
+			backoff = reconnectMaxBackoff
+		}
+	}
+}
+
+func (p *Plugin) heartbeatLoop(ctx context.Context, teardown func()) {
+	interval, staleAfter := p.heartbeat, p.staleAfter
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleTimeout
+	}
+	ticker := p.clock().NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C():
+			if p.pingSupported.Load() && now.Sub(p.LastContact()) > staleAfter {
+				p.stale.Store(true)
+				p.printf(LevelWarn, "no contact from panel for %s, tearing down connection", now.Sub(p.LastContact()).Round(time.Second))
+				teardown()
+				return
+			}
+			p.send(&pb.PluginMessage{Payload: &pb.PluginMessage_Ping{Ping: &pb.Ping{SentUnixMs: now.UnixMilli()}}})
+		}
+	}
+}
+
+func (p *Plugin) handlePing(ping *pb.Ping) {
+	if ping.Reply {
+		p.pingSupported.Store(true)
+		return
+	}
+	p.send(&pb.PluginMessage{Payload: &pb.PluginMessage_Ping{Ping: &pb.Ping{SentUnixMs: ping.SentUnixMs, Reply: true}}})
+}
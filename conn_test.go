@@ -0,0 +1,36 @@
+package birdactyl_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestWaitConnected(t *testing.T) {
+	p := birdactyl.New("wait", "1.0.0")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := p.WaitConnected(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitConnected before start = %v, want DeadlineExceeded", err)
+	}
+
+	tp := birdactyltest.NewPanel(t)
+	waited := make(chan error, 1)
+	go func() { waited <- p.WaitConnected(context.Background()) }()
+	tp.StartPlugin(p)
+	select {
+	case err := <-waited:
+		if err != nil {
+			t.Fatalf("WaitConnected = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WaitConnected did not return after the plugin connected")
+	}
+	if state := p.ConnectionState(); state != birdactyl.StateConnected {
+		t.Fatalf("state = %s, want connected", state)
+	}
+}
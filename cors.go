@@ -0,0 +1,176 @@
+package birdactyl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type CORSConfig struct {
+	AllowOrigins     []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+func (c CORSConfig) validate() error {
+	if len(c.AllowOrigins) == 0 {
+		return fmt.Errorf("cors: at least one allowed origin is required")
+	}
+	for _, o := range c.AllowOrigins {
+		if o == "*" && c.AllowCredentials {
+			return fmt.Errorf("cors: wildcard origin cannot be combined with credentials")
+		}
+		if strings.Count(o, "*") > 1 {
+			return fmt.Errorf("cors: origin %q may contain at most one wildcard", o)
+		}
+	}
+	return nil
+}
+
+func (p *Plugin) CORS(cfg CORSConfig) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if err := cfg.validate(); err != nil {
+		p.regErrs = append(p.regErrs, err)
+		return p
+	}
+	p.cors = &cfg
+	return p
+}
+
+func (rb *RouteBuilder) CORS(cfg CORSConfig) *RouteBuilder {
+	rb.plugin.regMu.Lock()
+	defer rb.plugin.regMu.Unlock()
+	if err := cfg.validate(); err != nil {
+		rb.plugin.regErrs = append(rb.plugin.regErrs, fmt.Errorf("route %s %s: %w", rb.config.Method, rb.config.Path, err))
+		return rb
+	}
+	rb.config.CORS = &cfg
+	return rb
+}
+
+func (c *CORSConfig) allowOrigin(origin string) (string, bool) {
+	if origin == "" {
+		return "", false
+	}
+	for _, o := range c.AllowOrigins {
+		if o == "*" {
+			return "*", true
+		}
+		prefix, suffix, wild := strings.Cut(o, "*")
+		if o == origin || (wild && len(origin) > len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix)) {
+			return origin, true
+		}
+	}
+	return "", false
+}
+
+func (c *CORSConfig) apply(headers map[string]string, origin string) bool {
+	allowed, ok := c.allowOrigin(origin)
+	if !ok {
+		return false
+	}
+	headers["Access-Control-Allow-Origin"] = allowed
+	if allowed != "*" {
+		headers["Vary"] = "Origin"
+	}
+	if c.AllowCredentials {
+		headers["Access-Control-Allow-Credentials"] = "true"
+	}
+	if len(c.ExposeHeaders) > 0 {
+		headers["Access-Control-Expose-Headers"] = strings.Join(c.ExposeHeaders, ", ")
+	}
+	return true
+}
+
+func (p *Plugin) corsFor(cfg *RouteConfig, path string) *CORSConfig {
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+	if cfg != nil {
+		if cfg.CORS != nil {
+			return cfg.CORS
+		}
+		return p.cors
+	}
+	for _, c := range p.activeRoutes() {
+		if matchPath(c.Path, path) {
+			if c.CORS != nil {
+				return c.CORS
+			}
+			if p.cors != nil {
+				return p.cors
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Plugin) activeRoutes() map[string]*RouteConfig {
+	if p.liveRoutes != nil {
+		return p.liveRoutes
+	}
+	return p.routes
+}
+
+func (p *Plugin) pathMethods(path string) []string {
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+	seen := map[string]bool{"OPTIONS": true}
+	for _, c := range p.activeRoutes() {
+		if matchPath(c.Path, path) {
+			if c.Method == "*" {
+				return []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+			}
+			seen[c.Method] = true
+		}
+	}
+	methods := make([]string, 0, len(seen))
+	for m := range seen {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+func (p *Plugin) preflight(cors *CORSConfig, req *pb.HTTPRequest) *pb.HTTPResponse {
+	resp := &pb.HTTPResponse{Status: 204, Headers: map[string]string{}}
+	if !cors.apply(resp.Headers, headerValue(req.Headers, "Origin")) {
+		return resp
+	}
+	methods := cors.AllowMethods
+	if len(methods) == 0 {
+		methods = p.pathMethods(req.Path)
+	}
+	resp.Headers["Access-Control-Allow-Methods"] = strings.Join(methods, ", ")
+	switch {
+	case len(cors.AllowHeaders) == 1 && cors.AllowHeaders[0] == "*":
+		if requested := headerValue(req.Headers, "Access-Control-Request-Headers"); requested != "" {
+			resp.Headers["Access-Control-Allow-Headers"] = requested
+		}
+	case len(cors.AllowHeaders) > 0:
+		resp.Headers["Access-Control-Allow-Headers"] = strings.Join(cors.AllowHeaders, ", ")
+	}
+	if cors.MaxAge > 0 {
+		resp.Headers["Access-Control-Max-Age"] = strconv.Itoa(int(cors.MaxAge / time.Second))
+	}
+	return resp
+}
+
+func headerValue(headers map[string]string, name string) string {
+	if v, ok := headers[name]; ok {
+		return v
+	}
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
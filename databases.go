@@ -0,0 +1,93 @@
+package birdactyl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type DatabaseCredentials struct {
+	DatabaseID string
+	Name       string
+	Host       string
+	Port       int32
+	Username   string
+	Password   string
+}
+
+type DatabaseExistsError struct {
+	Database Database
+	err      error
+}
+
+func (e *DatabaseExistsError) Error() string {
+	return ErrDatabaseExists.Error() + ": " + e.Database.Name
+}
+
+func (e *DatabaseExistsError) Is(target error) bool {
+	return target == ErrDatabaseExists
+}
+
+func (e *DatabaseExistsError) Unwrap() error {
+	return e.err
+}
+
+func (c DatabaseCredentials) DSN() string {
+	return c.Username + ":" + c.Password + "@tcp(" + c.Host + ":" + strconv.Itoa(int(c.Port)) + ")/" + c.Name
+}
+
+func databaseFromProto(d *pb.Database) Database {
+	return Database{ID: d.Id, Name: d.Name, Username: d.Username, Host: d.Host, Port: d.Port, ServerID: d.ServerId, AllowedHost: d.AllowedHost}
+}
+
+func credentialsFromProto(d *pb.Database) *DatabaseCredentials {
+	return &DatabaseCredentials{DatabaseID: d.Id, Name: d.Name, Host: d.Host, Port: d.Port, Username: d.Username, Password: d.Password}
+}
+
+func (a *API) Databases(ctx context.Context, serverID string) ([]Database, error) {
+	r, err := a.panel.ListDatabases(a.outgoing(ctx), &pb.IDRequest{Id: serverID})
+	if err != nil {
+		return nil, serverErr(err)
+	}
+	out := make([]Database, len(r.Databases))
+	for i, d := range r.Databases {
+		out[i] = databaseFromProto(d)
+	}
+	return out, nil
+}
+
+func (a *API) CreateDatabaseContext(ctx context.Context, serverID, name, allowedHost string) (*DatabaseCredentials, error) {
+	r, err := a.panel.CreateDatabase(a.outgoing(ctx), &pb.CreateDatabaseRequest{ServerId: serverID, Name: name, AllowedHost: allowedHost})
+	if err == nil {
+		return credentialsFromProto(r), nil
+	}
+	err = serverErr(err)
+	if !errors.Is(err, ErrAlreadyExists) {
+		return nil, err
+	}
+	list, lerr := a.panel.ListDatabases(a.outgoing(ctx), &pb.IDRequest{Id: serverID})
+	if lerr != nil {
+		return nil, err
+	}
+	for _, d := range list.Databases {
+		if d.Name == name {
+			return nil, &DatabaseExistsError{Database: databaseFromProto(d), err: err}
+		}
+	}
+	return nil, err
+}
+
+func (a *API) RotateDatabasePasswordContext(ctx context.Context, databaseID string) (*DatabaseCredentials, error) {
+	r, err := a.panel.RotateDatabasePassword(a.outgoing(ctx), &pb.IDRequest{Id: databaseID})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	return credentialsFromProto(r), nil
+}
+
+func (a *API) DeleteDatabaseContext(ctx context.Context, databaseID string) error {
+	_, err := a.panel.DeleteDatabase(a.outgoing(ctx), &pb.IDRequest{Id: databaseID})
+	return panelErr(err)
+}
@@ -0,0 +1,54 @@
+package birdactyl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type databasePanel struct {
+	pb.PanelServiceClient
+	existing []*pb.Database
+}
+
+func (d *databasePanel) CreateDatabase(ctx context.Context, req *pb.CreateDatabaseRequest, opts ...grpc.CallOption) (*pb.Database, error) {
+	for _, db := range d.existing {
+		if db.Name == req.Name {
+			return nil, status.Error(codes.AlreadyExists, "database exists")
+		}
+	}
+	return &pb.Database{Id: "db-new", Name: req.Name, Password: "secret"}, nil
+}
+
+func (d *databasePanel) ListDatabases(ctx context.Context, req *pb.IDRequest, opts ...grpc.CallOption) (*pb.ListDatabasesResponse, error) {
+	return &pb.ListDatabasesResponse{Databases: d.existing}, nil
+}
+
+func TestCreateDatabaseExisting(t *testing.T) {
+	a := &API{panel: &databasePanel{existing: []*pb.Database{{Id: "db-1", Name: "app", ServerId: "s1", Username: "u_app"}}}}
+
+	creds, err := a.CreateDatabaseContext(context.Background(), "s1", "app", "%")
+	if creds != nil {
+		t.Fatalf("returned credentials %+v for an existing database", creds)
+	}
+	var exists *DatabaseExistsError
+	if !errors.As(err, &exists) {
+		t.Fatalf("err = %v, want *DatabaseExistsError", err)
+	}
+	if exists.Database.ID != "db-1" || exists.Database.Username != "u_app" {
+		t.Fatalf("existing database = %+v", exists.Database)
+	}
+	if !errors.Is(err, ErrDatabaseExists) || !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("err = %v, want ErrDatabaseExists and ErrAlreadyExists", err)
+	}
+
+	creds, err = a.CreateDatabaseContext(context.Background(), "s1", "other", "%")
+	if err != nil || creds.Password != "secret" {
+		t.Fatalf("create new database = %+v, %v", creds, err)
+	}
+}
@@ -0,0 +1,60 @@
+package birdactyl
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+func (p *Plugin) DataPath(filename string) string {
+	clean := path.Clean("/" + strings.TrimPrefix(normalizeDataName(filename), volumePrefix(filename)))
+	return filepath.Join(p.dataDir, filepath.FromSlash(clean))
+}
+
+func (p *Plugin) DataPathE(filename string) (string, error) {
+	name := normalizeDataName(filename)
+	if name == "" || strings.HasPrefix(name, "/") || volumePrefix(filename) != "" || filepath.IsAbs(filename) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPath, filename)
+	}
+	clean := path.Clean(name)
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPath, filename)
+	}
+	return filepath.Join(p.dataDir, filepath.FromSlash(clean)), nil
+}
+
+func (p *Plugin) SaveData(filename string, data []byte) error {
+	target, err := p.DataPathE(filename)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	tmp := target + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, target)
+}
+
+func (p *Plugin) LoadData(filename string) ([]byte, error) {
+	target, err := p.DataPathE(filename)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(target)
+}
+
+func normalizeDataName(name string) string {
+	return strings.ReplaceAll(name, "\\", "/")
+}
+
+func volumePrefix(name string) string {
+	if len(name) >= 2 && name[1] == ':' && (name[0] >= 'a' && name[0] <= 'z' || name[0] >= 'A' && name[0] <= 'Z') {
+		return name[:2]
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package birdactyl
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestDataPathE(t *testing.T) {
+	root := "plugin_data"
+	p := &Plugin{dataDir: root}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"state.json", "state.json"},
+		{"servers/s1.json", "servers/s1.json"},
+		{"a/../b", "b"},
+		{"./a//b", "a/b"},
+		{`servers\s1.json`, "servers/s1.json"},
+		{`a\..\b`, "b"},
+		{"a/../../b", ""},
+		{"../escape", ""},
+		{"..", ""},
+		{".", ""},
+		{"", ""},
+		{"/etc/passwd", ""},
+		{`\etc\passwd`, ""},
+		{`..\..\windows`, ""},
+		{`a\..\..\b`, ""},
+		{`C:\Windows\system32`, ""},
+		{"c:relative", ""},
+		{`\\server\share\x`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.DataPathE(tt.name)
+			if tt.want == "" {
+				if !errors.Is(err, ErrInvalidPath) {
+					t.Fatalf("DataPathE(%q) = %q, %v; want ErrInvalidPath", tt.name, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DataPathE(%q): %v", tt.name, err)
+			}
+			if want := filepath.Join(root, filepath.FromSlash(tt.want)); got != want {
+				t.Fatalf("DataPathE(%q) = %q, want %q", tt.name, got, want)
+			}
+		})
+	}
+}
+
+func TestDataPathClampsToRoot(t *testing.T) {
+	root := "plugin_data"
+	p := &Plugin{dataDir: root}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"state.json", "state.json"},
+		{"a/../../b", "b"},
+		{"../../etc/cron.d/evil", "etc/cron.d/evil"},
+		{"/etc/passwd", "etc/passwd"},
+		{`..\..\windows\win.ini`, "windows/win.ini"},
+		{`C:\Windows\system32`, "Windows/system32"},
+		{"..", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := p.DataPath(tt.name), filepath.Join(root, filepath.FromSlash(tt.want)); got != want {
+				t.Fatalf("DataPath(%q) = %q, want %q", tt.name, got, want)
+			}
+		})
+	}
+}
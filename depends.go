@@ -0,0 +1,96 @@
+package birdactyl
+
+import (
+	"fmt"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type DependencyInfo struct {
+	PluginID   string
+	Constraint string
+	Optional   bool
+	Present    bool
+	Version    string
+	Satisfied  bool
+}
+
+func (p *Plugin) DependsOn(pluginID, versionConstraint string) *Plugin {
+	return p.addDependency(pluginID, versionConstraint, false)
+}
+
+func (p *Plugin) OptionalDependsOn(pluginID, versionConstraint string) *Plugin {
+	return p.addDependency(pluginID, versionConstraint, true)
+}
+
+func (p *Plugin) addDependency(pluginID, constraint string, optional bool) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	for _, d := range p.deps {
+		if d.PluginId == pluginID {
+			p.regErrs = append(p.regErrs, fmt.Errorf("dependency %q declared twice", pluginID))
+			return p
+		}
+	}
+	if constraint != "" {
+		if _, err := parseConstraint(constraint); err != nil {
+			p.regErrs = append(p.regErrs, fmt.Errorf("dependency %q: %v", pluginID, err))
+		}
+	}
+	p.deps = append(p.deps, &pb.PluginDependency{PluginId: pluginID, Version: constraint, Optional: optional})
+	return p
+}
+
+func (p *Plugin) Dependency(pluginID string) (DependencyInfo, bool) {
+	p.panelMu.RLock()
+	defer p.panelMu.RUnlock()
+	info, ok := p.depStatus[pluginID]
+	return info, ok && info.Present
+}
+
+func (p *Plugin) setDependencies(statuses []*pb.DependencyStatus) {
+	reported := make(map[string]*pb.DependencyStatus, len(statuses))
+	for _, s := range statuses {
+		reported[s.PluginId] = s
+	}
+	infos := make(map[string]DependencyInfo, len(p.deps))
+	for _, d := range p.deps {
+		info := DependencyInfo{PluginID: d.PluginId, Constraint: d.Version, Optional: d.Optional}
+		if s, ok := reported[d.PluginId]; ok && s.Present {
+			info.Present = true
+			info.Version = s.Version
+			info.Satisfied = d.Version == ""
+			if !info.Satisfied {
+				info.Satisfied, _ = versionSatisfies(s.Version, d.Version)
+			}
+		}
+		infos[d.PluginId] = info
+	}
+	p.panelMu.Lock()
+	p.depStatus = infos
+	p.depsReported = len(statuses) > 0
+	p.panelMu.Unlock()
+}
+
+func (p *Plugin) missingDependencies() []string {
+	p.panelMu.RLock()
+	defer p.panelMu.RUnlock()
+	if !p.depsReported {
+		if len(p.deps) > 0 {
+			p.printf(LevelWarn, "panel did not report plugin dependencies, skipping dependency checks")
+		}
+		return nil
+	}
+	var missing []string
+	for _, d := range p.deps {
+		info := p.depStatus[d.PluginId]
+		switch {
+		case d.Optional:
+		case !info.Present:
+			missing = append(missing, fmt.Sprintf("plugin %q is not installed", d.PluginId))
+		case !info.Satisfied:
+			missing = append(missing, fmt.Sprintf("plugin %q %s required, installed version is %s", d.PluginId, d.Version, info.Version))
+		}
+	}
+	return missing
+}
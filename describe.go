@@ -0,0 +1,191 @@
+package birdactyl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const InfoRoutePath = "/__birdactyl/info"
+
+type Description struct {
+	ID            string                `json:"id"`
+	Instance      string                `json:"instance,omitempty"`
+	Name          string                `json:"name"`
+	Version       string                `json:"version"`
+	SDKVersion    string                `json:"sdk_version"`
+	Protocol      int                   `json:"protocol"`
+	PanelVersion  string                `json:"panel_version,omitempty"`
+	State         string                `json:"state"`
+	StartError    string                `json:"start_error,omitempty"`
+	UptimeSeconds int64                 `json:"uptime_seconds"`
+	Routes        []RouteInfo           `json:"routes"`
+	Events        []string              `json:"events"`
+	Schedules     []ScheduleDescription `json:"schedules"`
+	Mixins        []MixinDescription    `json:"mixins"`
+	Flags         FlagSnapshot          `json:"flags"`
+	Queues        QueueDescription      `json:"queues"`
+	Metrics       MetricsSummary        `json:"metrics"`
+}
+
+type ScheduleDescription struct {
+	ID    string `json:"id"`
+	Cron  string `json:"cron"`
+	Route string `json:"route,omitempty"`
+}
+
+type MixinDescription struct {
+	Target   string `json:"target"`
+	Priority int    `json:"priority"`
+	Ordered  bool   `json:"ordered,omitempty"`
+}
+
+type QueueDescription struct {
+	Depth             int    `json:"depth"`
+	Capacity          int    `json:"capacity"`
+	Lanes             int    `json:"lanes"`
+	Buffered          int    `json:"buffered"`
+	BackgroundDropped uint64 `json:"background_dropped"`
+	PendingCalls      int    `json:"pending_calls"`
+	DeferredMixins    int    `json:"deferred_mixins"`
+}
+
+type MetricsSummary struct {
+	Handled      uint64 `json:"handled"`
+	Errors       uint64 `json:"errors"`
+	Panics       uint64 `json:"panics"`
+	Rejected     uint64 `json:"rejected"`
+	SendFailures uint64 `json:"send_failures"`
+	Throttled    uint64 `json:"throttled"`
+	CacheHits    uint64 `json:"cache_hits"`
+	CacheMisses  uint64 `json:"cache_misses"`
+}
+
+func WithoutInfoRoute() Option {
+	return func(p *Plugin) {
+		p.noInfoRoute = true
+	}
+}
+
+func WithStartupSummary() Option {
+	return func(p *Plugin) {
+		p.startSummary = true
+	}
+}
+
+func (p *Plugin) Describe() Description {
+	d := Description{
+		ID:            p.id,
+		Instance:      p.instance,
+		Name:          p.name,
+		Version:       p.version,
+		SDKVersion:    sdkVersion(),
+		Protocol:      p.ProtocolVersion(),
+		PanelVersion:  p.PanelVersion(),
+		State:         p.ConnectionState().String(),
+		UptimeSeconds: int64(p.clock().Now().Sub(p.bootTime) / time.Second),
+		Routes:        p.Routes(),
+		Events:        []string{},
+		Schedules:     []ScheduleDescription{},
+		Mixins:        []MixinDescription{},
+		Flags:         p.flags.Snapshot(),
+	}
+	if err := p.StartError(); err != nil {
+		d.StartError = err.Error()
+	}
+	if d.Flags == nil {
+		d.Flags = FlagSnapshot{}
+	}
+
+	p.regMu.RLock()
+	d.Events = append(d.Events, sortedKeys(p.events)...)
+	for _, id := range sortedKeys(p.schedules) {
+		cfg := p.schedules[id]
+		s := ScheduleDescription{ID: cfg.ID, Cron: cfg.Cron}
+		if cfg.Path != "" {
+			s.Route = cfg.Method + " " + cfg.Path
+		}
+		d.Schedules = append(d.Schedules, s)
+	}
+	for _, m := range p.mixins {
+		d.Mixins = append(d.Mixins, MixinDescription{Target: m.Target, Priority: m.Priority, Ordered: m.Key != nil})
+	}
+	p.regMu.RUnlock()
+
+	snap := p.Metrics()
+	d.Queues = QueueDescription{
+		Depth:             snap.Queue.Depth,
+		Capacity:          snap.Queue.Capacity,
+		Lanes:             snap.Queue.Lanes,
+		Buffered:          snap.Background.Pending,
+		BackgroundDropped: snap.Background.Dropped,
+		PendingCalls:      p.PendingCalls(),
+		DeferredMixins:    p.DeferredMixins(),
+	}
+	d.Metrics = MetricsSummary{
+		Rejected:     snap.Queue.Rejected,
+		SendFailures: snap.SendFailures,
+		Throttled:    snap.Throttled,
+		CacheHits:    snap.Cache.Hits,
+		CacheMisses:  snap.Cache.Misses,
+	}
+	for _, h := range snap.Handlers {
+		d.Metrics.Handled += h.Count
+		d.Metrics.Errors += h.Errors
+		d.Metrics.Panics += h.Panics
+	}
+	return d
+}
+
+func (p *Plugin) serveInfo(r Request) Response {
+	u, err := r.User(r.Context())
+	if err != nil || !u.IsAdmin {
+		return ErrorFrom(ErrPermissionDenied)
+	}
+	return JSON(p.Describe())
+}
+
+func (p *Plugin) logSummary() {
+	d := p.Describe()
+	head := fmt.Sprintf("%s v%s (sdk %s, protocol %d", d.ID, d.Version, d.SDKVersion, d.Protocol)
+	if d.Instance != "" {
+		head += ", instance " + d.Instance
+	}
+	if d.PanelVersion != "" {
+		head += ", panel " + d.PanelVersion
+	}
+	p.printf(LevelInfo, "%s)", head)
+
+	routes := make([]string, len(d.Routes))
+	for i, r := range d.Routes {
+		routes[i] = r.Method + " " + r.Path
+	}
+	schedules := make([]string, len(d.Schedules))
+	for i, s := range d.Schedules {
+		schedules[i] = s.ID + " (" + s.Cron + ")"
+	}
+	mixins := make([]string, len(d.Mixins))
+	for i, m := range d.Mixins {
+		mixins[i] = m.Target
+	}
+	flags := make([]string, 0, len(d.Flags))
+	for name, on := range d.Flags {
+		flags = append(flags, fmt.Sprintf("%s=%t", name, on))
+	}
+	sort.Strings(flags)
+
+	p.printf(LevelInfo, "  routes:    %s", summaryList(routes))
+	p.printf(LevelInfo, "  events:    %s", summaryList(d.Events))
+	p.printf(LevelInfo, "  schedules: %s", summaryList(schedules))
+	p.printf(LevelInfo, "  mixins:    %s", summaryList(mixins))
+	p.printf(LevelInfo, "  flags:     %s", summaryList(flags))
+	p.printf(LevelInfo, "  queue:     %d/%d, buffered %d, pending calls %d", d.Queues.Depth, d.Queues.Capacity, d.Queues.Buffered, d.Queues.PendingCalls)
+}
+
+func summaryList(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,119 @@
+package birdactyl
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	devBundleEnv      = "BIRDACTYL_DEV"
+	devBundleDebounce = 100 * time.Millisecond
+)
+
+func (p *Plugin) devBundleEnabled() bool {
+	if p.ui.devDir == "" {
+		return false
+	}
+	if os.Getenv(devBundleEnv) != "1" {
+		p.printf(LevelWarn, "ignoring DevBundleDir %s: set %s=1 to enable", p.ui.devDir, devBundleEnv)
+		return false
+	}
+	return true
+}
+
+func (p *Plugin) loadDevBundle() {
+	assets, err := readAssets(os.DirFS(p.ui.devDir), ".")
+	if err != nil {
+		p.printf(LevelError, "failed to load dev bundle %s: %v", p.ui.devDir, err)
+		return
+	}
+	p.ui.setAssets(assets)
+}
+
+func (p *Plugin) watchDevBundle(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		p.printf(LevelError, "failed to watch dev bundle %s: %v", p.ui.devDir, err)
+		return
+	}
+	defer watcher.Close()
+	watchDirs(watcher, p.ui.devDir)
+
+	var debounce Timer
+	var fire <-chan time.Time
+	defer func() {
+		if debounce != nil {
+			debounce.Stop()
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if ev.Has(fsnotify.Create) {
+				if info, err := os.Stat(ev.Name); err == nil && info.IsDir() {
+					watchDirs(watcher, ev.Name)
+				}
+			}
+			if debounce == nil {
+				debounce = p.clock().NewTimer(devBundleDebounce)
+			} else {
+				debounce.Stop()
+				debounce.Reset(devBundleDebounce)
+			}
+			fire = debounce.C()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			p.printf(LevelWarn, "dev bundle watcher: %v", err)
+		case <-fire:
+			fire = nil
+			p.reloadDevBundle(ctx)
+		}
+	}
+}
+
+func (p *Plugin) reloadDevBundle(ctx context.Context) {
+	assets, err := readAssets(os.DirFS(p.ui.devDir), ".")
+	if err != nil {
+		p.printf(LevelError, "failed to reload dev bundle: %v", err)
+		return
+	}
+	if p.WaitConnected(ctx) != nil {
+		return
+	}
+	p.ui.setAssets(assets)
+	size := 0
+	for _, a := range assets {
+		size += len(a.Data)
+	}
+	err = p.send(&pb.PluginMessage{Payload: &pb.PluginMessage_BundleUpdate{BundleUpdate: &pb.BundleUpdate{
+		BundleData: p.ui.GetBundleData(),
+		Assets:     assets,
+	}}})
+	if err != nil {
+		p.printf(LevelError, "failed to push dev bundle: %v", err)
+		return
+	}
+	p.printf(LevelInfo, "pushed dev bundle (%d files, %d bytes)", len(assets), size)
+}
+
+func watchDirs(watcher *fsnotify.Watcher, root string) {
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() {
+			watcher.Add(path)
+		}
+		return nil
+	})
+}
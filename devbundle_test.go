@@ -0,0 +1,72 @@
+package birdactyl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+func TestSetAssetsResetsBundleData(t *testing.T) {
+	u := newUIBuilder()
+	u.setAssets([]*pb.PluginUIAsset{{Path: "index.js", Data: []byte("js")}})
+	if string(u.GetBundleData()) != "js" {
+		t.Fatalf("bundle data = %q, want js", u.GetBundleData())
+	}
+	u.setAssets([]*pb.PluginUIAsset{{Path: "style.css", Data: []byte("css")}})
+	if data := u.GetBundleData(); data != nil {
+		t.Fatalf("bundle data = %q after index.js removed, want nil", data)
+	}
+}
+
+func TestSetAssetsConcurrentWithReaders(t *testing.T) {
+	p := New("devbundle", "1.0.0")
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			p.ui.setAssets([]*pb.PluginUIAsset{{Path: "index.js", Data: []byte{byte(i)}}})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			p.ui.build()
+			p.bundleUpload()
+			p.ui.bundleSize()
+		}
+	}()
+	wg.Wait()
+}
+
+func TestWatchDevBundleStopsOnCancel(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "index.js"), []byte("v1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p := New("devbundle", "1.0.0")
+	p.ui.DevBundleDir(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		p.watchDevBundle(ctx)
+		close(done)
+	}()
+
+	if err := os.WriteFile(filepath.Join(dir, "index.js"), []byte("v2"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * devBundleDebounce)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watcher still running after its context was cancelled")
+	}
+}
@@ -0,0 +1,88 @@
+package birdactyl_test
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func slowRoute(p *birdactyl.Plugin, started chan<- struct{}, release <-chan struct{}) {
+	p.Route("GET", "/slow", func(r birdactyl.Request) birdactyl.Response {
+		started <- struct{}{}
+		<-release
+		return birdactyl.Text("done")
+	})
+}
+
+func waitState(t *testing.T, p *birdactyl.Plugin, want birdactyl.State) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for p.ConnectionState() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("state = %s, want %s", p.ConnectionState(), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDrainFinishesInFlightAndRejectsNew(t *testing.T) {
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	p := birdactyl.New("drain", "1.0.0", birdactyl.WithDrainTimeout(5*time.Second))
+	slowRoute(p, started, release)
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	inflight := make(chan birdactyltest.Response, 1)
+	go func() { inflight <- tp.DoHTTP("GET", "/slow", nil) }()
+	<-started
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- tp.Shutdown() }()
+	waitState(t, p, birdactyl.StateDraining)
+
+	if resp := tp.DoHTTP("GET", "/slow", nil); resp.Status != 503 {
+		t.Fatalf("request while draining got %d, want 503", resp.Status)
+	}
+
+	close(release)
+	if resp := <-inflight; resp.Status != 200 || string(resp.Body) != "done" {
+		t.Fatalf("in-flight request got %d %q, want 200 done", resp.Status, resp.Body)
+	}
+	if err := <-stopped; err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+}
+
+func TestDrainTimeoutLogsAbandonedRequests(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+	p := birdactyl.New("drain", "1.0.0", birdactyl.WithDrainTimeout(50*time.Millisecond))
+	slowRoute(p, started, release)
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	abandoned := make(chan birdactyltest.Response, 1)
+	go func() { abandoned <- tp.DoHTTP("GET", "/slow", nil) }()
+	<-started
+	if err := tp.Shutdown(); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if resp := <-abandoned; resp.Status != 0 {
+		t.Fatalf("abandoned request got %d, want no response", resp.Status)
+	}
+	if !strings.Contains(buf.String(), "drain timed out, abandoning requests: ") {
+		t.Fatalf("log does not name abandoned requests:\n%s", buf.String())
+	}
+}
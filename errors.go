@@ -0,0 +1,164 @@
+package birdactyl
+
+import (
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrNotFound         = errors.New("birdactyl: not found")
+	ErrPermissionDenied = errors.New("birdactyl: permission denied")
+	ErrInvalidArgument  = errors.New("birdactyl: invalid argument")
+	ErrAlreadyExists    = errors.New("birdactyl: already exists")
+	ErrServerOffline    = errors.New("birdactyl: server offline")
+	ErrInvalidPath      = errors.New("birdactyl: invalid path")
+	ErrFileTooLarge     = errors.New("birdactyl: file too large")
+	ErrMailerDisabled   = errors.New("birdactyl: mailer not configured")
+	ErrValueTooLarge    = errors.New("birdactyl: value too large")
+	ErrBackupFailed     = errors.New("birdactyl: backup failed")
+	ErrTooManyPending   = errors.New("birdactyl: too many pending requests")
+	ErrUnavailable      = errors.New("birdactyl: panel unavailable")
+	ErrTimeout          = errors.New("birdactyl: timeout")
+	ErrTooManyItems     = errors.New("birdactyl: too many items")
+	ErrQueueFull        = errors.New("birdactyl: queue full")
+	ErrCircuitOpen      = errors.New("birdactyl: circuit open")
+	ErrBodyTooLarge     = errors.New("birdactyl: request body too large")
+	ErrRateLimited      = errors.New("birdactyl: rate limited")
+	ErrUnsupported      = errors.New("birdactyl: not supported by panel")
+	ErrNoFreeAllocation = errors.New("birdactyl: no free allocation")
+	ErrDatabaseExists   = errors.New("birdactyl: database already exists")
+
+	errShutdown    = errors.New("birdactyl: shutdown requested")
+	errStaleStream = errors.New("birdactyl: reply for a previous stream")
+)
+
+const serverOfflineReason = "SERVER_OFFLINE"
+
+type PanelError struct {
+	Code    codes.Code
+	Message string
+	kind    error
+	status  *status.Status
+}
+
+func (e *PanelError) Error() string {
+	if e.kind != nil {
+		return e.kind.Error() + ": " + e.Message
+	}
+	return "birdactyl: " + e.Message
+}
+
+func (e *PanelError) Unwrap() error {
+	return e.kind
+}
+
+func (e *PanelError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *PanelError) withKind(kind error) *PanelError {
+	c := *e
+	c.kind = kind
+	return &c
+}
+
+func panelErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	if pe, ok := err.(*PanelError); ok {
+		return pe
+	}
+	if pe, ok := err.(*PermissionError); ok {
+		return pe
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	pe := &PanelError{Code: st.Code(), Message: st.Message(), status: st}
+	switch st.Code() {
+	case codes.NotFound:
+		pe.kind = ErrNotFound
+	case codes.PermissionDenied, codes.Unauthenticated:
+		pe.kind = ErrPermissionDenied
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		pe.kind = ErrInvalidArgument
+	case codes.AlreadyExists:
+		pe.kind = ErrAlreadyExists
+	case codes.Unavailable:
+		pe.kind = ErrUnavailable
+	case codes.DeadlineExceeded:
+		pe.kind = ErrTimeout
+	}
+	return pe
+}
+
+func fileErr(err error) error {
+	err = serverErr(err)
+	if pe, ok := err.(*PanelError); ok && pe.Code == codes.ResourceExhausted {
+		return pe.withKind(ErrFileTooLarge)
+	}
+	return err
+}
+
+func serverErr(err error) error {
+	err = panelErr(err)
+	if pe, ok := err.(*PanelError); ok && serverOffline(pe.status) {
+		return pe.withKind(ErrServerOffline)
+	}
+	return err
+}
+
+func serverOffline(st *status.Status) bool {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason == serverOfflineReason {
+			return true
+		}
+	}
+	return false
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type SpecError struct {
+	Violations []FieldViolation
+}
+
+func (e *SpecError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return ErrInvalidArgument.Error() + ": " + strings.Join(parts, "; ")
+}
+
+func (e *SpecError) Unwrap() error {
+	return ErrInvalidArgument
+}
+
+func specErr(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return panelErr(err)
+	}
+	se := &SpecError{}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, fv := range br.FieldViolations {
+				se.Violations = append(se.Violations, FieldViolation{Field: fv.Field, Description: fv.Description})
+			}
+		}
+	}
+	if len(se.Violations) == 0 {
+		return panelErr(err)
+	}
+	return se
+}
@@ -0,0 +1,46 @@
+package birdactyl
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerErr(t *testing.T) {
+	offline, _ := status.New(codes.Unavailable, "server is offline").WithDetails(&errdetails.ErrorInfo{Reason: serverOfflineReason})
+	tests := []struct {
+		name string
+		err  error
+		want error
+		not  error
+	}{
+		{"panel unreachable", status.Error(codes.Unavailable, "connection refused"), ErrUnavailable, ErrServerOffline},
+		{"server offline", offline.Err(), ErrServerOffline, nil},
+		{"precondition", status.Error(codes.FailedPrecondition, "bad state"), ErrInvalidArgument, ErrServerOffline},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := serverErr(tt.err)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("serverErr() = %v, want %v", err, tt.want)
+			}
+			if tt.not != nil && errors.Is(err, tt.not) {
+				t.Errorf("serverErr() = %v, should not match %v", err, tt.not)
+			}
+		})
+	}
+}
+
+func TestServerErrDoesNotMutateShared(t *testing.T) {
+	st, _ := status.New(codes.Unavailable, "server is offline").WithDetails(&errdetails.ErrorInfo{Reason: serverOfflineReason})
+	shared := panelErr(st.Err())
+	if err := serverErr(shared); !errors.Is(err, ErrServerOffline) {
+		t.Fatalf("serverErr() = %v, want ErrServerOffline", err)
+	}
+	if !errors.Is(shared, ErrUnavailable) || errors.Is(shared, ErrServerOffline) {
+		t.Fatalf("shared error was mutated: %v", shared)
+	}
+}
@@ -0,0 +1,122 @@
+package birdactyl
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const DefaultSlowEventThreshold = 500 * time.Millisecond
+
+type lateHandlersKey struct{}
+
+type eventOutcome struct {
+	result   EventResult
+	panicked bool
+	value    interface{}
+}
+
+type EventOptions struct {
+	Timeout   time.Duration
+	OnTimeout func(Event) EventResult
+}
+
+func WithEventTimeout(d time.Duration, onTimeout EventResult) Option {
+	return func(p *Plugin) {
+		p.eventTimeout = d
+		p.eventOnTimeout = onTimeout
+	}
+}
+
+func WithSlowEventThreshold(d time.Duration) Option {
+	return func(p *Plugin) {
+		p.slowEvent = d
+	}
+}
+
+func (p *Plugin) OnEventWithOptions(eventType string, opts EventOptions, handler EventHandler) *Plugin {
+	p.OnEvent(eventType, handler)
+	p.regMu.Lock()
+	if p.eventOpts == nil {
+		p.eventOpts = make(map[string]EventOptions)
+	}
+	p.eventOpts[eventType] = opts
+	p.regMu.Unlock()
+	return p
+}
+
+func (p *Plugin) runEvent(handler EventHandler, e Event) EventResult {
+	if !e.Sync {
+		return handler(e)
+	}
+	p.regMu.RLock()
+	opts := p.eventOpts[e.Type]
+	p.regMu.RUnlock()
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = p.eventTimeout
+	}
+
+	clock := p.clock()
+	start := clock.Now()
+	if timeout <= 0 {
+		result := handler(e)
+		p.warnSlowEvent(e, clock.Now().Sub(start))
+		return result
+	}
+
+	ctx, cancel := context.WithCancel(e.Context())
+	defer cancel()
+	e.ctx = ctx
+	done := make(chan eventOutcome, 1)
+	go func() {
+		out := eventOutcome{panicked: true}
+		defer func() {
+			if out.panicked {
+				out.value = recover()
+			}
+			done <- out
+		}()
+		out.result = handler(e)
+		out.panicked = false
+	}()
+
+	timer := clock.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case out := <-done:
+		if out.panicked {
+			panic(out.value)
+		}
+		p.warnSlowEvent(e, clock.Now().Sub(start))
+		return out.result
+	case <-timer.C():
+	}
+
+	p.printf(LevelWarn, "event %s handler exceeded its %s timeout; responding with the timeout default", e.Type, timeout)
+	late, _ := ctx.Value(lateHandlersKey{}).(*sync.WaitGroup)
+	if late != nil {
+		late.Add(1)
+	}
+	go func() {
+		if late != nil {
+			defer late.Done()
+		}
+		out := <-done
+		if out.panicked {
+			p.reportPanic(out.value, "event:"+e.Type, e.RequestID)
+			return
+		}
+		p.printf(LevelWarn, "event %s handler finished after %s (timeout %s)", e.Type, clock.Now().Sub(start), timeout)
+	}()
+	if opts.OnTimeout != nil {
+		return opts.OnTimeout(e)
+	}
+	return p.eventOnTimeout
+}
+
+func (p *Plugin) warnSlowEvent(e Event, elapsed time.Duration) {
+	if p.slowEvent > 0 && elapsed > p.slowEvent {
+		p.printf(LevelWarn, "event %s handler took %s (slow threshold %s)", e.Type, elapsed, p.slowEvent)
+	}
+}
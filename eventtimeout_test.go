@@ -0,0 +1,60 @@
+package birdactyl_test
+
+import (
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestEventTimeoutPanicCountsAsPanic(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	p := birdactyl.New("events", "1.0.0", birdactyl.WithMetrics(), birdactyl.WithEventTimeout(time.Minute, birdactyl.Block("timed out")))
+	p.OnEvent("boom", func(birdactyl.Event) birdactyl.EventResult {
+		panic("boom")
+	})
+	tp.StartPlugin(p)
+
+	if result := tp.SendEvent("boom", nil, true); !result.Allowed() {
+		t.Fatalf("result = %+v, want allow after a panic", result)
+	}
+	var panics uint64
+	for _, h := range p.Metrics().Handlers {
+		if h.Kind == birdactyl.KindEvent && h.Name == "boom" {
+			panics = h.Panics
+		}
+	}
+	if panics != 1 {
+		t.Fatalf("recorded panics = %d, want 1", panics)
+	}
+}
+
+func TestTimedOutEventHoldsWorker(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	p := birdactyl.New("events", "1.0.0", birdactyl.WithWorkers(1), birdactyl.WithEventTimeout(20*time.Millisecond, birdactyl.Allow()))
+	release := make(chan struct{})
+	p.OnEvent("slow", func(birdactyl.Event) birdactyl.EventResult {
+		<-release
+		return birdactyl.Allow()
+	})
+	ran := make(chan struct{})
+	p.OnEvent("fast", func(birdactyl.Event) birdactyl.EventResult {
+		close(ran)
+		return birdactyl.Allow()
+	})
+	tp.StartPlugin(p)
+
+	tp.SendEvent("slow", nil, true)
+	fast := make(chan birdactyl.EventResult, 1)
+	go func() { fast <- tp.SendEvent("fast", nil, true) }()
+	select {
+	case <-ran:
+		t.Error("next event ran while the timed-out handler still held the only worker")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	if result := <-fast; !result.Allowed() {
+		t.Fatalf("fast result = %+v, want allow", result)
+	}
+}
@@ -0,0 +1,106 @@
+package birdactyl
+
+import (
+	"fmt"
+	"sync"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type Flags struct {
+	mu        sync.RWMutex
+	decls     []*pb.FlagDeclaration
+	values    map[string]bool
+	listeners map[string][]func(bool)
+	post      func(func())
+}
+
+type FlagSnapshot map[string]bool
+
+func (f FlagSnapshot) Bool(name string) bool {
+	return f[name]
+}
+
+func (p *Plugin) DeclareFlag(name, description string, defaultValue bool) *Plugin {
+	f := p.Flags()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, d := range f.decls {
+		if d.Name == name {
+			p.regMu.Lock()
+			p.regErrs = append(p.regErrs, fmt.Errorf("flag %q declared twice", name))
+			p.regMu.Unlock()
+			return p
+		}
+	}
+	f.decls = append(f.decls, &pb.FlagDeclaration{Name: name, Description: description, DefaultValue: defaultValue})
+	f.values[name] = defaultValue
+	return p
+}
+
+func (p *Plugin) Flags() *Flags {
+	return p.flags
+}
+
+func newFlags(post func(func())) *Flags {
+	return &Flags{
+		values:    make(map[string]bool),
+		listeners: make(map[string][]func(bool)),
+		post:      post,
+	}
+}
+
+func (f *Flags) Bool(name string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.values[name]
+}
+
+func (f *Flags) OnChange(name string, fn func(enabled bool)) *Flags {
+	f.mu.Lock()
+	f.listeners[name] = append(f.listeners[name], fn)
+	f.mu.Unlock()
+	return f
+}
+
+func (f *Flags) Snapshot() FlagSnapshot {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if len(f.values) == 0 {
+		return nil
+	}
+	out := make(FlagSnapshot, len(f.values))
+	for k, v := range f.values {
+		out[k] = v
+	}
+	return out
+}
+
+func (f *Flags) declarations() []*pb.FlagDeclaration {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return append([]*pb.FlagDeclaration(nil), f.decls...)
+}
+
+func (f *Flags) apply(values *pb.FlagValues) {
+	if values == nil {
+		return
+	}
+	f.mu.Lock()
+	var fire []func()
+	for name, v := range values.Values {
+		old, declared := f.values[name]
+		if !declared || old == v {
+			continue
+		}
+		f.values[name] = v
+		for _, fn := range f.listeners[name] {
+			fn, v := fn, v
+			fire = append(fire, func() { fn(v) })
+		}
+	}
+	f.mu.Unlock()
+	for _, fn := range fire {
+		f.post(fn)
+	}
+}
@@ -0,0 +1,78 @@
+package birdactyl
+
+import (
+	"fmt"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func WithMaxRecvMsgSize(bytes int) Option {
+	return func(p *Plugin) {
+		p.maxRecvMsg = bytes
+	}
+}
+
+func WithMaxSendMsgSize(bytes int) Option {
+	return func(p *Plugin) {
+		p.maxSendMsg = bytes
+	}
+}
+
+func WithGRPCCompression(name string) Option {
+	return func(p *Plugin) {
+		p.compression = name
+	}
+}
+
+func (p *Plugin) callOptions() []grpc.CallOption {
+	var opts []grpc.CallOption
+	if p.maxRecvMsg > 0 {
+		opts = append(opts, grpc.MaxCallRecvMsgSize(p.maxRecvMsg))
+	}
+	if p.maxSendMsg > 0 {
+		opts = append(opts, grpc.MaxCallSendMsgSize(p.maxSendMsg))
+	}
+	if p.compression != "" {
+		opts = append(opts, grpc.UseCompressor(p.compression))
+	}
+	return opts
+}
+
+func (p *Plugin) validateGRPC() []error {
+	var errs []error
+	if p.compression != "" && encoding.GetCompressor(p.compression) == nil {
+		errs = append(errs, fmt.Errorf("grpc compression %q is not registered", p.compression))
+	}
+	if p.maxRecvMsg < 0 || p.maxSendMsg < 0 {
+		errs = append(errs, fmt.Errorf("grpc message size limits must not be negative"))
+	}
+	return errs
+}
+
+func (p *Plugin) logSendErr(msg *pb.PluginMessage, err error) {
+	if status.Code(err) != codes.ResourceExhausted {
+		return
+	}
+	limit := "default"
+	if p.maxSendMsg > 0 {
+		limit = fmt.Sprintf("%d bytes", p.maxSendMsg)
+	}
+	p.printf(LevelError, "sending %s failed: message is %d bytes, send limit %s (see WithMaxSendMsgSize): %v", payloadName(msg), proto.Size(msg), limit, err)
+}
+
+func (p *Plugin) logRecvErr(err error) {
+	if status.Code(err) != codes.ResourceExhausted {
+		return
+	}
+	limit := "default"
+	if p.maxRecvMsg > 0 {
+		limit = fmt.Sprintf("%d bytes", p.maxRecvMsg)
+	}
+	p.printf(LevelError, "receiving from panel failed: message exceeds recv limit %s (see WithMaxRecvMsgSize): %v", limit, err)
+}
@@ -0,0 +1,151 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const DefaultHealthInterval = 30 * time.Second
+
+type HealthState int
+
+const (
+	HealthHealthy HealthState = iota
+	HealthDegraded
+	HealthUnhealthy
+)
+
+func (s HealthState) String() string {
+	switch s {
+	case HealthHealthy:
+		return "healthy"
+	case HealthDegraded:
+		return "degraded"
+	case HealthUnhealthy:
+		return "unhealthy"
+	}
+	return "unknown"
+}
+
+type HealthStatus struct {
+	State   HealthState
+	Message string
+}
+
+func Healthy() HealthStatus {
+	return HealthStatus{State: HealthHealthy}
+}
+
+func Degraded(message string) HealthStatus {
+	return HealthStatus{State: HealthDegraded, Message: message}
+}
+
+func Unhealthy(message string) HealthStatus {
+	return HealthStatus{State: HealthUnhealthy, Message: message}
+}
+
+type healthReporter struct {
+	mu       sync.Mutex
+	check    func() HealthStatus
+	interval time.Duration
+	current  HealthStatus
+	startErr error
+}
+
+func WithHealthInterval(d time.Duration) Option {
+	return func(p *Plugin) {
+		p.health.interval = d
+	}
+}
+
+func (p *Plugin) SetHealthCheck(fn func() HealthStatus) *Plugin {
+	p.health.mu.Lock()
+	p.health.check = fn
+	p.health.mu.Unlock()
+	return p
+}
+
+func (p *Plugin) Health() HealthStatus {
+	p.health.mu.Lock()
+	defer p.health.mu.Unlock()
+	if p.health.startErr != nil {
+		return Unhealthy("startup failed: " + p.health.startErr.Error())
+	}
+	return p.health.current
+}
+
+func (p *Plugin) ReportHealth(status HealthStatus) {
+	p.health.mu.Lock()
+	p.health.current = status
+	p.health.mu.Unlock()
+	p.sendHealth(status, "")
+}
+
+func (p *Plugin) ReportUnhealthy(reason string) {
+	p.ReportHealth(Unhealthy(reason))
+}
+
+func (p *Plugin) checkHealth() HealthStatus {
+	p.health.mu.Lock()
+	check, failed := p.health.check, p.health.startErr != nil
+	p.health.mu.Unlock()
+	if check == nil || failed {
+		return p.Health()
+	}
+	status := func() (status HealthStatus) {
+		defer func() {
+			if r := recover(); r != nil {
+				status = Unhealthy(fmt.Sprintf("health check panicked: %v", r))
+			}
+		}()
+		return check()
+	}()
+	p.health.mu.Lock()
+	p.health.current = status
+	p.health.mu.Unlock()
+	return status
+}
+
+func (p *Plugin) healthLoop(ctx context.Context) {
+	p.health.mu.Lock()
+	interval, enabled := p.health.interval, p.health.check != nil
+	p.health.mu.Unlock()
+	if !enabled {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultHealthInterval
+	}
+	p.sendHealth(p.checkHealth(), "")
+	ticker := p.clock().NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			p.sendHealth(p.checkHealth(), "")
+		}
+	}
+}
+
+func (p *Plugin) handleHealthProbe(requestID string) {
+	p.sendHealth(p.checkHealth(), requestID)
+}
+
+func (p *Plugin) sendHealth(status HealthStatus, requestID string) {
+	report := &pb.HealthReport{
+		State:         pb.HealthReport_State(status.State),
+		Message:       status.Message,
+		CheckedUnixMs: p.clock().Now().UnixMilli(),
+	}
+	if err := p.StartError(); err != nil {
+		report.State = pb.HealthReport_UNHEALTHY
+		report.InitError = err.Error()
+	}
+	p.send(&pb.PluginMessage{RequestId: requestID, Payload: &pb.PluginMessage_Health{Health: report}})
+}
@@ -0,0 +1,158 @@
+package birdactyl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	httpClientTimeout = 30 * time.Second
+	httpRetries       = 3
+	httpBaseBackoff   = 500 * time.Millisecond
+	httpErrorSnippet  = 512
+)
+
+type HTTPError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("birdactyl: %s returned %d", e.URL, e.StatusCode)
+	}
+	return fmt.Sprintf("birdactyl: %s returned %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+func (e *HTTPError) Temporary() bool {
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+}
+
+type userAgentTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func (t userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("User-Agent") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	return t.base.RoundTrip(req)
+}
+
+func (p *Plugin) HTTPClient() *http.Client {
+	p.httpClientOnce.Do(func() {
+		transport := &http.Transport{
+			Proxy:                 p.proxy,
+			DialContext:           (&net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   16,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		}
+		p.httpClient = &http.Client{
+			Transport: userAgentTransport{base: transport, userAgent: "birdactyl-plugin/" + p.id + "/" + p.version},
+			Timeout:   httpClientTimeout,
+		}
+	})
+	return p.httpClient
+}
+
+func (p *Plugin) setEgress(policy *pb.EgressPolicy) {
+	if policy == nil || policy.ProxyUrl == "" {
+		p.egress.Store(nil)
+		return
+	}
+	p.egress.Store(policy)
+}
+
+func (p *Plugin) proxy(req *http.Request) (*url.URL, error) {
+	policy := p.egress.Load()
+	if policy == nil {
+		return http.ProxyFromEnvironment(req)
+	}
+	host := req.URL.Hostname()
+	for _, skip := range policy.NoProxy {
+		skip = strings.TrimPrefix(skip, ".")
+		if host == skip || strings.HasSuffix(host, "."+skip) {
+			return nil, nil
+		}
+	}
+	return url.Parse(policy.ProxyUrl)
+}
+
+func (p *Plugin) GetJSON(ctx context.Context, endpoint string, out interface{}) error {
+	return p.doJSON(ctx, http.MethodGet, endpoint, nil, out)
+}
+
+func (p *Plugin) PostJSON(ctx context.Context, endpoint string, in, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return p.doJSON(ctx, http.MethodPost, endpoint, body, out)
+}
+
+func (p *Plugin) doJSON(ctx context.Context, method, endpoint string, body []byte, out interface{}) error {
+	var err error
+	for attempt := 0; attempt <= httpRetries; attempt++ {
+		if attempt > 0 {
+			t := p.clock().NewTimer(httpBaseBackoff << (attempt - 1))
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return err
+			case <-t.C():
+			}
+		}
+		var retry bool
+		retry, err = p.tryJSON(ctx, method, endpoint, body, out)
+		if err == nil || !retry || ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (p *Plugin) tryJSON(ctx context.Context, method, endpoint string, body []byte, out interface{}) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.HTTPClient().Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, httpErrorSnippet))
+		io.Copy(io.Discard, resp.Body)
+		herr := &HTTPError{URL: endpoint, StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(snippet))}
+		return herr.Temporary(), herr
+	}
+	if out == nil {
+		io.Copy(io.Discard, resp.Body)
+		return false, nil
+	}
+	return false, json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,53 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	instanceEnv  = "BIRDACTYL_INSTANCE"
+	capInstances = "plugin.instances"
+)
+
+var instancePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+func (p *Plugin) SetInstanceID(id string) *Plugin {
+	p.instance = id
+	return p
+}
+
+func (p *Plugin) InstanceID() string {
+	return p.instance
+}
+
+func (p *Plugin) label() string {
+	if p.instance == "" {
+		return p.id
+	}
+	return p.id + "/" + p.instance
+}
+
+func (p *Plugin) dataDirName() string {
+	if p.instance == "" {
+		return p.id + "_data"
+	}
+	return p.id + "_" + p.instance + "_data"
+}
+
+func (p *Plugin) validateInstance() error {
+	if p.instance != "" && !instancePattern.MatchString(p.instance) {
+		return fmt.Errorf("instance id %q must be letters, digits, '.', '-' or '_'", p.instance)
+	}
+	return nil
+}
+
+func pluginContext(ctx context.Context, pluginID, instance string) context.Context {
+	if instance == "" {
+		return metadata.AppendToOutgoingContext(ctx, "x-plugin-id", pluginID)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "x-plugin-id", pluginID, "x-plugin-instance", instance)
+}
@@ -0,0 +1,111 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	DefaultPageSize  = 50
+	MaxIteratorItems = 10000
+)
+
+type Iterator[T any] struct {
+	ctx      context.Context
+	fetch    func(ctx context.Context, offset, limit int) ([]T, int, error)
+	pageSize int
+	max      int
+	offset   int
+	page     []T
+	idx      int
+	item     T
+	err      error
+	done     bool
+}
+
+func newIterator[T any](ctx context.Context, offset, pageSize int, fetch func(context.Context, int, int) ([]T, int, error)) *Iterator[T] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return &Iterator[T]{ctx: ctx, fetch: fetch, pageSize: pageSize, max: MaxIteratorItems, offset: offset}
+}
+
+func (it *Iterator[T]) PageSize(n int) *Iterator[T] {
+	if n > 0 {
+		it.pageSize = n
+	}
+	return it
+}
+
+func (it *Iterator[T]) Max(n int) *Iterator[T] {
+	it.max = n
+	return it
+}
+
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.idx >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		page, total, err := it.fetch(it.ctx, it.offset, it.pageSize)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.idx = page, 0
+		it.offset += len(page)
+		if len(page) == 0 {
+			it.done = true
+			return false
+		}
+		if total > 0 && it.offset >= total {
+			it.done = true
+		}
+	}
+	it.item = it.page[it.idx]
+	it.idx++
+	return true
+}
+
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+func (it *Iterator[T]) All() ([]T, error) {
+	var out []T
+	for it.Next() {
+		if it.max > 0 && len(out) >= it.max {
+			return out, fmt.Errorf("%w: more than %d items", ErrTooManyItems, it.max)
+		}
+		out = append(out, it.Item())
+	}
+	return out, it.Err()
+}
+
+func (a *API) IterServers(ctx context.Context, filter ServerFilter) *Iterator[Server] {
+	return newIterator(ctx, filter.Offset, filter.Limit, func(ctx context.Context, offset, limit int) ([]Server, int, error) {
+		f := filter
+		f.Offset, f.Limit = offset, limit
+		return a.listServers(ctx, f)
+	})
+}
+
+func (a *API) IterUsers(ctx context.Context, filter UserFilter) *Iterator[User] {
+	return newIterator(ctx, filter.Offset, filter.Limit, func(ctx context.Context, offset, limit int) ([]User, int, error) {
+		f := filter
+		f.Offset, f.Limit = offset, limit
+		page, err := a.Users(ctx, f)
+		return page.Users, page.Total, err
+	})
+}
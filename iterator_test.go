@@ -0,0 +1,53 @@
+package birdactyl
+
+import (
+	"context"
+	"testing"
+)
+
+func cappedSource(n, maxPage int, reportTotal bool, calls *int) func(context.Context, int, int) ([]int, int, error) {
+	return func(ctx context.Context, offset, limit int) ([]int, int, error) {
+		*calls++
+		limit = min(limit, maxPage)
+		var page []int
+		for i := offset; i < n && len(page) < limit; i++ {
+			page = append(page, i)
+		}
+		if !reportTotal {
+			return page, 0, nil
+		}
+		return page, n, nil
+	}
+}
+
+func TestIteratorContinuesPastShortPages(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     bool
+		wantCalls int
+	}{
+		{"with total", true, 4},
+		{"without total", false, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			it := newIterator(context.Background(), 0, 5, cappedSource(10, 3, tt.total, &calls))
+			items, err := it.All()
+			if err != nil {
+				t.Fatalf("All: %v", err)
+			}
+			if len(items) != 10 {
+				t.Fatalf("got %d items, want 10", len(items))
+			}
+			for i, v := range items {
+				if v != i {
+					t.Fatalf("item %d = %d", i, v)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("fetched %d pages, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+package birdactyl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultJobWorkers     = 2
+	DefaultJobMaxAttempts = 5
+	DefaultJobBackoff     = 5 * time.Second
+	jobMaxBackoff         = 10 * time.Minute
+	jobFinishedRetention  = 100
+	jobsFile              = "jobs.json"
+)
+
+type JobID string
+
+type JobState string
+
+const (
+	JobPending   JobState = "pending"
+	JobRunning   JobState = "running"
+	JobSucceeded JobState = "succeeded"
+	JobFailed    JobState = "failed"
+)
+
+type JobStatus struct {
+	ID          JobID     `json:"id"`
+	Type        string    `json:"type"`
+	State       JobState  `json:"state"`
+	Attempts    int       `json:"attempts"`
+	MaxAttempts int       `json:"max_attempts"`
+	Progress    float64   `json:"progress"`
+	Message     string    `json:"message,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+	NextRun     time.Time `json:"next_run"`
+}
+
+type JobHandler func(JobCtx) error
+
+type JobOption func(*jobRecord)
+
+func JobMaxAttempts(n int) JobOption {
+	return func(r *jobRecord) { r.MaxAttempts = n }
+}
+
+func JobDelay(d time.Duration) JobOption {
+	return func(r *jobRecord) { r.NextRun = r.NextRun.Add(d) }
+}
+
+func JobBackoff(d time.Duration) JobOption {
+	return func(r *jobRecord) { r.Backoff = d }
+}
+
+func WithJobWorkers(n int) Option {
+	return func(p *Plugin) {
+		p.Jobs().workers = n
+	}
+}
+
+type JobCtx struct {
+	context.Context
+	ID      JobID
+	Type    string
+	Attempt int
+	payload json.RawMessage
+	jobs    *Jobs
+}
+
+func (c JobCtx) Payload() []byte {
+	return c.payload
+}
+
+func (c JobCtx) Bind(v interface{}) error {
+	return json.Unmarshal(c.payload, v)
+}
+
+func (c JobCtx) Progress(fraction float64, message string) {
+	c.jobs.update(c.ID, func(r *jobRecord) {
+		r.Progress = fraction
+		r.Message = message
+	})
+}
+
+type jobRecord struct {
+	JobStatus
+	Payload json.RawMessage `json:"payload"`
+	Backoff time.Duration   `json:"backoff"`
+}
+
+type Jobs struct {
+	plugin   *Plugin
+	mu       sync.Mutex
+	handlers map[string]JobHandler
+	records  map[JobID]*jobRecord
+	changed  chan struct{}
+	workers  int
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	loaded   bool
+	started  bool
+	stopping bool
+}
+
+func (p *Plugin) Jobs() *Jobs {
+	p.jobsOnce.Do(func() {
+		p.jobs = &Jobs{
+			plugin:   p,
+			handlers: make(map[string]JobHandler),
+			records:  make(map[JobID]*jobRecord),
+			changed:  make(chan struct{}),
+			workers:  DefaultJobWorkers,
+		}
+	})
+	return p.jobs
+}
+
+func (p *Plugin) JobHandler(jobType string, handler JobHandler) *Plugin {
+	j := p.Jobs()
+	j.mu.Lock()
+	j.handlers[jobType] = handler
+	j.mu.Unlock()
+	return p
+}
+
+func (j *Jobs) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...JobOption) (JobID, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if j.plugin.ConnectionState() == StateConnected {
+		j.start()
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	var id [8]byte
+	rand.Read(id[:])
+	now := j.plugin.clock().Now()
+	r := &jobRecord{
+		JobStatus: JobStatus{
+			ID:          JobID(hex.EncodeToString(id[:])),
+			Type:        jobType,
+			State:       JobPending,
+			MaxAttempts: DefaultJobMaxAttempts,
+			Created:     now,
+			Updated:     now,
+			NextRun:     now,
+		},
+		Payload: data,
+		Backoff: DefaultJobBackoff,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	j.mu.Lock()
+	if _, ok := j.handlers[jobType]; !ok {
+		j.mu.Unlock()
+		return "", fmt.Errorf("%w: no handler for job type %q", ErrNotFound, jobType)
+	}
+	j.loadLocked()
+	j.records[r.ID] = r
+	err = j.persistLocked()
+	j.notifyLocked()
+	j.mu.Unlock()
+	return r.ID, err
+}
+
+func (j *Jobs) Status(id JobID) (JobStatus, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.loadLocked()
+	r, ok := j.records[id]
+	if !ok {
+		return JobStatus{}, false
+	}
+	return r.JobStatus, true
+}
+
+func (j *Jobs) List() []JobStatus {
+	j.mu.Lock()
+	j.loadLocked()
+	out := make([]JobStatus, 0, len(j.records))
+	for _, r := range j.records {
+		out = append(out, r.JobStatus)
+	}
+	j.mu.Unlock()
+	sort.Slice(out, func(a, b int) bool { return out[a].Created.Before(out[b].Created) })
+	return out
+}
+
+func (j *Jobs) start() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.started {
+		return
+	}
+	j.started = true
+	j.loadLocked()
+	j.ctx, j.cancel = context.WithCancel(context.Background())
+	for i := 0; i < max(j.workers, 1); i++ {
+		j.wg.Add(1)
+		go j.worker()
+	}
+}
+
+func (j *Jobs) stop(timeout time.Duration) {
+	j.mu.Lock()
+	if !j.started {
+		j.mu.Unlock()
+		return
+	}
+	j.stopping = true
+	j.notifyLocked()
+	cancel := j.cancel
+	j.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		j.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		cancel()
+		return
+	case <-j.plugin.clock().NewTimer(timeout).C():
+	}
+	cancel()
+	<-done
+}
+
+func (j *Jobs) loadLocked() {
+	if j.loaded {
+		return
+	}
+	j.loaded = true
+	j.load()
+}
+
+func (j *Jobs) load() {
+	data, err := j.plugin.LoadData(jobsFile)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			j.plugin.printf(LevelWarn, "failed to load jobs: %v", err)
+		}
+		return
+	}
+	var records []*jobRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		j.plugin.printf(LevelWarn, "failed to decode jobs: %v", err)
+		return
+	}
+	for _, r := range records {
+		if r.State == JobRunning {
+			r.State = JobPending
+		}
+		j.records[r.ID] = r
+	}
+}
+
+func (j *Jobs) persistLocked() error {
+	var finished []*jobRecord
+	records := make([]*jobRecord, 0, len(j.records))
+	for _, r := range j.records {
+		if r.State == JobSucceeded || r.State == JobFailed {
+			finished = append(finished, r)
+			continue
+		}
+		records = append(records, r)
+	}
+	sort.Slice(finished, func(a, b int) bool { return finished[a].Updated.After(finished[b].Updated) })
+	for i, r := range finished {
+		if i >= jobFinishedRetention {
+			delete(j.records, r.ID)
+			continue
+		}
+		records = append(records, r)
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return j.plugin.SaveData(jobsFile, data)
+}
+
+func (j *Jobs) notifyLocked() {
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+func (j *Jobs) notify() {
+	j.mu.Lock()
+	j.notifyLocked()
+	j.mu.Unlock()
+}
+
+func (j *Jobs) update(id JobID, fn func(*jobRecord)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if r, ok := j.records[id]; ok {
+		fn(r)
+		r.Updated = j.plugin.clock().Now()
+		if err := j.persistLocked(); err != nil {
+			j.plugin.printf(LevelWarn, "failed to persist jobs: %v", err)
+		}
+	}
+}
+
+func (j *Jobs) next(now time.Time) (*jobRecord, time.Duration) {
+	var due *jobRecord
+	wait := time.Duration(-1)
+	for _, r := range j.records {
+		if r.State != JobPending {
+			continue
+		}
+		if _, ok := j.handlers[r.Type]; !ok {
+			continue
+		}
+		if d := r.NextRun.Sub(now); d > 0 {
+			if wait < 0 || d < wait {
+				wait = d
+			}
+			continue
+		}
+		if due == nil || r.NextRun.Before(due.NextRun) {
+			due = r
+		}
+	}
+	return due, wait
+}
+
+func (j *Jobs) worker() {
+	defer j.wg.Done()
+	clock := j.plugin.clock()
+	for {
+		j.mu.Lock()
+		if j.stopping {
+			j.mu.Unlock()
+			return
+		}
+		r, wait := j.next(clock.Now())
+		changed := j.changed
+		var handler JobHandler
+		if r != nil {
+			r.State = JobRunning
+			r.Attempts++
+			r.Updated = clock.Now()
+			j.persistLocked()
+			handler = j.handlers[r.Type]
+		}
+		j.mu.Unlock()
+
+		if r != nil {
+			j.run(r, handler)
+			continue
+		}
+		var timer Timer
+		var timeout <-chan time.Time
+		if wait >= 0 {
+			timer = clock.NewTimer(wait)
+			timeout = timer.C()
+		}
+		select {
+		case <-changed:
+		case <-timeout:
+		case <-j.ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+func (j *Jobs) run(r *jobRecord, handler JobHandler) {
+	j.mu.Lock()
+	jc := JobCtx{Context: j.ctx, ID: r.ID, Type: r.Type, Attempt: r.Attempts, payload: r.Payload, jobs: j}
+	j.mu.Unlock()
+
+	err := j.invoke(handler, jc)
+	interrupted := err != nil && j.ctx.Err() != nil
+
+	j.update(r.ID, func(r *jobRecord) {
+		switch {
+		case err == nil:
+			r.State = JobSucceeded
+			r.Progress = 1
+			r.Error = ""
+		case interrupted:
+			r.State = JobPending
+			r.Attempts--
+		case r.Attempts >= r.MaxAttempts:
+			r.State = JobFailed
+			r.Error = err.Error()
+			j.plugin.printf(LevelWarn, "job %s (%s) failed after %d attempts: %v", r.ID, r.Type, r.Attempts, err)
+		default:
+			r.State = JobPending
+			r.Error = err.Error()
+			r.NextRun = j.plugin.clock().Now().Add(jobBackoff(r.Backoff, r.Attempts))
+		}
+	})
+	j.notify()
+}
+
+func jobBackoff(base time.Duration, attempts int) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < jobMaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, jobMaxBackoff)
+}
+
+func (j *Jobs) invoke(handler JobHandler, jc JobCtx) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			j.plugin.reportPanic(rec, "job:"+jc.Type, string(jc.ID))
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+	return handler(jc)
+}
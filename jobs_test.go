@@ -0,0 +1,61 @@
+package birdactyl
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEnqueueKeepsPersistedJobs(t *testing.T) {
+	p := New("jobs", "1.0.0")
+	p.dataDir = t.TempDir()
+	old := []*jobRecord{{JobStatus: JobStatus{ID: "old", Type: "mail", State: JobRunning, MaxAttempts: 1}}}
+	data, _ := json.Marshal(old)
+	if err := p.SaveData(jobsFile, data); err != nil {
+		t.Fatal(err)
+	}
+	p.JobHandler("mail", func(JobCtx) error { return nil })
+
+	id, err := p.Jobs().Enqueue(context.Background(), "mail", "hi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st, ok := p.Jobs().Status("old"); !ok || st.State != JobPending {
+		t.Fatalf("old job = %+v, %v; want pending", st, ok)
+	}
+
+	data, err = p.LoadData(jobsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved []*jobRecord
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	ids := map[JobID]bool{}
+	for _, r := range saved {
+		ids[r.ID] = true
+	}
+	if len(saved) != 2 || !ids["old"] || !ids[id] {
+		t.Fatalf("persisted jobs = %v, want old and %s", ids, id)
+	}
+}
+
+func TestJobBackoffCapped(t *testing.T) {
+	cases := []struct {
+		base     time.Duration
+		attempts int
+		want     time.Duration
+	}{
+		{time.Second, 1, time.Second},
+		{time.Second, 3, 4 * time.Second},
+		{DefaultJobBackoff, 100, jobMaxBackoff},
+		{24 * time.Hour, 70, jobMaxBackoff},
+	}
+	for _, c := range cases {
+		if got := jobBackoff(c.base, c.attempts); got != c.want {
+			t.Errorf("jobBackoff(%s, %d) = %s, want %s", c.base, c.attempts, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package birdactyl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const MaxKVValueSize = 256 << 10
+
+type KV interface {
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	List(ctx context.Context, prefix string) ([]string, error)
+	CompareAndSwap(ctx context.Context, key string, old, new []byte) (bool, error)
+}
+
+type panelKV struct {
+	api *API
+}
+
+func (a *API) KV() KV {
+	return &panelKV{api: a}
+}
+
+func checkKVValue(value []byte) error {
+	if len(value) > MaxKVValueSize {
+		return fmt.Errorf("%w: %d bytes exceeds the %d byte limit", ErrValueTooLarge, len(value), MaxKVValueSize)
+	}
+	return nil
+}
+
+func (k *panelKV) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := checkKVValue(value); err != nil {
+		return err
+	}
+	_, err := k.api.panel.SetKV(k.api.outgoing(ctx), &pb.KVSetRequest{Key: key, Data: value, TtlSeconds: ttlSeconds(ttl)})
+	return panelErr(err)
+}
+
+func ttlSeconds(ttl time.Duration) int64 {
+	if ttl <= 0 {
+		return 0
+	}
+	return int64((ttl + time.Second - 1) / time.Second)
+}
+
+func (k *panelKV) Get(ctx context.Context, key string) ([]byte, error) {
+	r, err := k.api.panel.GetKV(k.api.outgoing(ctx), &pb.KVRequest{Key: key})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	if !r.Found {
+		return nil, fmt.Errorf("%w: key %q", ErrNotFound, key)
+	}
+	if r.Data == nil && r.Value != "" {
+		return []byte(r.Value), nil
+	}
+	return r.Data, nil
+}
+
+func (k *panelKV) Delete(ctx context.Context, key string) error {
+	_, err := k.api.panel.DeleteKV(k.api.outgoing(ctx), &pb.KVRequest{Key: key})
+	return panelErr(err)
+}
+
+func (k *panelKV) List(ctx context.Context, prefix string) ([]string, error) {
+	r, err := k.api.panel.ListKV(k.api.outgoing(ctx), &pb.KVListRequest{Prefix: prefix})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	return r.Keys, nil
+}
+
+func (k *panelKV) CompareAndSwap(ctx context.Context, key string, old, new []byte) (bool, error) {
+	if err := checkKVValue(new); err != nil {
+		return false, err
+	}
+	r, err := k.api.panel.CompareAndSwapKV(k.api.outgoing(ctx), &pb.KVCompareAndSwapRequest{Key: key, Old: old, New: new, OldMissing: old == nil})
+	if err != nil {
+		return false, panelErr(err)
+	}
+	return r.Swapped, nil
+}
+
+type AsyncKV struct {
+	kv KV
+}
+
+func (a *AsyncAPI) KV() *AsyncKV {
+	return &AsyncKV{kv: a.api.KV()}
+}
+
+func (k *AsyncKV) Set(ctx context.Context, key string, value []byte, ttl time.Duration) <-chan Result[struct{}] {
+	return resultChan(func() (struct{}, error) {
+		return struct{}{}, k.kv.Set(ctx, key, value, ttl)
+	})
+}
+
+func (k *AsyncKV) Get(ctx context.Context, key string) <-chan Result[[]byte] {
+	return resultChan(func() ([]byte, error) {
+		return k.kv.Get(ctx, key)
+	})
+}
+
+func (k *AsyncKV) Delete(ctx context.Context, key string) <-chan Result[struct{}] {
+	return resultChan(func() (struct{}, error) {
+		return struct{}{}, k.kv.Delete(ctx, key)
+	})
+}
+
+func (k *AsyncKV) List(ctx context.Context, prefix string) <-chan Result[[]string] {
+	return resultChan(func() ([]string, error) {
+		return k.kv.List(ctx, prefix)
+	})
+}
+
+func (k *AsyncKV) CompareAndSwap(ctx context.Context, key string, old, new []byte) <-chan Result[bool] {
+	return resultChan(func() (bool, error) {
+		return k.kv.CompareAndSwap(ctx, key, old, new)
+	})
+}
+
+type MemoryKV struct {
+	mu      sync.Mutex
+	entries map[string]memoryKVEntry
+	now     func() time.Time
+}
+
+type memoryKVEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+func NewMemoryKV() *MemoryKV {
+	return &MemoryKV{entries: make(map[string]memoryKVEntry), now: time.Now}
+}
+
+func (m *MemoryKV) lookup(key string) (memoryKVEntry, bool) {
+	e, ok := m.entries[key]
+	if ok && !e.expires.IsZero() && !m.now().Before(e.expires) {
+		delete(m.entries, key)
+		return memoryKVEntry{}, false
+	}
+	return e, ok
+}
+
+func (m *MemoryKV) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := checkKVValue(value); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := memoryKVEntry{value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		e.expires = m.now().Add(ttl)
+	}
+	m.entries[key] = e
+	return nil
+}
+
+func (m *MemoryKV) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.lookup(key)
+	if !ok {
+		return nil, fmt.Errorf("%w: key %q", ErrNotFound, key)
+	}
+	return append([]byte(nil), e.value...), nil
+}
+
+func (m *MemoryKV) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+func (m *MemoryKV) List(ctx context.Context, prefix string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for k := range m.entries {
+		if _, ok := m.lookup(k); ok && strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (m *MemoryKV) CompareAndSwap(ctx context.Context, key string, old, new []byte) (bool, error) {
+	if err := checkKVValue(new); err != nil {
+		return false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.lookup(key)
+	if old == nil {
+		if ok {
+			return false, nil
+		}
+	} else if !ok || !bytes.Equal(e.value, old) {
+		return false, nil
+	}
+	m.entries[key] = memoryKVEntry{value: append([]byte(nil), new...)}
+	return true, nil
+}
@@ -0,0 +1,45 @@
+package birdactyl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+)
+
+type kvPanel struct {
+	pb.PanelServiceClient
+	ttl int64
+}
+
+func (k *kvPanel) SetKV(ctx context.Context, req *pb.KVSetRequest, opts ...grpc.CallOption) (*pb.Empty, error) {
+	k.ttl = req.TtlSeconds
+	return &pb.Empty{}, nil
+}
+
+func TestKVSetRoundsTTLUp(t *testing.T) {
+	tests := []struct {
+		ttl  time.Duration
+		want int64
+	}{
+		{0, 0},
+		{-time.Second, 0},
+		{time.Millisecond, 1},
+		{500 * time.Millisecond, 1},
+		{time.Second, 1},
+		{1500 * time.Millisecond, 2},
+		{time.Minute, 60},
+	}
+	for _, tt := range tests {
+		panel := &kvPanel{}
+		a := &API{panel: panel}
+		if err := a.KV().Set(context.Background(), "k", []byte("v"), tt.ttl); err != nil {
+			t.Fatalf("Set(%s): %v", tt.ttl, err)
+		}
+		if panel.ttl != tt.want {
+			t.Errorf("Set(%s) sent ttl %ds, want %ds", tt.ttl, panel.ttl, tt.want)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package birdactyl
+
+import (
+	"sync"
+	"time"
+)
+
+type lifecycle struct {
+	mu      sync.Mutex
+	queue   []func()
+	wake    chan struct{}
+	started sync.Once
+	onPanic func(r interface{})
+}
+
+func (p *Plugin) OnConnect(fn func()) *Plugin {
+	p.onConnect = append(p.onConnect, fn)
+	return p
+}
+
+func (p *Plugin) OnDisconnect(fn func(err error)) *Plugin {
+	p.onDisconnect = append(p.onDisconnect, fn)
+	return p
+}
+
+func (p *Plugin) fireConnect() {
+	for _, fn := range p.onConnect {
+		p.lifecycle.post(fn)
+	}
+}
+
+func (p *Plugin) fireDisconnect(err error) {
+	for _, fn := range p.onDisconnect {
+		fn := fn
+		p.lifecycle.post(func() { fn(err) })
+	}
+}
+
+func (l *lifecycle) post(fn func()) {
+	l.started.Do(func() {
+		l.wake = make(chan struct{}, 1)
+		go l.loop()
+	})
+	l.mu.Lock()
+	l.queue = append(l.queue, fn)
+	l.mu.Unlock()
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (l *lifecycle) drain(clock Clock, timeout time.Duration) bool {
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+	done := make(chan struct{})
+	l.post(func() { close(done) })
+	timer := clock.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C():
+		return false
+	}
+}
+
+func (l *lifecycle) loop() {
+	for range l.wake {
+		for {
+			l.mu.Lock()
+			if len(l.queue) == 0 {
+				l.mu.Unlock()
+				break
+			}
+			fn := l.queue[0]
+			l.queue = l.queue[1:]
+			l.mu.Unlock()
+			l.run(fn)
+		}
+	}
+}
+
+func (l *lifecycle) run(fn func()) {
+	defer func() {
+		if r := recover(); r != nil && l.onPanic != nil {
+			l.onPanic(r)
+		}
+	}()
+	fn()
+}
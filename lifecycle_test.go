@@ -0,0 +1,42 @@
+package birdactyl_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestLifecyclePanicDoesNotStopLoop(t *testing.T) {
+	connected := make(chan struct{}, 1)
+	p := birdactyl.New("lifecycle", "1.0.0").
+		OnConnect(func() { panic("boom") }).
+		OnConnect(func() { connected <- struct{}{} })
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	select {
+	case <-connected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("callback after a panicking one did not run")
+	}
+}
+
+func TestLifecycleDrainedBeforeExit(t *testing.T) {
+	var finished atomic.Bool
+	p := birdactyl.New("lifecycle", "1.0.0").OnDisconnect(func(error) {
+		time.Sleep(50 * time.Millisecond)
+		finished.Store(true)
+	})
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	if err := tp.Shutdown(); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if !finished.Load() {
+		t.Fatal("OnDisconnect had not finished when the plugin exited")
+	}
+}
@@ -0,0 +1,82 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const LocalUserHeader = "X-Birdactyl-User"
+
+func (p *Plugin) ServeLocal(addr string) error {
+	if os.Getenv(devBundleEnv) != "1" {
+		return fmt.Errorf("birdactyl: ServeLocal is a development tool, set %s=1 to enable it", devBundleEnv)
+	}
+	if err := p.validate(); err != nil {
+		return err
+	}
+	if p.dataDir == "" {
+		p.dataDir = p.id + "_data"
+	}
+	p.setupDataDir()
+	defer p.closeLog()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/__registration", func(w http.ResponseWriter, r *http.Request) {
+		data, err := p.RegistrationJSON()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	})
+	mux.HandleFunc("/", p.serveLocalHTTP)
+
+	p.printf(LevelInfo, "serving routes locally on http://%s", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
+func (p *Plugin) serveLocalHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.HTTPRequest{
+		Method:  r.Method,
+		Path:    r.URL.Path,
+		Headers: make(map[string]string, len(r.Header)),
+		Query:   make(map[string]string),
+		Body:    body,
+		UserId:  r.Header.Get(LocalUserHeader),
+	}
+	for k, v := range r.Header {
+		req.Headers[k] = strings.Join(v, ", ")
+	}
+	for k, v := range r.URL.Query() {
+		req.Query[k] = v[0]
+	}
+
+	requestID := fmt.Sprintf("local-%d", start.UnixNano())
+	resp := p.dispatch(context.Background(), &pb.PanelMessage{RequestId: requestID, Payload: &pb.PanelMessage_Http{Http: req}}).GetHttpResponse()
+	for k, v := range resp.GetHeaders() {
+		w.Header().Set(k, v)
+	}
+	status := int(resp.GetStatus())
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	w.Write(resp.GetBody())
+
+	p.printf(LevelInfo, "%s %s -> %d (%s)", r.Method, r.URL.Path, status, time.Since(start).Round(time.Microsecond))
+}
@@ -0,0 +1,141 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const logTimeout = 5 * time.Second
+
+const (
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelWarn  = "warn"
+	LevelError = "error"
+)
+
+type Fields map[string]string
+
+func (p *Plugin) Logf(format string, args ...interface{}) {
+	p.Log(fmt.Sprintf(format, args...))
+}
+
+func (p *Plugin) LogFields(level, msg string, fields Fields) {
+	if p == nil {
+		log.Printf("[%s] %s%s", level, msg, fields.String())
+		return
+	}
+	p.writeLocal(level, msg+fields.String())
+	if p.panel == nil {
+		log.Printf("[%s] %s: %s%s", p.label(), level, msg, fields.String())
+		return
+	}
+	ctx, cancel := context.WithTimeout(pluginContext(context.Background(), p.id, p.instance), logTimeout)
+	defer cancel()
+	if _, err := p.panel.Log(ctx, &pb.LogRequest{Level: level, Message: msg, Fields: fields}); err != nil {
+		log.Printf("[%s] %s: %s%s (panel log failed: %v)", p.label(), level, msg, fields.String(), err)
+	}
+}
+
+func (p *Plugin) printf(level, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	log.Printf("[%s] %s", p.label(), msg)
+	p.writeLocal(level, msg)
+}
+
+func (p *Plugin) writeLocal(level, msg string) {
+	if p != nil && p.logFile != nil {
+		p.logFile.write(level, msg)
+	}
+}
+
+func (p *Plugin) closeLog() {
+	if p.logFile != nil {
+		p.logFile.close()
+	}
+}
+
+func (f Fields) String() string {
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := ""
+	for _, k := range keys {
+		out += " " + k + "=" + f[k]
+	}
+	return out
+}
+
+func (f Fields) with(key, value string) Fields {
+	if value != "" {
+		f[key] = value
+	}
+	return f
+}
+
+func (r Request) Log(msg string) {
+	r.plugin.LogFields(LevelInfo, msg, r.logFields())
+}
+
+func (r Request) Logf(format string, args ...interface{}) {
+	r.Log(fmt.Sprintf(format, args...))
+}
+
+func (r Request) logFields() Fields {
+	return Fields{}.with("request_id", r.RequestID).with("route", r.route).with("user_id", r.UserID)
+}
+
+func (e Event) Log(msg string) {
+	e.plugin.LogFields(LevelInfo, msg, e.logFields())
+}
+
+func (e Event) Logf(format string, args ...interface{}) {
+	e.Log(fmt.Sprintf(format, args...))
+}
+
+func (e Event) logFields() Fields {
+	return Fields{}.with("request_id", e.RequestID).with("event", e.Type)
+}
+
+func (c *MixinContext) Log(msg string) {
+	c.plugin.LogFields(LevelInfo, msg, c.logFields())
+}
+
+func (c *MixinContext) Logf(format string, args ...interface{}) {
+	c.Log(fmt.Sprintf(format, args...))
+}
+
+func (c *MixinContext) logFields() Fields {
+	return Fields{}.with("request_id", c.RequestID).with("mixin", c.Target)
+}
+
+func (s Sched) Log(msg string) {
+	s.plugin.LogFields(LevelInfo, msg, s.logFields())
+}
+
+func (s Sched) Logf(format string, args ...interface{}) {
+	s.Log(fmt.Sprintf(format, args...))
+}
+
+func (s Sched) logFields() Fields {
+	return Fields{}.with("request_id", s.RequestID).with("schedule", s.ID)
+}
+
+func (r AddonTypeRequest) Log(msg string) {
+	r.plugin.LogFields(LevelInfo, msg, r.logFields())
+}
+
+func (r AddonTypeRequest) Logf(format string, args ...interface{}) {
+	r.Log(fmt.Sprintf(format, args...))
+}
+
+func (r AddonTypeRequest) logFields() Fields {
+	return Fields{}.with("request_id", r.RequestID).with("addon_type", r.TypeID).with("server_id", r.ServerID)
+}
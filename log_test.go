@@ -0,0 +1,42 @@
+package birdactyl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+)
+
+type logPanel struct {
+	pb.PanelServiceClient
+	deadline bool
+}
+
+func (l *logPanel) Log(ctx context.Context, req *pb.LogRequest, opts ...grpc.CallOption) (*pb.Empty, error) {
+	_, l.deadline = ctx.Deadline()
+	return nil, errors.New("panel unavailable")
+}
+
+func TestLogFieldsFallsBackOnPanelError(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	panel := &logPanel{}
+	p := New("logs", "1.0.0")
+	p.panel = panel
+	p.LogFields(LevelWarn, "disk low", Fields{"free": "1%"})
+
+	if !panel.deadline {
+		t.Fatal("panel Log called without a deadline")
+	}
+	if out := buf.String(); !strings.Contains(out, "disk low free=1%") || !strings.Contains(out, "panel unavailable") {
+		t.Fatalf("log output = %q, want the message and the panel error", out)
+	}
+}
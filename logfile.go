@@ -0,0 +1,96 @@
+package birdactyl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const logFileName = "plugin.log"
+
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	failed     bool
+}
+
+func (r *rotatingFile) write(level, msg string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failed || r.path == "" {
+		return
+	}
+	if r.file == nil && !r.open() {
+		return
+	}
+	line := fmt.Sprintf("%s %-5s %s\n", time.Now().Format(time.RFC3339), level, msg)
+	if r.maxSize > 0 && r.size+int64(len(line)) > r.maxSize && r.size > 0 {
+		r.rotate()
+		if r.file == nil {
+			return
+		}
+	}
+	n, _ := r.file.WriteString(line)
+	r.size += int64(n)
+}
+
+func (r *rotatingFile) open() bool {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		r.failed = true
+		return false
+	}
+	info, err := f.Stat()
+	if err == nil {
+		r.size = info.Size()
+	}
+	r.file = f
+	return true
+}
+
+func (r *rotatingFile) rotate() {
+	r.file.Close()
+	r.file = nil
+	if r.maxBackups <= 0 {
+		os.Remove(r.path)
+	} else {
+		os.Remove(r.backupPath(r.maxBackups))
+		for i := r.maxBackups - 1; i >= 1; i-- {
+			os.Rename(r.backupPath(i), r.backupPath(i+1))
+		}
+		os.Rename(r.path, r.backupPath(1))
+	}
+	r.prune()
+	r.size = 0
+	r.open()
+}
+
+func (r *rotatingFile) prune() {
+	matches, _ := filepath.Glob(r.path + ".*")
+	for _, m := range matches {
+		var n int
+		if _, err := fmt.Sscanf(m[len(r.path)+1:], "%d", &n); err == nil && n > r.maxBackups {
+			os.Remove(m)
+		}
+	}
+}
+
+func (r *rotatingFile) backupPath(n int) string {
+	return fmt.Sprintf("%s.%d", r.path, n)
+}
+
+func (r *rotatingFile) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file != nil {
+		r.file.Sync()
+		r.file.Close()
+		r.file = nil
+	}
+}
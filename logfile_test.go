@@ -0,0 +1,33 @@
+package birdactyl_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestLocalLogFileTeesForwardedLogs(t *testing.T) {
+	chdirTemp(t)
+	p := birdactyl.New("logs", "1.0.0", birdactyl.WithLocalLogFile(1, 1)).UseDataDir()
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	p.Log("plugin line")
+	p.API().Log(birdactyl.LevelWarn, "api line")
+	p.Background().Log(birdactyl.LevelError, "background line")
+	p.Async().Batch().Add(birdactyl.BatchLog(birdactyl.LevelInfo, "batch line")).Execute(context.Background())
+
+	data, err := os.ReadFile(p.DataPath("plugin.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"info  plugin line", "warn  api line", "error background line", "info  batch line"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("plugin.log missing %q:\n%s", want, data)
+		}
+	}
+}
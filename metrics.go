@@ -0,0 +1,266 @@
+package birdactyl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+var latencyBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+const (
+	KindRoute     = "route"
+	KindEvent     = "event"
+	KindSchedule  = "schedule"
+	KindMixin     = "mixin"
+	KindAddonType = "addon_type"
+)
+
+type metrics struct {
+	mu       sync.Mutex
+	handlers map[string]*handlerStats
+}
+
+type handlerStats struct {
+	kind, name string
+	count      uint64
+	errors     uint64
+	panics     uint64
+	sum        time.Duration
+	buckets    []uint64
+}
+
+type HandlerMetrics struct {
+	Kind       string
+	Name       string
+	Count      uint64
+	Errors     uint64
+	Panics     uint64
+	LatencySum time.Duration
+	Buckets    []LatencyBucket
+}
+
+type LatencyBucket struct {
+	UpperBound time.Duration
+	Count      uint64
+}
+
+type QueueMetrics struct {
+	Depth         int
+	Capacity      int
+	Lanes         int
+	Rejected      uint64
+	DroppedEvents uint64
+}
+
+type MetricsSnapshot struct {
+	Handlers     []HandlerMetrics
+	Queue        QueueMetrics
+	Outbound     OutboundMetrics
+	Cache        CacheMetrics
+	UserCache    UserCacheMetrics
+	SendFailures uint64
+	Throttled    uint64
+	Background   BackgroundMetrics
+}
+
+type BackgroundMetrics struct {
+	Pending int
+	Dropped uint64
+}
+
+func WithMetrics() Option {
+	return func(p *Plugin) {
+		if p.metrics == nil {
+			p.metrics = &metrics{handlers: make(map[string]*handlerStats)}
+		}
+	}
+}
+
+func WithMetricsEndpoint(path string) Option {
+	return func(p *Plugin) {
+		WithMetrics()(p)
+		p.Route("GET", path, func(Request) Response {
+			return Text(p.Metrics().Prometheus()).WithHeader("Content-Type", "text/plain; version=0.0.4")
+		})
+	}
+}
+
+func (p *Plugin) Metrics() MetricsSnapshot {
+	var snap MetricsSnapshot
+	if p.metrics != nil {
+		snap = p.metrics.snapshot()
+	}
+	snap.Queue = p.queueMetrics()
+	snap.Outbound = p.outbound.metrics()
+	snap.Cache = p.cache.metrics()
+	snap.UserCache = p.users.metrics()
+	snap.SendFailures = p.sendFailures.Load()
+	snap.Throttled = p.throttled.Load()
+	snap.Background = BackgroundMetrics{Pending: p.BufferedCalls(), Dropped: p.bgDropped.Load()}
+	return snap
+}
+
+func (p *Plugin) observe(msg *pb.PanelMessage, resp *pb.PluginMessage, panicked bool, elapsed time.Duration) {
+	var kind, name string
+	failed := false
+	switch payload := msg.Payload.(type) {
+	case *pb.PanelMessage_Http:
+		kind, name = KindRoute, "unmatched"
+		if cfg := p.findRoute(payload.Http.Method, payload.Http.Path); cfg != nil {
+			name = cfg.Method + " " + cfg.Path
+		}
+		failed = resp.GetHttpResponse().GetStatus() >= 500
+	case *pb.PanelMessage_Event:
+		kind, name = KindEvent, payload.Event.Type
+	case *pb.PanelMessage_Schedule:
+		kind, name = KindSchedule, payload.Schedule.ScheduleId
+	case *pb.PanelMessage_Mixin:
+		kind, name = KindMixin, payload.Mixin.Target
+		failed = resp.GetMixinResponse().GetAction() == pb.MixinResponse_ERROR
+	case *pb.PanelMessage_AddonType:
+		kind, name = KindAddonType, payload.AddonType.TypeId
+		failed = !resp.GetAddonTypeResponse().GetSuccess()
+	default:
+		return
+	}
+	p.metrics.record(kind, name, elapsed, failed || panicked, panicked)
+}
+
+func (m *metrics) record(kind, name string, elapsed time.Duration, failed, panicked bool) {
+	key := kind + "\x00" + name
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st, ok := m.handlers[key]
+	if !ok {
+		st = &handlerStats{kind: kind, name: name, buckets: make([]uint64, len(latencyBuckets))}
+		m.handlers[key] = st
+	}
+	st.count++
+	st.sum += elapsed
+	if failed {
+		st.errors++
+	}
+	if panicked {
+		st.panics++
+	}
+	for i, b := range latencyBuckets {
+		if elapsed <= b {
+			st.buckets[i]++
+			break
+		}
+	}
+}
+
+func (m *metrics) snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	snap := MetricsSnapshot{Handlers: make([]HandlerMetrics, 0, len(m.handlers))}
+	for _, st := range m.handlers {
+		h := HandlerMetrics{Kind: st.kind, Name: st.name, Count: st.count, Errors: st.errors, Panics: st.panics, LatencySum: st.sum}
+		var cumulative uint64
+		for i, b := range latencyBuckets {
+			cumulative += st.buckets[i]
+			h.Buckets = append(h.Buckets, LatencyBucket{UpperBound: b, Count: cumulative})
+		}
+		snap.Handlers = append(snap.Handlers, h)
+	}
+	sort.Slice(snap.Handlers, func(i, j int) bool {
+		if snap.Handlers[i].Kind != snap.Handlers[j].Kind {
+			return snap.Handlers[i].Kind < snap.Handlers[j].Kind
+		}
+		return snap.Handlers[i].Name < snap.Handlers[j].Name
+	})
+	return snap
+}
+
+func (s MetricsSnapshot) Prometheus() string {
+	var b strings.Builder
+	b.WriteString("# TYPE birdactyl_queue_depth gauge\n")
+	fmt.Fprintf(&b, "birdactyl_queue_depth %d\n", s.Queue.Depth)
+	b.WriteString("# TYPE birdactyl_queue_lanes gauge\n")
+	fmt.Fprintf(&b, "birdactyl_queue_lanes %d\n", s.Queue.Lanes)
+	b.WriteString("# TYPE birdactyl_queue_capacity gauge\n")
+	fmt.Fprintf(&b, "birdactyl_queue_capacity %d\n", s.Queue.Capacity)
+	b.WriteString("# TYPE birdactyl_queue_rejected_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_queue_rejected_total %d\n", s.Queue.Rejected)
+	b.WriteString("# TYPE birdactyl_queue_dropped_events_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_queue_dropped_events_total %d\n", s.Queue.DroppedEvents)
+	b.WriteString("# TYPE birdactyl_outbound_throttled_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_outbound_throttled_total %d\n", s.Outbound.Throttled)
+	b.WriteString("# TYPE birdactyl_outbound_throttle_wait_seconds_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_outbound_throttle_wait_seconds_total %g\n", s.Outbound.ThrottleWait.Seconds())
+	circuitOpen := 0
+	if s.Outbound.CircuitState != CircuitClosed {
+		circuitOpen = 1
+	}
+	b.WriteString("# TYPE birdactyl_circuit_open gauge\n")
+	fmt.Fprintf(&b, "birdactyl_circuit_open %d\n", circuitOpen)
+	b.WriteString("# TYPE birdactyl_circuit_opens_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_circuit_opens_total %d\n", s.Outbound.CircuitOpens)
+	b.WriteString("# TYPE birdactyl_circuit_rejected_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_circuit_rejected_total %d\n", s.Outbound.CircuitRejected)
+	b.WriteString("# TYPE birdactyl_cache_hits_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_cache_hits_total %d\n", s.Cache.Hits)
+	b.WriteString("# TYPE birdactyl_cache_misses_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_cache_misses_total %d\n", s.Cache.Misses)
+	b.WriteString("# TYPE birdactyl_cache_evictions_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_cache_evictions_total %d\n", s.Cache.Evictions)
+	b.WriteString("# TYPE birdactyl_cache_bytes gauge\n")
+	fmt.Fprintf(&b, "birdactyl_cache_bytes %d\n", s.Cache.Bytes)
+	b.WriteString("# TYPE birdactyl_user_cache_hits_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_user_cache_hits_total %d\n", s.UserCache.Hits)
+	b.WriteString("# TYPE birdactyl_user_cache_misses_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_user_cache_misses_total %d\n", s.UserCache.Misses)
+	b.WriteString("# TYPE birdactyl_send_failures_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_send_failures_total %d\n", s.SendFailures)
+	b.WriteString("# TYPE birdactyl_route_throttled_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_route_throttled_total %d\n", s.Throttled)
+	b.WriteString("# TYPE birdactyl_background_pending gauge\n")
+	fmt.Fprintf(&b, "birdactyl_background_pending %d\n", s.Background.Pending)
+	b.WriteString("# TYPE birdactyl_background_dropped_total counter\n")
+	fmt.Fprintf(&b, "birdactyl_background_dropped_total %d\n", s.Background.Dropped)
+	b.WriteString("# TYPE birdactyl_handler_calls_total counter\n")
+	for _, h := range s.Handlers {
+		fmt.Fprintf(&b, "birdactyl_handler_calls_total{%s} %d\n", h.labels(), h.Count)
+	}
+	b.WriteString("# TYPE birdactyl_handler_errors_total counter\n")
+	for _, h := range s.Handlers {
+		fmt.Fprintf(&b, "birdactyl_handler_errors_total{%s} %d\n", h.labels(), h.Errors)
+	}
+	b.WriteString("# TYPE birdactyl_handler_panics_total counter\n")
+	for _, h := range s.Handlers {
+		fmt.Fprintf(&b, "birdactyl_handler_panics_total{%s} %d\n", h.labels(), h.Panics)
+	}
+	b.WriteString("# TYPE birdactyl_handler_duration_seconds histogram\n")
+	for _, h := range s.Handlers {
+		for _, bucket := range h.Buckets {
+			fmt.Fprintf(&b, "birdactyl_handler_duration_seconds_bucket{%s,le=\"%g\"} %d\n", h.labels(), bucket.UpperBound.Seconds(), bucket.Count)
+		}
+		fmt.Fprintf(&b, "birdactyl_handler_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", h.labels(), h.Count)
+		fmt.Fprintf(&b, "birdactyl_handler_duration_seconds_sum{%s} %g\n", h.labels(), h.LatencySum.Seconds())
+		fmt.Fprintf(&b, "birdactyl_handler_duration_seconds_count{%s} %d\n", h.labels(), h.Count)
+	}
+	return b.String()
+}
+
+func (h HandlerMetrics) labels() string {
+	return fmt.Sprintf("kind=%q,name=%q", h.Kind, h.Name)
+}
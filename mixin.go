@@ -1,6 +1,9 @@
 package birdactyl
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 const (
 	MixinServerCreate    = "server.create"
@@ -87,9 +90,40 @@ type MixinContext struct {
 	RequestID     string
 	input         map[string]interface{}
 	chainData     map[string]interface{}
+	output        map[string]interface{}
 	nextCalled    bool
 	result        MixinResult
 	notifications []Notification
+	skipped       []SkippedEffect
+	dryRun        bool
+	deferral      *deferredMixin
+	ctx           context.Context
+	flags         FlagSnapshot
+	plugin        *Plugin
+}
+
+func (c *MixinContext) Flags() FlagSnapshot {
+	return c.flags
+}
+
+func (c *MixinContext) Context() context.Context {
+	return orBackground(c.ctx)
+}
+
+func (c *MixinContext) IsDryRun() bool {
+	return c.dryRun
+}
+
+func (c *MixinContext) Skip(kind, description string) {
+	c.skipped = append(c.skipped, SkippedEffect{Kind: kind, Description: description})
+}
+
+func (c *MixinContext) SideEffect(kind, description string, fn func() error) error {
+	if c.dryRun {
+		c.Skip(kind, description)
+		return nil
+	}
+	return fn()
 }
 
 type Notification struct {
@@ -98,12 +132,21 @@ type Notification struct {
 	Type    string
 }
 
+type SkippedEffect struct {
+	Kind        string
+	Description string
+}
+
 type MixinResult struct {
 	action        int
 	output        map[string]interface{}
 	err           string
 	modifiedInput map[string]interface{}
+	outputPatch   map[string]interface{}
+	deleteKeys    []string
 	notifications []Notification
+	skipped       []SkippedEffect
+	deferred      bool
 }
 
 type MixinHandler func(*MixinContext) MixinResult
@@ -174,7 +217,7 @@ func (c *MixinContext) NotifyInfo(title, message string) {
 
 func (c *MixinContext) Next() MixinResult {
 	c.nextCalled = true
-	return MixinResult{action: 0, modifiedInput: c.result.modifiedInput, notifications: c.notifications}
+	return MixinResult{action: 0, modifiedInput: c.result.modifiedInput, outputPatch: c.result.outputPatch, deleteKeys: c.result.deleteKeys, notifications: c.notifications, skipped: c.skipped}
 }
 
 func (c *MixinContext) Return(data interface{}) MixinResult {
@@ -186,15 +229,16 @@ func (c *MixinContext) Return(data interface{}) MixinResult {
 		b, _ := json.Marshal(data)
 		json.Unmarshal(b, &out)
 	}
-	return MixinResult{action: 1, output: out, notifications: c.notifications}
+	return MixinResult{action: 1, output: out, notifications: c.notifications, skipped: c.skipped}
 }
 
 func (c *MixinContext) Error(msg string) MixinResult {
-	return MixinResult{action: 2, err: msg, notifications: c.notifications}
+	return MixinResult{action: 2, err: msg, notifications: c.notifications, skipped: c.skipped}
 }
 
 type MixinRegistration struct {
 	Target   string
 	Priority int
 	Handler  MixinHandler
+	Key      MixinKeyFunc
 }
@@ -0,0 +1,170 @@
+package birdactyl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const DefaultMixinDeadline = 30 * time.Second
+
+func WithMixinDeadline(d time.Duration) Option {
+	return func(p *Plugin) {
+		p.mixinDeadline = d
+	}
+}
+
+func MixinDeferred() MixinResult {
+	return MixinResult{deferred: true}
+}
+
+func (c *MixinContext) Complete(result MixinResult) {
+	d := c.deferral
+	if d == nil {
+		return
+	}
+	if result.deferred {
+		d.plugin.printf(LevelWarn, "mixin %s request %s completed with a deferred result, continuing", c.Target, c.RequestID)
+		result = MixinResult{}
+	}
+	if !d.complete(result) {
+		d.plugin.printf(LevelWarn, "mixin %s request %s completed twice, ignoring", c.Target, c.RequestID)
+	}
+}
+
+func (p *Plugin) DeferredMixins() int {
+	p.pendingMu.RLock()
+	defer p.pendingMu.RUnlock()
+	n := 0
+	for _, call := range p.pending {
+		if call.deferred != nil {
+			n++
+		}
+	}
+	return n
+}
+
+type deferredMixin struct {
+	mu     sync.Mutex
+	plugin *Plugin
+	gen    uint64
+	id     string
+	msg    *pb.PanelMessage
+	cancel context.CancelFunc
+	stop   chan struct{}
+	done   bool
+	parked bool
+	early  *MixinResult
+}
+
+func (d *deferredMixin) settle() {
+	d.mu.Lock()
+	d.done = true
+	d.mu.Unlock()
+}
+
+func (d *deferredMixin) park() *MixinResult {
+	p := d.plugin
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.early != nil {
+		return d.early
+	}
+	p.pendingMu.Lock()
+	if p.maxPending > 0 && len(p.pending) >= p.maxPending {
+		p.pendingMu.Unlock()
+		d.done = true
+		p.printf(LevelWarn, "too many pending requests, failing deferred mixin %s", d.msg.GetMixin().Target)
+		return &MixinResult{action: 2, err: "plugin overloaded"}
+	}
+	p.pending[d.id] = &pendingCall{created: p.clock().Now(), deferred: d}
+	p.pendingMu.Unlock()
+	d.parked = true
+	go d.watch(p.mixinDeadline)
+	return nil
+}
+
+func (d *deferredMixin) complete(result MixinResult) bool {
+	d.mu.Lock()
+	if d.done {
+		d.mu.Unlock()
+		return false
+	}
+	d.done = true
+	if !d.parked {
+		d.early = &result
+		d.mu.Unlock()
+		return true
+	}
+	d.mu.Unlock()
+	d.finish(&result)
+	return true
+}
+
+func (d *deferredMixin) abandon() {
+	d.mu.Lock()
+	if d.done {
+		d.mu.Unlock()
+		return
+	}
+	d.done = true
+	d.mu.Unlock()
+	d.finish(nil)
+}
+
+func (d *deferredMixin) finish(result *MixinResult) {
+	p := d.plugin
+	close(d.stop)
+	d.cancel()
+	p.removePending(d.id)
+	if result == nil {
+		return
+	}
+	resp := p.mixinResponse(d.msg.GetMixin(), *result)
+	p.respond(d.gen, d.msg, &pb.PluginMessage{RequestId: d.msg.RequestId, Payload: &pb.PluginMessage_MixinResponse{MixinResponse: resp}})
+}
+
+func (d *deferredMixin) watch(deadline time.Duration) {
+	if deadline <= 0 {
+		deadline = DefaultMixinDeadline
+	}
+	timer := d.plugin.clock().NewTimer(deadline)
+	defer timer.Stop()
+	select {
+	case <-d.stop:
+	case <-timer.C():
+		if d.complete(MixinResult{}) {
+			d.plugin.printf(LevelWarn, "deferred mixin %s request %s missed its %s deadline, continuing", d.msg.GetMixin().Target, d.msg.RequestId, deadline)
+		}
+	}
+}
+
+func (p *Plugin) deferredMixins() []*deferredMixin {
+	p.pendingMu.RLock()
+	defer p.pendingMu.RUnlock()
+	var out []*deferredMixin
+	for _, call := range p.pending {
+		if call.deferred != nil {
+			out = append(out, call.deferred)
+		}
+	}
+	return out
+}
+
+func (p *Plugin) failDeferredMixins(reason string) {
+	outstanding := p.deferredMixins()
+	for _, d := range outstanding {
+		d.complete(MixinResult{action: 2, err: reason})
+	}
+	if len(outstanding) > 0 {
+		p.printf(LevelWarn, "failed %d deferred mixins: %s", len(outstanding), reason)
+	}
+}
+
+func (p *Plugin) abandonDeferredMixins() {
+	for _, d := range p.deferredMixins() {
+		d.abandon()
+	}
+}
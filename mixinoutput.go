@@ -0,0 +1,92 @@
+package birdactyl
+
+import "strings"
+
+func (c *MixinContext) Output() map[string]interface{} {
+	return c.output
+}
+
+func (c *MixinContext) ModifyOutputPartial(patch map[string]interface{}) MixinResult {
+	if c.result.outputPatch == nil {
+		c.result.outputPatch = make(map[string]interface{}, len(patch))
+	}
+	mergeOutput(c.result.outputPatch, cloneOutput(patch))
+	return c.Next()
+}
+
+func (c *MixinContext) DeleteOutputKeys(keys ...string) MixinResult {
+	for _, key := range keys {
+		if c.result.outputPatch != nil {
+			prunePatchKey(c.result.outputPatch, strings.Split(key, "."))
+		}
+		c.result.deleteKeys = append(c.result.deleteKeys, key)
+	}
+	return c.Next()
+}
+
+func MergeOutput(output, patch map[string]interface{}, deleteKeys []string) map[string]interface{} {
+	out := cloneOutput(output)
+	if out == nil {
+		out = make(map[string]interface{})
+	}
+	for _, key := range deleteKeys {
+		deleteOutputKey(out, strings.Split(key, "."))
+	}
+	mergeOutput(out, cloneOutput(patch))
+	return out
+}
+
+func mergeOutput(dst, patch map[string]interface{}) {
+	for k, v := range patch {
+		pm, ok := v.(map[string]interface{})
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		dm, ok := dst[k].(map[string]interface{})
+		if !ok {
+			dm = make(map[string]interface{}, len(pm))
+			dst[k] = dm
+		}
+		mergeOutput(dm, pm)
+	}
+}
+
+func deleteOutputKey(m map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		delete(m, path[0])
+		return
+	}
+	if next, ok := m[path[0]].(map[string]interface{}); ok {
+		deleteOutputKey(next, path[1:])
+	}
+}
+
+func prunePatchKey(m map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		delete(m, path[0])
+		return
+	}
+	next, ok := m[path[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	prunePatchKey(next, path[1:])
+	if len(next) == 0 {
+		delete(m, path[0])
+	}
+}
+
+func cloneOutput(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if nested, ok := v.(map[string]interface{}); ok {
+			v = cloneOutput(nested)
+		}
+		out[k] = v
+	}
+	return out
+}
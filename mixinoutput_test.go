@@ -0,0 +1,66 @@
+package birdactyl_test
+
+import (
+	"reflect"
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestMixinOutputOperationsApplyInCallOrder(t *testing.T) {
+	output := map[string]interface{}{
+		"name": "srv",
+		"node": map[string]interface{}{"id": "n1", "token": "secret"},
+	}
+	tests := []struct {
+		name    string
+		handler birdactyl.MixinHandler
+		want    map[string]interface{}
+	}{
+		{
+			name: "delete after patch",
+			handler: func(c *birdactyl.MixinContext) birdactyl.MixinResult {
+				c.ModifyOutputPartial(map[string]interface{}{"name": "renamed", "extra": true})
+				return c.DeleteOutputKeys("extra", "name")
+			},
+			want: map[string]interface{}{"node": map[string]interface{}{"id": "n1", "token": "secret"}},
+		},
+		{
+			name: "patch after delete",
+			handler: func(c *birdactyl.MixinContext) birdactyl.MixinResult {
+				c.DeleteOutputKeys("name")
+				return c.ModifyOutputPartial(map[string]interface{}{"name": "renamed"})
+			},
+			want: map[string]interface{}{"name": "renamed", "node": map[string]interface{}{"id": "n1", "token": "secret"}},
+		},
+		{
+			name: "nested delete after patch",
+			handler: func(c *birdactyl.MixinContext) birdactyl.MixinResult {
+				c.ModifyOutputPartial(map[string]interface{}{"node": map[string]interface{}{"token": "rotated"}})
+				return c.DeleteOutputKeys("node.token")
+			},
+			want: map[string]interface{}{"name": "srv", "node": map[string]interface{}{"id": "n1"}},
+		},
+		{
+			name: "delete of a key only added by the patch",
+			handler: func(c *birdactyl.MixinContext) birdactyl.MixinResult {
+				c.ModifyOutputPartial(map[string]interface{}{"meta": map[string]interface{}{"tag": "x"}})
+				return c.DeleteOutputKeys("meta.tag")
+			},
+			want: map[string]interface{}{"name": "srv", "node": map[string]interface{}{"id": "n1", "token": "secret"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := birdactyl.New("mixins", "1.0.0").Mixin("servers.get", tt.handler)
+			tp := birdactyltest.NewPanel(t)
+			tp.StartPlugin(p)
+
+			res := tp.RunPostMixin("servers.get", nil, output)
+			if !reflect.DeepEqual(res.Output, tt.want) {
+				t.Fatalf("output = %v, want %v", res.Output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const discordMaxEmbeds = 10
+
+type Embed struct {
+	Title       string
+	Description string
+	URL         string
+	Color       int
+	Fields      []EmbedField
+	Footer      string
+	Timestamp   time.Time
+}
+
+type EmbedField struct {
+	Name   string
+	Value  string
+	Inline bool
+}
+
+type Discord struct {
+	plugin   *Plugin
+	url      string
+	username string
+	avatar   string
+}
+
+func (p *Plugin) Discord(webhookURL string) *Discord {
+	return &Discord{plugin: p, url: webhookURL}
+}
+
+func (d *Discord) Username(name string) *Discord {
+	d.username = name
+	return d
+}
+
+func (d *Discord) Avatar(url string) *Discord {
+	d.avatar = url
+	return d
+}
+
+func (d *Discord) Send(content string) error {
+	return d.send(content, nil)
+}
+
+func (d *Discord) SendEmbed(embeds ...Embed) error {
+	if len(embeds) > discordMaxEmbeds {
+		return fmt.Errorf("%w: discord allows at most %d embeds, got %d", ErrInvalidArgument, discordMaxEmbeds, len(embeds))
+	}
+	return d.send("", embeds)
+}
+
+func (d *Discord) send(content string, embeds []Embed) error {
+	payload := map[string]interface{}{}
+	if content != "" {
+		payload["content"] = content
+	}
+	if d.username != "" {
+		payload["username"] = d.username
+	}
+	if d.avatar != "" {
+		payload["avatar_url"] = d.avatar
+	}
+	if len(embeds) > 0 {
+		out := make([]map[string]interface{}, len(embeds))
+		for i, e := range embeds {
+			out[i] = e.payload()
+		}
+		payload["embeds"] = out
+	}
+	return d.plugin.Webhooks().Send(context.Background(), d.url, payload)
+}
+
+func (e Embed) payload() map[string]interface{} {
+	m := map[string]interface{}{}
+	if e.Title != "" {
+		m["title"] = e.Title
+	}
+	if e.Description != "" {
+		m["description"] = e.Description
+	}
+	if e.URL != "" {
+		m["url"] = e.URL
+	}
+	if e.Color != 0 {
+		m["color"] = e.Color
+	}
+	if len(e.Fields) > 0 {
+		fields := make([]map[string]interface{}, len(e.Fields))
+		for i, f := range e.Fields {
+			fields[i] = map[string]interface{}{"name": f.Name, "value": f.Value, "inline": f.Inline}
+		}
+		m["fields"] = fields
+	}
+	if e.Footer != "" {
+		m["footer"] = map[string]string{"text": e.Footer}
+	}
+	if !e.Timestamp.IsZero() {
+		m["timestamp"] = e.Timestamp.UTC().Format(time.RFC3339)
+	}
+	return m
+}
+
+type SlackBlock map[string]interface{}
+
+func SlackHeader(text string) SlackBlock {
+	return SlackBlock{"type": "header", "text": map[string]interface{}{"type": "plain_text", "text": text}}
+}
+
+func SlackSection(markdown string) SlackBlock {
+	return SlackBlock{"type": "section", "text": map[string]interface{}{"type": "mrkdwn", "text": markdown}}
+}
+
+func SlackFields(markdown ...string) SlackBlock {
+	fields := make([]map[string]interface{}, len(markdown))
+	for i, f := range markdown {
+		fields[i] = map[string]interface{}{"type": "mrkdwn", "text": f}
+	}
+	return SlackBlock{"type": "section", "fields": fields}
+}
+
+func SlackContext(markdown ...string) SlackBlock {
+	elements := make([]map[string]interface{}, len(markdown))
+	for i, f := range markdown {
+		elements[i] = map[string]interface{}{"type": "mrkdwn", "text": f}
+	}
+	return SlackBlock{"type": "context", "elements": elements}
+}
+
+func SlackDivider() SlackBlock {
+	return SlackBlock{"type": "divider"}
+}
+
+type Slack struct {
+	plugin *Plugin
+	url    string
+}
+
+func (p *Plugin) Slack(webhookURL string) *Slack {
+	return &Slack{plugin: p, url: webhookURL}
+}
+
+func (s *Slack) Send(text string) error {
+	return s.SendBlocks(text)
+}
+
+func (s *Slack) SendBlocks(text string, blocks ...SlackBlock) error {
+	payload := map[string]interface{}{"text": text}
+	if len(blocks) > 0 {
+		payload["blocks"] = blocks
+	}
+	return s.plugin.Webhooks().Send(context.Background(), s.url, payload)
+}
@@ -0,0 +1,23 @@
+package birdactyl
+
+import "time"
+
+type Option func(*Plugin)
+
+func WithLocalLogFile(maxSizeMB, maxBackups int) Option {
+	return func(p *Plugin) {
+		p.logFile = &rotatingFile{maxSize: int64(maxSizeMB) << 20, maxBackups: maxBackups}
+	}
+}
+
+func WithMaxPendingCalls(n int) Option {
+	return func(p *Plugin) {
+		p.maxPending = n
+	}
+}
+
+func WithPendingTimeout(d time.Duration) Option {
+	return func(p *Plugin) {
+		p.pendingTTL = d
+	}
+}
@@ -0,0 +1,132 @@
+package birdactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type EventKeyFunc func(Event) string
+
+type MixinKeyFunc func(target string, input map[string]interface{}) string
+
+type eventOrdering struct {
+	pattern string
+	key     EventKeyFunc
+}
+
+func ByServerID(e Event) string {
+	return e.Data["server_id"]
+}
+
+func ByInputID(target string, input map[string]interface{}) string {
+	for _, k := range []string{"server_id", "id"} {
+		if v, ok := input[k]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+func (p *Plugin) OnEventOrdered(pattern string, key EventKeyFunc, handler EventHandler) *Plugin {
+	if key == nil {
+		key = ByServerID
+	}
+	p.OnEvent(pattern, handler)
+	p.regMu.Lock()
+	p.orderings = append(p.orderings, eventOrdering{pattern: pattern, key: key})
+	p.regMu.Unlock()
+	return p
+}
+
+func (p *Plugin) MixinOrdered(target string, key MixinKeyFunc, handler MixinHandler) *Plugin {
+	if key == nil {
+		key = ByInputID
+	}
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	p.mixins = append(p.mixins, MixinRegistration{Target: target, Handler: handler, Key: key})
+	return p
+}
+
+func matchEventType(pattern, eventType string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(eventType, prefix)
+	}
+	return pattern == eventType
+}
+
+func (p *Plugin) eventHandler(eventType string) (EventHandler, bool) {
+	if h, ok := p.events[eventType]; ok {
+		return h, true
+	}
+	var best string
+	for pattern := range p.events {
+		if strings.HasSuffix(pattern, "*") && len(pattern) > len(best) && matchEventType(pattern, eventType) {
+			best = pattern
+		}
+	}
+	if best == "" {
+		return nil, false
+	}
+	return p.events[best], true
+}
+
+func (p *Plugin) laneKey(msg *pb.PanelMessage) (lane string) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.reportPanic(r, "lane key", msg.RequestId)
+			lane = ""
+		}
+	}()
+	switch payload := msg.Payload.(type) {
+	case *pb.PanelMessage_Event:
+		ev := payload.Event
+		var key EventKeyFunc
+		best := -1
+		p.regMu.RLock()
+		for _, o := range p.orderings {
+			if !matchEventType(o.pattern, ev.Type) {
+				continue
+			}
+			score := len(o.pattern)
+			if o.pattern == ev.Type {
+				score = len(o.pattern) + 1
+			}
+			if score > best {
+				key, best = o.key, score
+			}
+		}
+		p.regMu.RUnlock()
+		if key == nil {
+			return ""
+		}
+		if k := key(Event{Type: ev.Type, Data: ev.Data, Sync: ev.Sync, Seq: ev.Seq, Replayed: ev.Replayed, RequestID: msg.RequestId, plugin: p}); k != "" {
+			return "event:" + k
+		}
+	case *pb.PanelMessage_Mixin:
+		req := payload.Mixin
+		var key MixinKeyFunc
+		p.regMu.RLock()
+		for _, m := range p.mixins {
+			if m.Target == req.Target {
+				key = m.Key
+				break
+			}
+		}
+		p.regMu.RUnlock()
+		if key == nil {
+			return ""
+		}
+		var input map[string]interface{}
+		if err := json.Unmarshal(req.Input, &input); err != nil {
+			return ""
+		}
+		if k := key(req.Target, input); k != "" {
+			return "mixin:" + req.Target + ":" + k
+		}
+	}
+	return ""
+}
@@ -0,0 +1,34 @@
+package birdactyl_test
+
+import (
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestPanickingEventKeyFallsBackToDefaultLane(t *testing.T) {
+	p := birdactyl.New("ordering", "1.0.0").OnEventOrdered("server.*",
+		func(birdactyl.Event) string { panic("boom") },
+		func(birdactyl.Event) birdactyl.EventResult { return birdactyl.Block("handled") })
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	res := tp.SendEvent("server.start", map[string]string{"server_id": "s1"}, true)
+	if res.Allowed() || res.Message() != "handled" {
+		t.Fatalf("event result = %v %q, want the handler's block", res.Allowed(), res.Message())
+	}
+}
+
+func TestPanickingMixinKeyFallsBackToDefaultLane(t *testing.T) {
+	p := birdactyl.New("ordering", "1.0.0").MixinOrdered("servers.create",
+		func(string, map[string]interface{}) string { panic("boom") },
+		func(c *birdactyl.MixinContext) birdactyl.MixinResult { return c.Error("handled") })
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	res := tp.RunMixin("servers.create", map[string]interface{}{"id": "s1"})
+	if res.Error != "handled" {
+		t.Fatalf("mixin error = %q, want the handler's error", res.Error)
+	}
+}
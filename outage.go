@@ -0,0 +1,194 @@
+package birdactyl
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultOutageBuffer = 1000
+	maxPendingEventSeqs = 4096
+)
+
+var bufferedMethods = map[string]bool{
+	"Log":            true,
+	"WriteAudit":     true,
+	"BroadcastEvent": true,
+	"ReportError":    true,
+}
+
+func WithOutageBuffer(size int) Option {
+	return func(p *Plugin) {
+		p.outbox.max = size
+	}
+}
+
+func (p *Plugin) OnBufferOverflow(fn func(method string)) *Plugin {
+	p.outbox.mu.Lock()
+	p.outbox.overflow = fn
+	p.outbox.mu.Unlock()
+	return p
+}
+
+func (p *Plugin) BufferedCalls() int {
+	p.outbox.mu.Lock()
+	defer p.outbox.mu.Unlock()
+	return len(p.outbox.calls)
+}
+
+type bufferedCall struct {
+	method string
+	md     metadata.MD
+	args   proto.Message
+}
+
+type outbox struct {
+	mu       sync.Mutex
+	max      int
+	calls    []bufferedCall
+	flushing bool
+	overflow func(string)
+}
+
+func (o *outbox) push(ctx context.Context, method string, args any) bool {
+	return o.pushLimit(ctx, method, args, o.max)
+}
+
+func (o *outbox) pushLimit(ctx context.Context, method string, args any, limit int) bool {
+	msg, ok := args.(proto.Message)
+	if !ok {
+		return false
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	o.mu.Lock()
+	if len(o.calls) >= limit {
+		fn := o.overflow
+		o.mu.Unlock()
+		if fn != nil {
+			fn(method[strings.LastIndex(method, "/")+1:])
+		}
+		return false
+	}
+	o.calls = append(o.calls, bufferedCall{method: method, md: md.Copy(), args: proto.Clone(msg)})
+	o.mu.Unlock()
+	return true
+}
+
+type outboxConn struct {
+	base   grpc.ClientConnInterface
+	plugin *Plugin
+}
+
+func (c outboxConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	p := c.plugin
+	if !bufferedMethods[method[strings.LastIndex(method, "/")+1:]] || p.outbox.max <= 0 {
+		return c.base.Invoke(ctx, method, args, reply, opts...)
+	}
+	if p.ConnectionState() != StateConnected || p.BufferedCalls() > 0 {
+		p.outbox.push(ctx, method, args)
+		return nil
+	}
+	err := c.base.Invoke(ctx, method, args, reply, opts...)
+	if status.Code(err) == codes.Unavailable {
+		p.outbox.push(ctx, method, args)
+		return nil
+	}
+	return err
+}
+
+func (c outboxConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.base.NewStream(ctx, desc, method, opts...)
+}
+
+func (p *Plugin) flushOutbox(conn grpc.ClientConnInterface) {
+	if flushed := p.drainOutbox(conn); flushed > 0 {
+		p.printf(LevelInfo, "flushed %d buffered calls", flushed)
+	}
+}
+
+func (p *Plugin) drainOutbox(conn grpc.ClientConnInterface) int {
+	o := &p.outbox
+	o.mu.Lock()
+	if o.flushing {
+		o.mu.Unlock()
+		return 0
+	}
+	o.flushing = true
+	o.mu.Unlock()
+
+	flushed := 0
+	for {
+		o.mu.Lock()
+		if len(o.calls) == 0 || p.ConnectionState() != StateConnected {
+			o.flushing = false
+			o.mu.Unlock()
+			return flushed
+		}
+		call := o.calls[0]
+		o.mu.Unlock()
+
+		ctx := metadata.NewOutgoingContext(context.Background(), call.md)
+		err := conn.Invoke(ctx, call.method, call.args, new(pb.Empty))
+		if status.Code(err) == codes.Unavailable {
+			o.mu.Lock()
+			o.flushing = false
+			o.mu.Unlock()
+			return flushed
+		}
+		if err != nil {
+			p.printf(LevelWarn, "dropping buffered %s: %v", call.method, err)
+		}
+		o.mu.Lock()
+		o.calls = o.calls[1:]
+		o.mu.Unlock()
+		flushed++
+	}
+}
+
+type eventSeq struct {
+	mu    sync.Mutex
+	acked uint64
+	done  map[uint64]bool
+}
+
+func (s *eventSeq) seen(seq uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return seq <= s.acked || s.done[seq]
+}
+
+func (s *eventSeq) ack(seq uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if seq <= s.acked {
+		return
+	}
+	if s.done == nil {
+		s.done = make(map[uint64]bool)
+	}
+	s.done[seq] = true
+	for s.done[s.acked+1] {
+		delete(s.done, s.acked+1)
+		s.acked++
+	}
+	if len(s.done) > maxPendingEventSeqs {
+		for n := range s.done {
+			s.acked = max(s.acked, n)
+		}
+		s.done = nil
+	}
+}
+
+func (s *eventSeq) resume() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.acked
+}
@@ -0,0 +1,211 @@
+package birdactyl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+)
+
+type urgentKey struct{}
+
+func Urgent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, urgentKey{}, true)
+}
+
+func isUrgent(ctx context.Context) bool {
+	v, _ := ctx.Value(urgentKey{}).(bool)
+	return v
+}
+
+type OutboundMetrics struct {
+	Throttled       uint64
+	ThrottleWait    time.Duration
+	CircuitState    string
+	CircuitOpens    uint64
+	CircuitRejected uint64
+}
+
+type outboundGuard struct {
+	mu      sync.Mutex
+	global  *routeLimiter
+	methods map[string]*routeLimiter
+
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	state     string
+	openUntil time.Time
+	probing   bool
+
+	throttled    atomic.Uint64
+	throttleWait atomic.Int64
+	opens        atomic.Uint64
+	rejected     atomic.Uint64
+}
+
+func newLimiter(rps float64, burst int) *routeLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &routeLimiter{rate: rps, burst: float64(burst), buckets: make(map[string]*bucket)}
+}
+
+func (a *API) WithRateLimit(rps float64, burst int) *API {
+	g := a.opts.guard
+	g.mu.Lock()
+	g.global = nil
+	if rps > 0 {
+		g.global = newLimiter(rps, burst)
+	}
+	g.mu.Unlock()
+	return a
+}
+
+func (a *API) WithMethodRateLimit(method string, rps float64, burst int) *API {
+	g := a.opts.guard
+	g.mu.Lock()
+	if g.methods == nil {
+		g.methods = make(map[string]*routeLimiter)
+	}
+	delete(g.methods, method)
+	if rps > 0 {
+		g.methods[method] = newLimiter(rps, burst)
+	}
+	g.mu.Unlock()
+	return a
+}
+
+func (a *API) WithCircuitBreaker(threshold int, cooldown time.Duration) *API {
+	g := a.opts.guard
+	g.mu.Lock()
+	g.threshold, g.cooldown = threshold, cooldown
+	g.failures, g.state, g.probing = 0, CircuitClosed, false
+	g.mu.Unlock()
+	return a
+}
+
+func (g *outboundGuard) wait(ctx context.Context, clock Clock, method string) error {
+	name := method[strings.LastIndex(method, "/")+1:]
+	g.mu.Lock()
+	limiters := make([]*routeLimiter, 0, 2)
+	if l := g.methods[name]; l != nil {
+		limiters = append(limiters, l)
+	}
+	if g.global != nil {
+		limiters = append(limiters, g.global)
+	}
+	g.mu.Unlock()
+
+	for _, l := range limiters {
+		for {
+			d, ok := l.take("", clock.Now())
+			if ok {
+				break
+			}
+			g.throttled.Add(1)
+			g.throttleWait.Add(int64(d))
+			t := clock.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return ctx.Err()
+			case <-t.C():
+			}
+		}
+	}
+	return nil
+}
+
+func (g *outboundGuard) allow(now time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.threshold <= 0 {
+		return true
+	}
+	switch g.state {
+	case CircuitOpen:
+		if now.Before(g.openUntil) {
+			g.rejected.Add(1)
+			return false
+		}
+		g.state = CircuitHalfOpen
+		fallthrough
+	case CircuitHalfOpen:
+		if g.probing {
+			g.rejected.Add(1)
+			return false
+		}
+		g.probing = true
+	}
+	return true
+}
+
+func (g *outboundGuard) release() {
+	g.mu.Lock()
+	g.probing = false
+	g.mu.Unlock()
+}
+
+func (g *outboundGuard) record(now time.Time, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.threshold <= 0 {
+		return
+	}
+	g.probing = false
+	if !tripsCircuit(err) {
+		g.failures = 0
+		g.state = CircuitClosed
+		return
+	}
+	g.failures++
+	if g.state == CircuitHalfOpen || g.failures >= g.threshold {
+		if g.state != CircuitOpen {
+			g.opens.Add(1)
+		}
+		g.state = CircuitOpen
+		g.openUntil = now.Add(g.cooldown)
+	}
+}
+
+func tripsCircuit(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrUnavailable) || errors.Is(err, ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (g *outboundGuard) metrics() OutboundMetrics {
+	g.mu.Lock()
+	state := g.state
+	g.mu.Unlock()
+	if state == "" {
+		state = CircuitClosed
+	}
+	return OutboundMetrics{
+		Throttled:       g.throttled.Load(),
+		ThrottleWait:    time.Duration(g.throttleWait.Load()),
+		CircuitState:    state,
+		CircuitOpens:    g.opens.Load(),
+		CircuitRejected: g.rejected.Load(),
+	}
+}
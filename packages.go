@@ -0,0 +1,251 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type PackageVariable struct {
+	Name         string
+	EnvKey       string
+	Description  string
+	Default      string
+	Rules        string
+	UserEditable bool
+	UserViewable bool
+}
+
+type PackageGroup struct {
+	Name     string
+	Packages []Package
+}
+
+func packageFromProto(p *pb.Package) *Package {
+	out := &Package{
+		ID:             p.Id,
+		Name:           p.Name,
+		Description:    p.Description,
+		DockerImage:    p.DockerImage,
+		StartupCommand: p.StartupCommand,
+		StopCommand:    p.StopCommand,
+		ConfigFiles:    p.ConfigFiles,
+		Memory:         p.DefaultMemory,
+		CPU:            p.DefaultCpu,
+		Disk:           p.DefaultDisk,
+		IsPublic:       p.IsPublic,
+		DockerImages:   p.DockerImages,
+		Group:          p.Group,
+	}
+	for _, v := range p.Variables {
+		out.Variables = append(out.Variables, PackageVariable{
+			Name:         v.Name,
+			EnvKey:       v.EnvKey,
+			Description:  v.Description,
+			Default:      v.DefaultValue,
+			Rules:        v.Rules,
+			UserEditable: v.UserEditable,
+			UserViewable: v.UserViewable,
+		})
+	}
+	return out
+}
+
+func (a *API) Packages(ctx context.Context) ([]Package, error) {
+	r, err := a.panel.ListPackages(a.outgoing(ctx), &pb.Empty{})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]Package, len(r.Packages))
+	for i, p := range r.Packages {
+		out[i] = *packageFromProto(p)
+	}
+	return out, nil
+}
+
+func (a *API) Package(ctx context.Context, id string) (*Package, error) {
+	r, err := a.panel.GetPackage(a.outgoing(ctx), &pb.IDRequest{Id: id})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	return packageFromProto(r), nil
+}
+
+func (a *API) PackageGroups(ctx context.Context) ([]PackageGroup, error) {
+	packages, err := a.Packages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return GroupPackages(packages), nil
+}
+
+func GroupPackages(packages []Package) []PackageGroup {
+	index := make(map[string]int)
+	var groups []PackageGroup
+	for _, p := range packages {
+		i, ok := index[p.Group]
+		if !ok {
+			i = len(groups)
+			index[p.Group] = i
+			groups = append(groups, PackageGroup{Name: p.Group})
+		}
+		groups[i].Packages = append(groups[i].Packages, p)
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups
+}
+
+func (p *Package) Variable(envKey string) (PackageVariable, bool) {
+	for _, v := range p.Variables {
+		if v.EnvKey == envKey {
+			return v, true
+		}
+	}
+	return PackageVariable{}, false
+}
+
+func (s ServerSpec) ValidateFor(pkg *Package) error {
+	var v []FieldViolation
+	if err := s.validate(); err != nil {
+		v = append(v, err.(*SpecError).Violations...)
+	}
+	if s.PackageID != "" && s.PackageID != pkg.ID {
+		v = append(v, FieldViolation{Field: "package_id", Description: fmt.Sprintf("does not match package %q", pkg.ID)})
+	}
+	if s.Image != "" && len(pkg.DockerImages) > 0 && !slices.Contains(pkg.DockerImages, s.Image) {
+		v = append(v, FieldViolation{Field: "image", Description: fmt.Sprintf("is not offered by package %q", pkg.ID)})
+	}
+	for _, key := range sortedKeys(s.Variables) {
+		pv, ok := pkg.Variable(key)
+		switch {
+		case !ok:
+			v = append(v, FieldViolation{Field: "variables." + key, Description: fmt.Sprintf("is not defined by package %q", pkg.ID)})
+		case !pv.UserEditable && s.Variables[key] != pv.Default:
+			v = append(v, FieldViolation{Field: "variables." + key, Description: "is not user editable"})
+		}
+	}
+	for _, pv := range pkg.Variables {
+		value, ok := s.Variables[pv.EnvKey]
+		if !ok {
+			value = pv.Default
+		}
+		if err := pv.Validate(value); err != nil {
+			v = append(v, FieldViolation{Field: "variables." + pv.EnvKey, Description: err.Error()})
+		}
+	}
+	if len(v) > 0 {
+		return &SpecError{Violations: v}
+	}
+	return nil
+}
+
+func (v PackageVariable) Validate(value string) error {
+	rules := strings.Split(v.Rules, "|")
+	numeric := false
+	for _, r := range rules {
+		switch strings.TrimSpace(r) {
+		case "nullable":
+			if value == "" {
+				return nil
+			}
+		case "integer", "int", "numeric":
+			numeric = true
+		}
+	}
+	for _, r := range rules {
+		name, arg, _ := strings.Cut(strings.TrimSpace(r), ":")
+		if err := checkRule(name, arg, value, numeric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkRule(name, arg, value string, numeric bool) error {
+	switch name {
+	case "required":
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("is required")
+		}
+	case "integer", "int":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil && value != "" {
+			return fmt.Errorf("must be an integer")
+		}
+	case "numeric":
+		if _, err := strconv.ParseFloat(value, 64); err != nil && value != "" {
+			return fmt.Errorf("must be numeric")
+		}
+	case "boolean", "bool":
+		switch value {
+		case "", "true", "false", "1", "0":
+		default:
+			return fmt.Errorf("must be a boolean")
+		}
+	case "in":
+		options := strings.Split(arg, ",")
+		if value != "" && !slices.Contains(options, value) {
+			return fmt.Errorf("must be one of %s", strings.Join(options, ", "))
+		}
+	case "min", "max", "between":
+		lo, hi, ok := ruleBounds(name, arg)
+		if !ok || value == "" {
+			return nil
+		}
+		size, unit := float64(utf8.RuneCountInString(value)), " characters"
+		if numeric {
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil
+			}
+			size, unit = n, ""
+		}
+		if size < lo {
+			return fmt.Errorf("must be at least %s%s", strconv.FormatFloat(lo, 'f', -1, 64), unit)
+		}
+		if size > hi {
+			return fmt.Errorf("must be at most %s%s", strconv.FormatFloat(hi, 'f', -1, 64), unit)
+		}
+	case "regex":
+		pattern := arg
+		if len(pattern) >= 2 && pattern[0] == '/' {
+			if end := strings.LastIndexByte(pattern, '/'); end > 0 {
+				pattern = pattern[1:end]
+			}
+		}
+		re, err := regexp.Compile(pattern)
+		if err == nil && value != "" && !re.MatchString(value) {
+			return fmt.Errorf("must match %s", arg)
+		}
+	}
+	return nil
+}
+
+func ruleBounds(name, arg string) (lo, hi float64, ok bool) {
+	parse := func(s string) (float64, bool) {
+		n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		return n, err == nil
+	}
+	switch name {
+	case "min":
+		lo, ok = parse(arg)
+		return lo, math.MaxFloat64, ok
+	case "max":
+		hi, ok = parse(arg)
+		return -math.MaxFloat64, hi, ok
+	}
+	a, b, found := strings.Cut(arg, ",")
+	if !found {
+		return 0, 0, false
+	}
+	lo, okLo := parse(a)
+	hi, okHi := parse(b)
+	return lo, hi, okLo && okHi
+}
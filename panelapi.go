@@ -0,0 +1,141 @@
+package birdactyl
+
+import (
+	"context"
+	"time"
+)
+
+type ServerAPI interface {
+	Server(ctx context.Context, id string) (*Server, error)
+	Servers(ctx context.Context, filter ServerFilter) ([]Server, error)
+	SearchServers(ctx context.Context, query string) ([]Server, error)
+	CreateServerContext(ctx context.Context, spec ServerSpec) (*Server, error)
+	DeleteServerContext(ctx context.Context, id string, force bool) error
+	SuspendServerContext(ctx context.Context, id string) error
+	UnsuspendServerContext(ctx context.Context, id string) error
+	PowerAction(ctx context.Context, serverID string, action PowerAction) error
+	SendCommandContext(ctx context.Context, serverID, command string) error
+	StreamConsoleContext(ctx context.Context, serverID string) (<-chan ConsoleLine, error)
+	StreamStatus(ctx context.Context, serverID string) (<-chan StatusChange, error)
+}
+
+type FileAPI interface {
+	ReadFileContext(ctx context.Context, serverID, filePath string) ([]byte, error)
+	WriteFileContext(ctx context.Context, serverID, filePath string, data []byte) error
+	ListDir(ctx context.Context, serverID, dirPath string) ([]FileEntry, error)
+	DeleteFileContext(ctx context.Context, serverID, filePath string) error
+}
+
+type UserAPI interface {
+	User(ctx context.Context, id string) (*User, error)
+	Users(ctx context.Context, filter UserFilter) (UserPage, error)
+	UserServers(ctx context.Context, userID string) ([]Server, error)
+}
+
+type NodeAPI interface {
+	Nodes(ctx context.Context) ([]Node, error)
+	NodeStats(ctx context.Context, nodeID string) (*NodeStats, error)
+	WatchNodeStats(ctx context.Context, interval time.Duration) (<-chan []NodeStats, error)
+}
+
+type AllocationAPI interface {
+	Allocations(ctx context.Context, nodeID string, filter AllocFilter) ([]Allocation, error)
+	CreateAllocation(ctx context.Context, nodeID, ip string, ports []int) error
+	AssignAllocation(ctx context.Context, serverID, allocationID string) error
+	ReleaseAllocation(ctx context.Context, allocationID string) error
+	FindFreeAllocation(ctx context.Context, nodeID string, ports PortRange) (*Allocation, error)
+}
+
+type PackageAPI interface {
+	Packages(ctx context.Context) ([]Package, error)
+	Package(ctx context.Context, id string) (*Package, error)
+	PackageGroups(ctx context.Context) ([]PackageGroup, error)
+}
+
+type DatabaseAPI interface {
+	Databases(ctx context.Context, serverID string) ([]Database, error)
+	CreateDatabaseContext(ctx context.Context, serverID, name, allowedHost string) (*DatabaseCredentials, error)
+	RotateDatabasePasswordContext(ctx context.Context, databaseID string) (*DatabaseCredentials, error)
+	DeleteDatabaseContext(ctx context.Context, databaseID string) error
+}
+
+type ServerScheduleAPI interface {
+	ServerSchedules(ctx context.Context, serverID string) ([]ServerSchedule, error)
+	CreateServerSchedule(ctx context.Context, serverID string, spec ScheduleSpec) (*ServerSchedule, error)
+	DeleteServerSchedule(ctx context.Context, scheduleID string) error
+	AddScheduleTask(ctx context.Context, scheduleID string, task TaskSpec) (*ScheduleTask, error)
+	DeleteScheduleTask(ctx context.Context, taskID string) error
+}
+
+type SubuserAPI interface {
+	Subusers(ctx context.Context, serverID string) ([]Subuser, error)
+	AddSubuserContext(ctx context.Context, serverID, email string, permissions []string) (*Subuser, error)
+	UpdateSubuserPermissions(ctx context.Context, serverID, subuserID string, permissions []string) error
+	RemoveSubuserContext(ctx context.Context, serverID, subuserID string) error
+	SubuserPermissionInfo(ctx context.Context) ([]SubuserPermissionInfo, error)
+	ValidateSubuserPermissions(ctx context.Context, permissions []string) error
+}
+
+type BackupAPI interface {
+	Backups(ctx context.Context, serverID string) ([]Backup, error)
+	Backup(ctx context.Context, serverID, backupID string) (*Backup, error)
+	CreateBackupContext(ctx context.Context, serverID, name string) (*Backup, error)
+	DeleteBackupContext(ctx context.Context, serverID, backupID string) error
+	RestoreBackup(ctx context.Context, serverID, backupID string, truncate bool) error
+}
+
+type MessagingAPI interface {
+	NotifyUser(ctx context.Context, userID string, n Notification) error
+	NotifyAdmins(ctx context.Context, n Notification) error
+	SendEmail(ctx context.Context, userID, subject, htmlBody string) error
+	Audit(ctx context.Context, entry AuditEntry) error
+	AuditBatch(ctx context.Context, entries []AuditEntry) error
+}
+
+type PluginsAPI interface {
+	CallPluginRoute(ctx context.Context, pluginID, method, path string, body []byte) (*PluginCallResponse, error)
+	Plugins(ctx context.Context) ([]PluginSummary, error)
+}
+
+type ServerSettingsAPI interface {
+	ServerSettings(ctx context.Context, serverID string) (map[string]string, error)
+	SetServerSetting(ctx context.Context, serverID, key, value string) error
+	SetServerSettings(ctx context.Context, serverID string, values map[string]string) error
+	DeleteServerSettings(ctx context.Context, serverID string, keys ...string) error
+	WatchServerSettings(ctx context.Context, serverID string) (<-chan ServerSettingChange, error)
+}
+
+type PanelAPI interface {
+	ServerAPI
+	FileAPI
+	UserAPI
+	NodeAPI
+	AllocationAPI
+	PackageAPI
+	DatabaseAPI
+	BackupAPI
+	ServerScheduleAPI
+	SubuserAPI
+	MessagingAPI
+	PluginsAPI
+	ServerSettingsAPI
+	KV() KV
+}
+
+var _ PanelAPI = (*API)(nil)
+
+func WithPanelAPI(api PanelAPI) Option {
+	return func(p *Plugin) {
+		p.panelAPI = api
+	}
+}
+
+func (p *Plugin) PanelAPI() PanelAPI {
+	if p.panelAPI != nil {
+		return p.panelAPI
+	}
+	if p.api == nil {
+		return nil
+	}
+	return p.api
+}
@@ -0,0 +1,92 @@
+package birdactyl
+
+import (
+	"maps"
+	"strings"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type PanelInfo struct {
+	AppURL        string
+	Version       string
+	DefaultLocale string
+	Timezone      string
+	Features      map[string]bool
+}
+
+func (i PanelInfo) Feature(name string) bool {
+	return i.Features[name]
+}
+
+func (i PanelInfo) Location() *time.Location {
+	if i.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(i.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (i PanelInfo) URL(path string) string {
+	if i.AppURL == "" {
+		return path
+	}
+	return strings.TrimSuffix(i.AppURL, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+func (i PanelInfo) equal(o PanelInfo) bool {
+	return i.AppURL == o.AppURL && i.Version == o.Version && i.DefaultLocale == o.DefaultLocale &&
+		i.Timezone == o.Timezone && maps.Equal(i.Features, o.Features)
+}
+
+func (p *Plugin) PanelInfo() PanelInfo {
+	p.panelMu.RLock()
+	defer p.panelMu.RUnlock()
+	info := p.panelInfo
+	info.Features = maps.Clone(info.Features)
+	return info
+}
+
+func (p *Plugin) OnPanelInfoChange(fn func(old, new PanelInfo)) *Plugin {
+	p.regMu.Lock()
+	p.onPanelInfo = append(p.onPanelInfo, fn)
+	p.regMu.Unlock()
+	return p
+}
+
+func panelInfoFrom(reg *pb.Registered) PanelInfo {
+	env := reg.GetEnvironment()
+	features := env.GetFeatures()
+	if features == nil {
+		features = map[string]bool{}
+	}
+	return PanelInfo{
+		AppURL:        env.GetAppUrl(),
+		Version:       reg.PanelVersion,
+		DefaultLocale: env.GetDefaultLocale(),
+		Timezone:      env.GetTimezone(),
+		Features:      maps.Clone(features),
+	}
+}
+
+func (p *Plugin) setPanelEnvironment(reg *pb.Registered) {
+	info := panelInfoFrom(reg)
+	p.panelMu.Lock()
+	old, known := p.panelInfo, p.panelInfoSet
+	p.panelInfo, p.panelInfoSet = info, true
+	p.panelMu.Unlock()
+	if !known || old.equal(info) {
+		return
+	}
+	p.regMu.RLock()
+	handlers := p.onPanelInfo
+	p.regMu.RUnlock()
+	for _, fn := range handlers {
+		fn := fn
+		p.lifecycle.post(func() { fn(old, info) })
+	}
+}
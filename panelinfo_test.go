@@ -0,0 +1,52 @@
+package birdactyl_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestOnPanelInfoChangeDuringReconnect(t *testing.T) {
+	p := birdactyl.New("panelinfo", "1.0.0")
+	changed := make(chan birdactyl.PanelInfo, 1)
+	p.OnPanelInfoChange(func(_, info birdactyl.PanelInfo) {
+		select {
+		case changed <- info:
+		default:
+		}
+	})
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				p.OnPanelInfoChange(func(_, _ birdactyl.PanelInfo) {})
+				time.Sleep(100 * time.Microsecond)
+			}
+		}
+	}()
+	tp.SetPanelInfo(birdactyl.PanelInfo{AppURL: "https://panel.example", Timezone: "UTC"})
+	tp.Disconnect()
+	close(stop)
+	wg.Wait()
+
+	select {
+	case info := <-changed:
+		if info.AppURL != "https://panel.example" {
+			t.Fatalf("AppURL = %q, want https://panel.example", info.AppURL)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnPanelInfoChange was not called after reconnect")
+	}
+}
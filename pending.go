@@ -0,0 +1,180 @@
+package birdactyl
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultMaxPending     = 1024
+	DefaultPendingTimeout = time.Minute
+)
+
+type pendingCall struct {
+	ch       chan *pb.PanelMessage
+	err      error
+	created  time.Time
+	deferred *deferredMixin
+}
+
+type streamConn struct {
+	p *Plugin
+}
+
+func (c streamConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	p := c.p
+	stream, conn := p.connSnapshot()
+	if stream == nil {
+		return conn.Invoke(ctx, method, args, reply, opts...)
+	}
+	payload, err := proto.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	id, call, err := p.addPending()
+	if err != nil {
+		return err
+	}
+	defer p.removePending(id)
+
+	apiCall := &pb.ApiCall{Method: method, Payload: payload}
+	if err := p.send(&pb.PluginMessage{RequestId: id, Payload: &pb.PluginMessage_ApiCall{ApiCall: apiCall}}); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case msg, ok := <-call.ch:
+		if !ok {
+			return call.err
+		}
+		return decodeAPIResult(msg.GetApiResult(), reply.(proto.Message))
+	}
+}
+
+func decodeAPIResult(res *pb.ApiResult, reply proto.Message) error {
+	if len(res.GetStatus()) > 0 {
+		st := &spb.Status{}
+		if err := proto.Unmarshal(res.Status, st); err != nil {
+			return err
+		}
+		if err := status.ErrorProto(st); err != nil {
+			return err
+		}
+	}
+	return proto.Unmarshal(res.GetPayload(), reply)
+}
+
+func (c streamConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	_, conn := c.p.connSnapshot()
+	return conn.NewStream(ctx, desc, method, opts...)
+}
+
+func (p *Plugin) connSnapshot() (pb.PanelService_ConnectClient, grpc.ClientConnInterface) {
+	p.sendMu.Lock()
+	defer p.sendMu.Unlock()
+	return p.stream, p.conn
+}
+
+func (p *Plugin) addPending() (string, *pendingCall, error) {
+	p.pendingMu.Lock()
+	defer p.pendingMu.Unlock()
+	if p.maxPending > 0 && len(p.pending) >= p.maxPending {
+		return "", nil, ErrTooManyPending
+	}
+	id := "call-" + strconv.FormatUint(p.pendingSeq.Add(1), 10)
+	call := &pendingCall{ch: make(chan *pb.PanelMessage, 1), err: ErrTimeout, created: p.clock().Now()}
+	p.pending[id] = call
+	return id, call, nil
+}
+
+func (p *Plugin) removePending(id string) {
+	p.pendingMu.Lock()
+	delete(p.pending, id)
+	p.pendingMu.Unlock()
+}
+
+func (p *Plugin) resolvePending(msg *pb.PanelMessage) {
+	p.pendingMu.RLock()
+	defer p.pendingMu.RUnlock()
+	call, ok := p.pending[msg.RequestId]
+	if !ok {
+		p.printf(LevelDebug, "dropping late api result %s", msg.RequestId)
+		return
+	}
+	select {
+	case call.ch <- msg:
+	default:
+	}
+}
+
+func (p *Plugin) pendingTimeout() time.Duration {
+	if p.pendingTTL > 0 {
+		return p.pendingTTL
+	}
+	return DefaultPendingTimeout
+}
+
+func (p *Plugin) expirePending(ctx context.Context) {
+	ttl := p.pendingTimeout()
+	interval := ttl / 2
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+	ticker := p.clock().NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C():
+			p.sweepPending(now.Add(-ttl))
+		}
+	}
+}
+
+func (p *Plugin) sweepPending(cutoff time.Time) {
+	p.pendingMu.Lock()
+	defer p.pendingMu.Unlock()
+	for id, call := range p.pending {
+		if call.deferred == nil && call.created.Before(cutoff) {
+			delete(p.pending, id)
+			close(call.ch)
+		}
+	}
+}
+
+func (p *Plugin) failPending(err error) {
+	p.pendingMu.Lock()
+	defer p.pendingMu.Unlock()
+	for id, call := range p.pending {
+		if call.deferred == nil {
+			delete(p.pending, id)
+			call.err = err
+			close(call.ch)
+		}
+	}
+}
+
+func (p *Plugin) streamClosed(stream pb.PanelService_ConnectClient) {
+	p.sendMu.Lock()
+	if p.stream == stream {
+		p.stream = nil
+	}
+	p.sendMu.Unlock()
+	p.failPending(ErrUnavailable)
+}
+
+func (p *Plugin) PendingCalls() int {
+	p.pendingMu.RLock()
+	defer p.pendingMu.RUnlock()
+	return len(p.pending)
+}
@@ -0,0 +1,45 @@
+package birdactyl_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestPendingCallsFailOnDisconnect(t *testing.T) {
+	tp := birdactyltest.NewPanel(t)
+	p := birdactyl.New("pending", "1.0.0")
+	tp.StartPlugin(p)
+	tp.HoldAPICalls(true)
+
+	future := p.Async().GetUser("u1")
+	deadline := time.Now().Add(5 * time.Second)
+	for tp.HeldAPICalls() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("api call never reached the panel")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := future.Get()
+		result <- err
+	}()
+	tp.Disconnect()
+
+	select {
+	case err := <-result:
+		if !errors.Is(err, birdactyl.ErrUnavailable) {
+			t.Fatalf("in-flight call err = %v, want ErrUnavailable", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("in-flight call was not failed on disconnect")
+	}
+	if n := p.PendingCalls(); n != 0 {
+		t.Fatalf("PendingCalls() = %d after disconnect, want 0", n)
+	}
+}
@@ -0,0 +1,269 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type Permission string
+
+const (
+	PermServersRead       Permission = "servers.read"
+	PermServersWrite      Permission = "servers.write"
+	PermServersControl    Permission = "servers.control"
+	PermFilesRead         Permission = "files.read"
+	PermFilesWrite        Permission = "files.write"
+	PermUsersRead         Permission = "users.read"
+	PermUsersWrite        Permission = "users.write"
+	PermDatabasesRead     Permission = "databases.read"
+	PermDatabasesWrite    Permission = "databases.write"
+	PermBackupsRead       Permission = "backups.read"
+	PermBackupsWrite      Permission = "backups.write"
+	PermNodesRead         Permission = "nodes.read"
+	PermNodesWrite        Permission = "nodes.write"
+	PermPackagesRead      Permission = "packages.read"
+	PermPackagesWrite     Permission = "packages.write"
+	PermSecurityRead      Permission = "security.read"
+	PermSecurityWrite     Permission = "security.write"
+	PermSettingsRead      Permission = "settings.read"
+	PermSettingsWrite     Permission = "settings.write"
+	PermActivityRead      Permission = "activity.read"
+	PermAuditWrite        Permission = "audit.write"
+	PermDBQuery           Permission = "db.query"
+	PermEventsBroadcast   Permission = "events.broadcast"
+	PermNotificationsSend Permission = "notifications.send"
+	PermEmailSend         Permission = "email.send"
+	PermHTTPRequest       Permission = "http.request"
+	PermPluginsRead       Permission = "plugins.read"
+	PermPluginsCall       Permission = "plugins.call"
+)
+
+var methodPermissions = map[string]Permission{
+	"GetServer":                PermServersRead,
+	"ListServers":              PermServersRead,
+	"GetConsoleLog":            PermServersRead,
+	"StreamConsole":            PermServersRead,
+	"StreamStatus":             PermServersRead,
+	"GetFullLog":               PermServersRead,
+	"SearchLogs":               PermServersRead,
+	"ListLogFiles":             PermServersRead,
+	"ReadLogFile":              PermServersRead,
+	"GetServerStats":           PermServersRead,
+	"CreateServer":             PermServersWrite,
+	"DeleteServer":             PermServersWrite,
+	"UpdateServer":             PermServersWrite,
+	"SuspendServer":            PermServersWrite,
+	"UnsuspendServer":          PermServersWrite,
+	"TransferServer":           PermServersWrite,
+	"AddAllocation":            PermServersWrite,
+	"DeleteAllocation":         PermServersWrite,
+	"SetPrimaryAllocation":     PermServersWrite,
+	"AssignAllocation":         PermServersWrite,
+	"ReleaseAllocation":        PermServersWrite,
+	"ReserveAllocation":        PermServersWrite,
+	"ListAllocations":          PermNodesRead,
+	"ListServerSchedules":      PermServersRead,
+	"CreateServerSchedule":     PermServersWrite,
+	"DeleteServerSchedule":     PermServersWrite,
+	"AddScheduleTask":          PermServersWrite,
+	"DeleteScheduleTask":       PermServersWrite,
+	"CreateAllocations":        PermNodesWrite,
+	"UpdateServerVariables":    PermServersWrite,
+	"StartServer":              PermServersControl,
+	"StopServer":               PermServersControl,
+	"RestartServer":            PermServersControl,
+	"KillServer":               PermServersControl,
+	"ReinstallServer":          PermServersControl,
+	"SendCommand":              PermServersControl,
+	"ListFiles":                PermFilesRead,
+	"ReadFile":                 PermFilesRead,
+	"WriteFile":                PermFilesWrite,
+	"DeleteFile":               PermFilesWrite,
+	"CreateFolder":             PermFilesWrite,
+	"MoveFile":                 PermFilesWrite,
+	"CopyFile":                 PermFilesWrite,
+	"CompressFiles":            PermFilesWrite,
+	"DecompressFile":           PermFilesWrite,
+	"GetUser":                  PermUsersRead,
+	"GetUserByEmail":           PermUsersRead,
+	"GetUserByUsername":        PermUsersRead,
+	"ListUsers":                PermUsersRead,
+	"ListSubusers":             PermUsersRead,
+	"CreateUser":               PermUsersWrite,
+	"DeleteUser":               PermUsersWrite,
+	"UpdateUser":               PermUsersWrite,
+	"BanUser":                  PermUsersWrite,
+	"UnbanUser":                PermUsersWrite,
+	"SetAdmin":                 PermUsersWrite,
+	"RevokeAdmin":              PermUsersWrite,
+	"SetUserResources":         PermUsersWrite,
+	"ForcePasswordReset":       PermUsersWrite,
+	"AddSubuser":               PermUsersWrite,
+	"UpdateSubuser":            PermUsersWrite,
+	"RemoveSubuser":            PermUsersWrite,
+	"ListSubuserPermissions":   PermUsersRead,
+	"ListDatabases":            PermDatabasesRead,
+	"ListDatabaseHosts":        PermDatabasesRead,
+	"CreateDatabase":           PermDatabasesWrite,
+	"DeleteDatabase":           PermDatabasesWrite,
+	"RotateDatabasePassword":   PermDatabasesWrite,
+	"CreateDatabaseHost":       PermDatabasesWrite,
+	"UpdateDatabaseHost":       PermDatabasesWrite,
+	"DeleteDatabaseHost":       PermDatabasesWrite,
+	"ListBackups":              PermBackupsRead,
+	"GetBackup":                PermBackupsRead,
+	"CreateBackup":             PermBackupsWrite,
+	"DeleteBackup":             PermBackupsWrite,
+	"RestoreBackup":            PermBackupsWrite,
+	"ListNodes":                PermNodesRead,
+	"GetNode":                  PermNodesRead,
+	"GetNodeStats":             PermNodesRead,
+	"ListNodeStats":            PermNodesRead,
+	"CreateNode":               PermNodesWrite,
+	"DeleteNode":               PermNodesWrite,
+	"ResetNodeToken":           PermNodesWrite,
+	"ListPackages":             PermPackagesRead,
+	"GetPackage":               PermPackagesRead,
+	"CreatePackage":            PermPackagesWrite,
+	"UpdatePackage":            PermPackagesWrite,
+	"DeletePackage":            PermPackagesWrite,
+	"ListIPBans":               PermSecurityRead,
+	"CreateIPBan":              PermSecurityWrite,
+	"DeleteIPBan":              PermSecurityWrite,
+	"GetSettings":              PermSettingsRead,
+	"SetRegistrationEnabled":   PermSettingsWrite,
+	"SetServerCreationEnabled": PermSettingsWrite,
+	"GetActivityLogs":          PermActivityRead,
+	"WriteAudit":               PermAuditWrite,
+	"QueryDB":                  PermDBQuery,
+	"BroadcastEvent":           PermEventsBroadcast,
+	"SendNotification":         PermNotificationsSend,
+	"SendEmail":                PermEmailSend,
+	"HTTPRequest":              PermHTTPRequest,
+	"ListPlugins":              PermPluginsRead,
+	"CallPlugin":               PermPluginsCall,
+	"CallPluginRoute":          PermPluginsCall,
+}
+
+type PermissionError struct {
+	Permission Permission
+	Method     string
+}
+
+func (e *PermissionError) Error() string {
+	return fmt.Sprintf("birdactyl: permission %q required for %s", e.Permission, e.Method)
+}
+
+func (e *PermissionError) Unwrap() error {
+	return ErrPermissionDenied
+}
+
+type permissionScope struct {
+	mu        sync.RWMutex
+	requested []Permission
+	enforced  bool
+	granted   map[Permission]bool
+	token     string
+}
+
+func (p *Plugin) RequestPermissions(perms ...Permission) *Plugin {
+	p.perms.requested = append(p.perms.requested, perms...)
+	return p
+}
+
+func (p *Plugin) GrantedPermissions() []Permission {
+	p.perms.mu.RLock()
+	defer p.perms.mu.RUnlock()
+	out := make([]Permission, 0, len(p.perms.granted))
+	for perm := range p.perms.granted {
+		out = append(out, perm)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (p *Plugin) HasPermission(perm Permission) bool {
+	p.perms.mu.RLock()
+	defer p.perms.mu.RUnlock()
+	return !p.perms.enforced || p.perms.granted[perm]
+}
+
+func (s *permissionScope) names() []string {
+	out := make([]string, len(s.requested))
+	for i, perm := range s.requested {
+		out[i] = string(perm)
+	}
+	return out
+}
+
+func (s *permissionScope) grant(g *pb.PermissionGrant) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enforced = g != nil && len(s.requested) > 0
+	s.granted = make(map[Permission]bool)
+	s.token = g.GetApiToken()
+	for _, perm := range g.GetGranted() {
+		s.granted[Permission(perm)] = true
+	}
+}
+
+type scopedConn struct {
+	base  grpc.ClientConnInterface
+	scope *permissionScope
+}
+
+func (c scopedConn) authorize(ctx context.Context, method string) (context.Context, error) {
+	name := method[strings.LastIndex(method, "/")+1:]
+	c.scope.mu.RLock()
+	enforced, token := c.scope.enforced, c.scope.token
+	perm, needed := methodPermissions[name]
+	allowed := !needed || c.scope.granted[perm]
+	c.scope.mu.RUnlock()
+	if enforced && !allowed {
+		return ctx, &PermissionError{Permission: perm, Method: name}
+	}
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-plugin-token", token)
+	}
+	return ctx, nil
+}
+
+func (c scopedConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	ctx, err := c.authorize(ctx, method)
+	if err != nil {
+		return err
+	}
+	return deniedPermission(c.base.Invoke(ctx, method, args, reply, opts...), method)
+}
+
+func (c scopedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, err := c.authorize(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.base.NewStream(ctx, desc, method, opts...)
+	return stream, deniedPermission(err, method)
+}
+
+func deniedPermission(err error, method string) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.PermissionDenied {
+		return err
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Metadata["permission"] != "" {
+			return &PermissionError{Permission: Permission(info.Metadata["permission"]), Method: method[strings.LastIndex(method, "/")+1:]}
+		}
+	}
+	return err
+}
@@ -3,51 +3,198 @@ package birdactyl
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type Plugin struct {
-	id          string
-	name        string
-	version     string
-	events      map[string]EventHandler
-	routes      map[string]*RouteConfig
-	schedule    map[string]ScheduleHandler
-	mixins      []MixinRegistration
-	addonTypes  map[string]AddonTypeHandler
-	panel       pb.PanelServiceClient
-	conn        *grpc.ClientConn
-	api         *API
-	asyncApi    *AsyncAPI
-	dataDir     string
-	useDataDir  bool
-	onStart     func()
-	pending     map[string]chan *pb.PanelMessage
-	pendingMu   sync.RWMutex
-	ui          *UIBuilder
+	id              string
+	instance        string
+	name            string
+	version         string
+	meta            pluginMeta
+	requiredVersion string
+	requiredCaps    []string
+	panelVersion    string
+	panelCaps       map[string]bool
+	panelProtocol   int
+	panelInfo       PanelInfo
+	panelInfoSet    bool
+	onPanelInfo     []func(old, new PanelInfo)
+	panelMu         sync.RWMutex
+	deps            []*pb.PluginDependency
+	depStatus       map[string]DependencyInfo
+	depsReported    bool
+	perms           permissionScope
+	outbox          outbox
+	eventSeq        eventSeq
+	events          map[string]EventHandler
+	eventOpts       map[string]EventOptions
+	orderings       []eventOrdering
+	routes          map[string]*RouteConfig
+	schedules       map[string]*ScheduleConfig
+	mixins          []MixinRegistration
+	addonTypes      map[string]AddonTypeHandler
+	addonInfo       map[string]*pb.AddonTypeInfo
+	panel           pb.PanelServiceClient
+	conn            grpc.ClientConnInterface
+	api             *API
+	asyncApi        *AsyncAPI
+	dataDir         string
+	useDataDir      bool
+	onStart         func(StartContext)
+	onStartError    []func(error)
+	config          interface{}
+	onStop          []func()
+	onConnect       []func()
+	onDisconnect    []func(error)
+	lifecycle       lifecycle
+	health          healthReporter
+	flags           *Flags
+	outbound        *outboundGuard
+	tracer          *tracer
+	validators      map[string]ValidatorFunc
+	jobs            *Jobs
+	jobsOnce        sync.Once
+	drainTimeout    time.Duration
+	pending         map[string]*pendingCall
+	pendingMu       sync.RWMutex
+	pendingSeq      atomic.Uint64
+	maxPending      int
+	pendingTTL      time.Duration
+	webhooks        *Webhooks
+	webhooksOnce    sync.Once
+	httpClient      *http.Client
+	httpClientOnce  sync.Once
+	egress          atomic.Pointer[pb.EgressPolicy]
+	regErrs         []error
+	wire            *wireLogger
+	panelAPI        PanelAPI
+	clk             Clock
+	metrics         *metrics
+	maxRecvMsg      int
+	maxSendMsg      int
+	compression     string
+	keepalive       keepalive.ClientParameters
+	heartbeat       time.Duration
+	staleAfter      time.Duration
+	state           atomic.Int32
+	stateMu         sync.Mutex
+	stateChanged    chan struct{}
+	lastContact     atomic.Int64
+	pingSupported   atomic.Bool
+	stale           atomic.Bool
+	started         bool
+	workers         int
+	queueSize       int
+	pool            *workerPool
+	poolMu          sync.Mutex
+	bodies          map[string]*bodyStream
+	bodiesMu        sync.Mutex
+	queueStats      queueStats
+	ui              *UIBuilder
+	logFile         *rotatingFile
+	reporter        errorReporter
+	stream          pb.PanelService_ConnectClient
+	streamGen       uint64
+	sendMu          sync.Mutex
+	regMu           sync.RWMutex
+	liveRoutes      map[string]*RouteConfig
+	regDirty        bool
+	dropStream      func()
+	sendFailures    atomic.Uint64
+	bgDropped       atomic.Uint64
+	uiPush          *routeLimiter
+	uiPushMax       int
+	mixinDeadline   time.Duration
+	onThrottled     []func(RouteThrottleInfo)
+	onAPIRequest    []RequestHook
+	onAPIResponse   []ResponseHook
+	onBundleUpload  []func(BundleUploadProgress)
+	throttled       atomic.Uint64
+	appliedRoutes   map[string]*pb.RateLimitConfig
+	noInfoRoute     bool
+	startSummary    bool
+	bootTime        time.Time
+	schedRuns       scheduleRuns
+	bundleCache     bool
+	bundleInline    atomic.Bool
+	cache           responseCache
+	cacheFlight     singleflight.Group
+	eventTimeout    time.Duration
+	eventOnTimeout  EventResult
+	slowEvent       time.Duration
+	users           userCache
+	streamedLimit   int64
+	cors            *CORSConfig
+	actionContent   map[string][]contentStream
+	actionContentMu sync.Mutex
 }
 
 type EventHandler func(Event) EventResult
 type RouteHandler func(Request) Response
 type ScheduleHandler func()
+type SchedHandler func(Sched)
 type AddonTypeHandler func(AddonTypeRequest) AddonTypeResponse
 
+type ScheduleConfig struct {
+	ID      string
+	Cron    string
+	Handler SchedHandler
+	Method  string
+	Path    string
+	CatchUp CatchUpPolicy
+}
+
+type pluginMeta struct {
+	description     string
+	longDescription string
+	author          string
+	website         string
+	license         string
+	icon            string
+}
+
 type RouteConfig struct {
-	Method           string
-	Path             string
-	Handler          RouteHandler
-	RateLimitPreset  string
-	RateLimitRPM     int
-	RateLimitBurst   int
+	Method          string
+	Path            string
+	Handler         RouteHandler
+	RateLimitPreset string
+	RateLimitRPM    int
+	RateLimitBurst  int
+	RateLimitScope  RateLimitScope
+	RateLimitKey    func(Request) string
+	Raw             bool
+	StreamBody      bool
+	MaxBodySize     int64
+	CacheTTL        time.Duration
+	CacheShared     bool
+	CORS            *CORSConfig
+	limiter         *routeLimiter
+	schema          *pb.RouteSchema
 }
 
 const (
@@ -56,19 +203,43 @@ const (
 	PresetStrict = "strict"
 )
 
-func New(id, version string) *Plugin {
-	return &Plugin{
+func New(id, version string, opts ...Option) *Plugin {
+	p := &Plugin{
 		id:         id,
 		name:       id,
 		version:    version,
 		events:     make(map[string]EventHandler),
 		routes:     make(map[string]*RouteConfig),
-		schedule:   make(map[string]ScheduleHandler),
+		schedules:  make(map[string]*ScheduleConfig),
 		mixins:     make([]MixinRegistration, 0),
 		addonTypes: make(map[string]AddonTypeHandler),
-		pending:    make(map[string]chan *pb.PanelMessage),
+		addonInfo:  make(map[string]*pb.AddonTypeInfo),
+		pending:    make(map[string]*pendingCall),
+		maxPending: defaultMaxPending,
 		ui:         newUIBuilder(),
 	}
+	p.lifecycle.onPanic = func(r interface{}) { p.reportPanic(r, "lifecycle", "") }
+	p.flags = newFlags(p.lifecycle.post)
+	p.outbound = &outboundGuard{}
+	p.outbox.max = DefaultOutageBuffer
+	p.cache.maxBytes = DefaultCacheMaxBytes
+	p.eventOnTimeout = Allow()
+	p.slowEvent = DefaultSlowEventThreshold
+	p.users.ttl = DefaultUserCacheTTL
+	p.users.max = DefaultUserCacheSize
+	p.streamedLimit = DefaultMaxStreamedFileSize
+	p.instance = os.Getenv(instanceEnv)
+	p.uiPush = newUIPushLimiter(DefaultUIPushRate, DefaultUIPushBurst)
+	p.uiPushMax = DefaultUIPushMaxSize
+	p.mixinDeadline = DefaultMixinDeadline
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.bootTime = p.clock().Now()
+	if !p.noInfoRoute {
+		p.Route("GET", InfoRoutePath, p.serveInfo)
+	}
+	return p
 }
 
 func (p *Plugin) SetName(name string) *Plugin {
@@ -76,17 +247,56 @@ func (p *Plugin) SetName(name string) *Plugin {
 	return p
 }
 
+func (p *Plugin) SetDescription(summary string) *Plugin {
+	p.meta.description = summary
+	return p
+}
+
+func (p *Plugin) SetLongDescription(markdown string) *Plugin {
+	p.meta.longDescription = markdown
+	return p
+}
+
+func (p *Plugin) SetAuthor(name string) *Plugin {
+	p.meta.author = name
+	return p
+}
+
+func (p *Plugin) SetWebsite(url string) *Plugin {
+	p.meta.website = url
+	return p
+}
+
+func (p *Plugin) SetLicense(license string) *Plugin {
+	p.meta.license = license
+	return p
+}
+
+func (p *Plugin) SetIcon(dataOrName string) *Plugin {
+	p.meta.icon = dataOrName
+	return p
+}
+
 func (p *Plugin) UseDataDir() *Plugin {
 	p.useDataDir = true
 	return p
 }
 
 func (p *Plugin) OnStart(fn func()) *Plugin {
-	p.onStart = fn
+	return p.OnStartContext(func(StartContext) { fn() })
+}
+
+func (p *Plugin) OnStop(fn func()) *Plugin {
+	p.onStop = append(p.onStop, fn)
 	return p
 }
 
 func (p *Plugin) OnEvent(eventType string, handler EventHandler) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if _, ok := p.events[eventType]; ok {
+		p.regErrs = append(p.regErrs, fmt.Errorf("event %q handled twice", eventType))
+	}
 	p.events[eventType] = handler
 	return p
 }
@@ -97,12 +307,18 @@ func (p *Plugin) Route(method, path string, handler RouteHandler) *RouteBuilder
 		Path:    path,
 		Handler: handler,
 	}
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if _, ok := p.routes[method+":"+path]; ok {
+		p.regErrs = append(p.regErrs, fmt.Errorf("route %s %s registered twice", method, path))
+	}
 	p.routes[method+":"+path] = cfg
-	return &RouteBuilder{config: cfg}
+	return &RouteBuilder{config: cfg, plugin: p}
 }
 
 type RouteBuilder struct {
 	config *RouteConfig
+	plugin *Plugin
 }
 
 func (rb *RouteBuilder) RateLimit(requestsPerMinute, burstLimit int) *RouteBuilder {
@@ -111,13 +327,44 @@ func (rb *RouteBuilder) RateLimit(requestsPerMinute, burstLimit int) *RouteBuild
 	return rb
 }
 
+func (rb *RouteBuilder) RateLimitScope(scope RateLimitScope) *RouteBuilder {
+	switch scope {
+	case "", ScopeGlobal, ScopeUser, ScopeIP:
+	default:
+		rb.plugin.regMu.Lock()
+		rb.plugin.regErrs = append(rb.plugin.regErrs, fmt.Errorf("route %s %s: unknown rate limit scope %q", rb.config.Method, rb.config.Path, scope))
+		rb.plugin.regMu.Unlock()
+	}
+	rb.config.RateLimitScope = scope
+	return rb
+}
+
+func (rb *RouteBuilder) RateLimitKey(key func(Request) string) *RouteBuilder {
+	rb.config.RateLimitKey = key
+	return rb
+}
+
+func (rb *RouteBuilder) Raw() *RouteBuilder {
+	rb.config.Raw = true
+	return rb
+}
+
 func (rb *RouteBuilder) RateLimitPreset(preset string) *RouteBuilder {
+	if _, ok := presetLimits[preset]; !ok && preset != "" {
+		rb.plugin.regMu.Lock()
+		rb.plugin.regErrs = append(rb.plugin.regErrs, fmt.Errorf("route %s %s: unknown rate limit preset %q", rb.config.Method, rb.config.Path, preset))
+		rb.plugin.regMu.Unlock()
+	}
 	rb.config.RateLimitPreset = preset
 	return rb
 }
 
 func (p *Plugin) Schedule(id, cron string, handler ScheduleHandler) *Plugin {
-	p.schedule[id+":"+cron] = handler
+	return p.ScheduleCtx(id, cron, func(Sched) { handler() })
+}
+
+func (p *Plugin) ScheduleCtx(id, cron string, handler SchedHandler) *Plugin {
+	p.Scheduled(id, cron, handler)
 	return p
 }
 
@@ -126,6 +373,8 @@ func (p *Plugin) Mixin(target string, handler MixinHandler) *Plugin {
 }
 
 func (p *Plugin) MixinWithPriority(target string, priority int, handler MixinHandler) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
 	p.mixins = append(p.mixins, MixinRegistration{
 		Target:   target,
 		Priority: priority,
@@ -135,7 +384,13 @@ func (p *Plugin) MixinWithPriority(target string, priority int, handler MixinHan
 }
 
 func (p *Plugin) AddonType(typeID, name, description string, handler AddonTypeHandler) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if _, ok := p.addonTypes[typeID]; ok {
+		p.regErrs = append(p.regErrs, fmt.Errorf("addon type %q registered twice", typeID))
+	}
 	p.addonTypes[typeID] = handler
+	p.addonInfo[typeID] = &pb.AddonTypeInfo{TypeId: typeID, Name: name, Description: description}
 	return p
 }
 
@@ -152,18 +407,13 @@ func (p *Plugin) Async() *AsyncAPI {
 }
 
 func (p *Plugin) Log(msg string) {
-	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-plugin-id", p.id)
-	p.panel.Log(ctx, &pb.LogRequest{Level: "info", Message: msg})
+	p.LogFields(LevelInfo, msg, nil)
 }
 
 func (p *Plugin) DataDir() string {
 	return p.dataDir
 }
 
-func (p *Plugin) DataPath(filename string) string {
-	return filepath.Join(p.dataDir, filename)
-}
-
 func (p *Plugin) SaveConfig(v interface{}) error {
 	data, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
@@ -180,44 +430,126 @@ func (p *Plugin) LoadConfig(v interface{}) error {
 	return json.Unmarshal(data, v)
 }
 
+func (p *Plugin) LoadConfigOrDefault(v interface{}) error {
+	err := p.LoadConfig(v)
+	if errors.Is(err, os.ErrNotExist) {
+		return p.SaveConfig(v)
+	}
+	return err
+}
+
 func (p *Plugin) Start(panelAddr string) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+
 	if len(os.Args) > 1 {
 		panelAddr = os.Args[1]
 	}
 	if len(os.Args) > 2 {
-		p.dataDir = filepath.Join(os.Args[2], p.id+"_data")
+		p.dataDir = filepath.Join(os.Args[2], p.dataDirName())
 	} else {
-		p.dataDir = p.id + "_data"
-	}
-
-	if p.useDataDir {
-		if err := os.MkdirAll(p.dataDir, 0755); err != nil {
-			log.Printf("[%s] failed to create data dir %s: %v", p.id, p.dataDir, err)
-		}
+		p.dataDir = p.dataDirName()
 	}
+	p.setupDataDir()
+	defer p.closeLog()
 
 	conn, err := grpc.NewClient(panelAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(p.callOptions()...),
+		p.keepaliveOption(),
 		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-			ctx = metadata.AppendToOutgoingContext(ctx, "x-plugin-id", p.id)
+			ctx = pluginContext(ctx, p.id, p.instance)
 			return invoker(ctx, method, req, reply, cc, opts...)
 		}),
 	)
 	if err != nil {
 		return err
 	}
-	p.conn = conn
-	p.panel = pb.NewPanelServiceClient(conn)
-	p.api = &API{panel: p.panel, pluginID: p.id}
-	p.asyncApi = &AsyncAPI{panel: p.panel, pluginID: p.id}
 
-	stream, err := p.panel.Connect(context.Background())
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err = p.connect(ctx, conn)
+	if err == errShutdown || ctx.Err() != nil {
+		p.closeLog()
+		os.Exit(0)
+	}
+	return err
+}
+
+func (p *Plugin) Serve(ctx context.Context, conn grpc.ClientConnInterface) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	if p.dataDir == "" {
+		p.dataDir = p.dataDirName()
+	}
+	p.setupDataDir()
+	defer p.closeLog()
+
+	err := p.connect(ctx, conn)
+	if err == errShutdown || ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func (p *Plugin) validate() error {
+	return errors.Join(p.Validate()...)
+}
+
+func (p *Plugin) setupDataDir() {
+	if !p.useDataDir {
+		return
+	}
+	if err := os.MkdirAll(p.dataDir, 0755); err != nil {
+		log.Printf("[%s] failed to create data dir %s: %v", p.label(), p.dataDir, err)
+	} else if p.logFile != nil {
+		p.logFile.path = p.DataPath(logFileName)
+	}
+}
+
+func (p *Plugin) run(ctx context.Context, conn grpc.ClientConnInterface) error {
+	p.setupWireLogging()
+	p.sendMu.Lock()
+	p.conn = conn
+	p.sendMu.Unlock()
+	p.panel = pb.NewPanelServiceClient(outboxConn{base: conn, plugin: p})
+	p.api = newAPI(outboxConn{base: scopedConn{base: conn, scope: &p.perms}, plugin: p}, p)
+	streamAPI := newAPI(scopedConn{base: streamConn{p}, scope: &p.perms}, p)
+	p.asyncApi = &AsyncAPI{panel: streamAPI.panel, pluginID: p.id, api: streamAPI, plugin: p}
+
+	p.setState(StateConnecting)
+	p.stale.Store(false)
+	p.bundleInline.Store(false)
+	p.pingSupported.Store(false)
+
+	streamCtx, cancelStream := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelStream()
+	defer p.abortBodies()
+	defer p.abandonDeferredMixins()
+
+	stream, err := p.panel.Connect(streamCtx, p.callOptions()...)
 	if err != nil {
 		return err
 	}
+	p.sendMu.Lock()
+	p.stream = stream
+	p.streamGen++
+	gen := p.streamGen
+	p.dropStream = cancelStream
+	p.sendMu.Unlock()
+	defer p.streamClosed(stream)
+
+	devBundle := p.devBundleEnabled()
+	if devBundle {
+		p.loadDevBundle()
+	}
 
+	routes := p.routeSnapshot()
 	info := p.buildInfo()
-	if err := stream.Send(&pb.PluginMessage{Payload: &pb.PluginMessage_Register{Register: info}}); err != nil {
+	if err := p.send(&pb.PluginMessage{Payload: &pb.PluginMessage_Register{Register: info}}); err != nil {
 		return err
 	}
 
@@ -225,64 +557,170 @@ func (p *Plugin) Start(panelAddr string) error {
 	if err != nil {
 		return err
 	}
-	if msg.GetRegistered() == nil {
+	p.wire.in(msg)
+	p.touch()
+	registered := msg.GetRegistered()
+	if registered == nil {
 		return err
 	}
+	p.bundleCache = registered.BundleCache
+	p.activateRoutes(routes)
+	p.setPanelInfo(registered)
+	p.checkProtocol()
+	if err := p.checkRequirements(); err != nil {
+		return err
+	}
+	p.setState(StateConnected)
+	p.fireConnect()
+	go p.catchUpSchedules(streamCtx)
+	p.startBundleUpload(streamCtx, info)
+	go p.flushOutbox(scopedConn{base: conn, scope: &p.perms})
+
+	p.printf(LevelInfo, "v%s connected to panel", p.version)
+
+	first := !p.started
+	if first {
+		p.started = true
+		if devBundle {
+			go p.watchDevBundle(ctx)
+		}
+		if p.jobs != nil {
+			p.jobs.start()
+		}
+		if p.startPlugin() {
+			p.Log(p.name + " v" + p.version + " started")
+		}
+	}
 
-	log.Printf("[%s] v%s connected to panel", p.id, p.version)
-
-	if p.onStart != nil {
-		p.onStart()
+	pool := p.startWorkers(gen)
+	defer pool.close()
+	if first && p.startSummary {
+		p.logSummary()
 	}
-	p.Log(p.name + " v" + p.version + " started")
+
+	var shutdownOnce sync.Once
+	var stopping atomic.Bool
+	shutdown := func() { shutdownOnce.Do(func() { p.handleShutdown(pool) }) }
+	go func() {
+		select {
+		case <-ctx.Done():
+			shutdown()
+			cancelStream()
+		case <-streamCtx.Done():
+		}
+	}()
+
+	janitorCtx, stopJanitor := context.WithCancel(ctx)
+	defer stopJanitor()
+	go p.expirePending(janitorCtx)
+	go p.heartbeatLoop(streamCtx, cancelStream)
+	go p.healthLoop(streamCtx)
 
 	for {
 		msg, err := stream.Recv()
 		if err == io.EOF {
-			log.Printf("[%s] stream closed", p.id)
-			return nil
+			p.printf(LevelInfo, "stream closed")
+			return err
 		}
 		if err != nil {
-			log.Printf("[%s] stream error: %v", p.id, err)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if stopping.Load() {
+				shutdown()
+				return errShutdown
+			}
+			if p.stale.Load() {
+				return errStale
+			}
+			p.logRecvErr(err)
+			p.printf(LevelError, "stream error: %v", err)
 			return err
 		}
-		p.handleMessage(stream, msg)
+		p.wire.in(msg)
+		p.touch()
+		if msg.GetApiResult() != nil || msg.GetApiBatchResult() != nil || msg.GetRegistrationUpdated() != nil {
+			p.resolvePending(msg)
+			continue
+		}
+		if ping := msg.GetPing(); ping != nil {
+			p.handlePing(ping)
+			continue
+		}
+		if t := msg.GetRouteThrottled(); t != nil {
+			p.routeThrottled(t)
+			continue
+		}
+		if values := msg.GetFlagsChanged(); values != nil {
+			p.flags.apply(values)
+			continue
+		}
+		if chunk := msg.GetBodyChunk(); chunk != nil {
+			p.bodyChunk(msg.RequestId, chunk)
+			continue
+		}
+		if msg.GetHealthProbe() != nil {
+			go p.handleHealthProbe(msg.RequestId)
+			continue
+		}
+		if _, ok := msg.Payload.(*pb.PanelMessage_Shutdown); ok {
+			stopping.Store(true)
+			go func() {
+				shutdown()
+				cancelStream()
+			}()
+			continue
+		}
+		p.openBody(msg)
+		p.enqueue(pool, msg)
 	}
 }
 
 func (p *Plugin) buildInfo() *pb.PluginInfo {
-	events := make([]string, 0, len(p.events))
-	for e := range p.events {
-		events = append(events, e)
-	}
-
-	routes := make([]*pb.RouteInfo, 0, len(p.routes))
-	for _, cfg := range p.routes {
-		route := &pb.RouteInfo{Method: cfg.Method, Path: cfg.Path}
-		if cfg.RateLimitPreset != "" || cfg.RateLimitRPM > 0 {
-			route.RateLimit = &pb.RateLimitConfig{
-				Preset:            cfg.RateLimitPreset,
-				RequestsPerMinute: int32(cfg.RateLimitRPM),
-				BurstLimit:        int32(cfg.RateLimitBurst),
-			}
+	live := p.routeSnapshot()
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+
+	events := sortedKeys(p.events)
+	routes := make([]*pb.RouteInfo, 0, len(live))
+	for _, key := range sortedKeys(live) {
+		cfg := live[key]
+		route := &pb.RouteInfo{Method: cfg.Method, Path: cfg.Path, RateLimit: cfg.rateLimitConfig()}
+		if cfg.StreamBody {
+			route.StreamBody = true
+			route.MaxBodySize = cfg.maxBodySize()
 		}
+		route.Cors = cfg.CORS != nil || p.cors != nil
+		route.Schema = cfg.schema
 		routes = append(routes, route)
 	}
 
-	schedules := make([]*pb.ScheduleInfo, 0, len(p.schedule))
-	for key := range p.schedule {
-		id, cron := splitKey(key)
-		schedules = append(schedules, &pb.ScheduleInfo{Id: id, Cron: cron})
+	schedules := make([]*pb.ScheduleInfo, 0, len(p.schedules))
+	for _, id := range sortedKeys(p.schedules) {
+		cfg := p.schedules[id]
+		schedules = append(schedules, &pb.ScheduleInfo{Id: cfg.ID, Cron: cfg.Cron, RouteMethod: cfg.Method, RoutePath: cfg.Path})
 	}
 
 	mixins := make([]*pb.MixinInfo, 0, len(p.mixins))
 	for _, m := range p.mixins {
 		mixins = append(mixins, &pb.MixinInfo{Target: m.Target, Priority: int32(m.Priority)})
 	}
+	sort.SliceStable(mixins, func(i, j int) bool {
+		if mixins[i].Target != mixins[j].Target {
+			return mixins[i].Target < mixins[j].Target
+		}
+		return mixins[i].Priority > mixins[j].Priority
+	})
 
 	addonTypes := make([]*pb.AddonTypeInfo, 0, len(p.addonTypes))
-	for typeID := range p.addonTypes {
-		addonTypes = append(addonTypes, &pb.AddonTypeInfo{TypeId: typeID})
+	for _, typeID := range sortedKeys(p.addonTypes) {
+		addonTypes = append(addonTypes, p.addonInfo[typeID])
+	}
+
+	ui := p.ui.build()
+	if !p.inlineBundle(ui) {
+		ui.BundleData = nil
+		ui.Assets = nil
 	}
 
 	return &pb.PluginInfo{
@@ -294,99 +732,325 @@ func (p *Plugin) buildInfo() *pb.PluginInfo {
 		Schedules:  schedules,
 		Mixins:     mixins,
 		AddonTypes: addonTypes,
-		Ui:         p.ui.build(),
+		Ui:         ui,
+
+		Description:     p.meta.description,
+		LongDescription: p.meta.longDescription,
+		Author:          p.meta.author,
+		Website:         p.meta.website,
+		License:         p.meta.license,
+		Icon:            p.meta.icon,
+
+		RequiredPanelVersion: p.requiredVersion,
+		RequiredCapabilities: p.requiredCaps,
+		Dependencies:         p.deps,
+		Permissions:          p.perms.names(),
+		Flags:                p.flags.declarations(),
+		ResumeEventSeq:       p.eventSeq.resume(),
+		InstanceId:           p.instance,
+		ThrottleEvents:       len(p.onThrottled) > 0,
+		SdkVersion:           sdkVersion(),
+		ProtocolVersion:      ProtocolVersion,
+	}
+}
+
+func (p *Plugin) send(msg *pb.PluginMessage) error {
+	return p.sendGen(0, msg)
+}
+
+func (p *Plugin) sendGen(gen uint64, msg *pb.PluginMessage) error {
+	p.sendMu.Lock()
+	defer p.sendMu.Unlock()
+	if gen != 0 && gen != p.streamGen {
+		return errStaleStream
+	}
+	if p.stream == nil {
+		return ErrUnavailable
+	}
+	p.wire.out(msg)
+	var err error
+	if size := proto.Size(msg); p.maxSendMsg > 0 && size > p.maxSendMsg {
+		err = status.Errorf(codes.ResourceExhausted, "message of %d bytes exceeds send limit of %d", size, p.maxSendMsg)
+	} else {
+		err = p.stream.Send(msg)
 	}
+	if err != nil {
+		p.logSendErr(msg, err)
+	}
+	return err
 }
 
-func (p *Plugin) handleMessage(stream pb.PanelService_ConnectClient, msg *pb.PanelMessage) {
-	var resp *pb.PluginMessage
+func (p *Plugin) handleShutdown(pool *workerPool) {
+	p.printf(LevelInfo, "shutdown requested")
+	p.setState(StateDraining)
+	if abandoned := pool.drain(p.clock(), p.drainTimeout); len(abandoned) > 0 {
+		p.printf(LevelWarn, "drain timed out, abandoning requests: %s", strings.Join(abandoned, ", "))
+	}
+	p.failDeferredMixins("plugin shutting down")
+	if p.webhooks != nil && !p.webhooks.flushTimeout(webhookShutdownFlush) {
+		p.printf(LevelWarn, "shutdown with undelivered webhooks")
+	}
+	if p.jobs != nil {
+		p.jobs.stop(p.drainTimeout)
+	}
+	if p.tracer != nil {
+		p.tracer.flush()
+	}
+	for _, fn := range p.onStop {
+		fn()
+	}
+}
+
+func (p *Plugin) handleMessage(gen uint64, msg *pb.PanelMessage) {
+	var late sync.WaitGroup
+	ctx := context.WithValue(context.Background(), lateHandlersKey{}, &late)
+	if gen != 0 {
+		ctx = context.WithValue(ctx, streamGenKey{}, gen)
+	}
+	resp := p.dispatch(ctx, msg)
+	if resp != nil {
+		resp.RequestId = msg.RequestId
+		p.respond(gen, msg, resp)
+	}
+	if msg.GetAddonType() != nil {
+		p.sendActionContent(msg.RequestId)
+	}
+	late.Wait()
+}
+
+func (p *Plugin) dispatch(ctx context.Context, msg *pb.PanelMessage) (resp *pb.PluginMessage) {
+	var start time.Time
+	if p.metrics != nil {
+		start = p.clock().Now()
+	}
+	var span *Span
+	if p.tracer != nil {
+		ctx, span = p.tracer.startMessage(ctx, msg)
+	}
+	defer func() {
+		panicked := false
+		if r := recover(); r != nil {
+			panicked = true
+			p.reportPanic(r, messageSource(msg), msg.RequestId)
+			resp = panicResponse(msg)
+		}
+		if p.metrics != nil {
+			p.observe(msg, resp, panicked, p.clock().Now().Sub(start))
+		}
+		if span != nil {
+			span.finish(resp, panicked)
+		}
+	}()
 
 	switch payload := msg.Payload.(type) {
 	case *pb.PanelMessage_Event:
-		resp = p.handleEvent(payload.Event)
+		return p.handleEvent(ctx, payload.Event, msg.RequestId)
 	case *pb.PanelMessage_Http:
-		resp = p.handleHTTP(payload.Http)
+		return p.handleHTTP(ctx, payload.Http, msg.RequestId)
 	case *pb.PanelMessage_Schedule:
-		resp = p.handleSchedule(payload.Schedule)
+		return p.handleSchedule(ctx, payload.Schedule, msg.RequestId)
 	case *pb.PanelMessage_Mixin:
-		resp = p.handleMixin(payload.Mixin)
+		return p.handleMixin(ctx, payload.Mixin, msg.RequestId)
 	case *pb.PanelMessage_AddonType:
-		resp = p.handleAddonType(payload.AddonType)
-	case *pb.PanelMessage_Shutdown:
-		log.Printf("[%s] shutdown requested", p.id)
-		os.Exit(0)
-	default:
-		return
-	}
-
-	if resp != nil {
-		resp.RequestId = msg.RequestId
-		stream.Send(resp)
+		return p.handleAddonType(ctx, payload.AddonType, msg.RequestId)
+	case *pb.PanelMessage_BundleRequest:
+		return p.handleBundleRequest(payload.BundleRequest)
 	}
+	return nil
 }
 
-func (p *Plugin) handleEvent(ev *pb.Event) *pb.PluginMessage {
-	handler, ok := p.events[ev.Type]
-	if !ok {
+func (p *Plugin) handleEvent(ctx context.Context, ev *pb.Event, requestID string) *pb.PluginMessage {
+	if ev.Type == EventUserUpdated || ev.Type == EventUserDeleted {
+		p.InvalidateUser(ev.Data["user_id"])
+	}
+	p.regMu.RLock()
+	handler, ok := p.eventHandler(ev.Type)
+	p.regMu.RUnlock()
+	if !ok || (ev.Seq > 0 && p.eventSeq.seen(ev.Seq)) {
+		p.eventSeq.ack(ev.Seq)
 		return &pb.PluginMessage{Payload: &pb.PluginMessage_EventResponse{EventResponse: &pb.EventResponse{Allow: true}}}
 	}
-	result := handler(Event{Type: ev.Type, Data: ev.Data, Sync: ev.Sync})
+	result := p.runEvent(handler, Event{Type: ev.Type, Data: ev.Data, Sync: ev.Sync, Seq: ev.Seq, Replayed: ev.Replayed, RequestID: requestID, ctx: ctx, flags: p.flags.Snapshot(), plugin: p})
+	if ev.Seq > 0 {
+		p.eventSeq.ack(ev.Seq)
+	}
 	return &pb.PluginMessage{Payload: &pb.PluginMessage_EventResponse{EventResponse: &pb.EventResponse{Allow: result.allow, Message: result.message}}}
 }
 
-func (p *Plugin) handleHTTP(req *pb.HTTPRequest) *pb.PluginMessage {
-	cfg, ok := p.routes[req.Method+":"+req.Path]
-	if !ok {
-		for _, c := range p.routes {
-			if (c.Method == "*" || c.Method == req.Method) && matchPath(c.Path, req.Path) {
-				cfg = c
-				break
+func (p *Plugin) findRoute(method, path string) *RouteConfig {
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+	return p.findRouteLocked(method, path)
+}
+
+func (p *Plugin) findRouteLocked(method, path string) *RouteConfig {
+	routes := p.activeRoutes()
+	if cfg, ok := routes[method+":"+path]; ok {
+		return cfg
+	}
+	var param *RouteConfig
+	for _, c := range routes {
+		if (c.Method == "*" || c.Method == method) && matchPath(c.Path, path) {
+			if !hasPathParams(c.Path) {
+				return c
+			}
+			if param == nil || c.Path < param.Path {
+				param = c
 			}
 		}
 	}
+	return param
+}
+
+func (p *Plugin) handleHTTP(ctx context.Context, req *pb.HTTPRequest, requestID string) *pb.PluginMessage {
+	stream := p.body(requestID)
+	if stream != nil {
+		defer p.closeBody(requestID)
+	}
+	cfg := p.findRoute(req.Method, req.Path)
+	cors := p.corsFor(cfg, req.Path)
+	if cfg == nil && cors != nil && req.Method == "OPTIONS" {
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: p.preflight(cors, req)}}
+	}
+	resp := p.serveHTTP(ctx, cfg, req, requestID, stream)
+	if cors != nil {
+		if r := resp.GetHttpResponse(); r != nil {
+			headers := make(map[string]string, len(r.Headers)+4)
+			for k, v := range r.Headers {
+				headers[k] = v
+			}
+			if cors.apply(headers, headerValue(req.Headers, "Origin")) {
+				r.Headers = headers
+			}
+		}
+	}
+	return resp
+}
+
+func (p *Plugin) serveHTTP(ctx context.Context, cfg *RouteConfig, req *pb.HTTPRequest, requestID string, stream *bodyStream) *pb.PluginMessage {
 	if cfg == nil {
 		return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: errorResponse(404, "not found")}}
 	}
 
+	raw := req.Body
+	if stream != nil && !cfg.StreamBody {
+		data, err := io.ReadAll(stream)
+		if errors.Is(err, ErrBodyTooLarge) {
+			return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: errorResponse(413, "request body too large")}}
+		}
+		if err != nil {
+			return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: errorResponse(400, "request body incomplete")}}
+		}
+		raw, stream = data, nil
+	}
+
 	var body map[string]interface{}
-	json.Unmarshal(req.Body, &body)
-
-	resp := cfg.Handler(Request{
-		Method:  req.Method,
-		Path:    req.Path,
-		Headers: req.Headers,
-		Query:   req.Query,
-		Body:    body,
-		RawBody: req.Body,
-		UserID:  req.UserId,
-	})
+	if !cfg.Raw && stream == nil {
+		json.Unmarshal(raw, &body)
+	}
 
-	return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: &pb.HTTPResponse{
-		Status:  int32(resp.Status),
-		Headers: resp.Headers,
-		Body:    resp.body,
-	}}}
-}
+	r := Request{
+		Method:         req.Method,
+		Path:           req.Path,
+		Headers:        req.Headers,
+		Query:          req.Query,
+		Body:           body,
+		RawBody:        raw,
+		UserID:         req.UserId,
+		RequestID:      requestID,
+		CallerPluginID: req.CallerPluginId,
+		stream:         stream,
+		ctx:            ctx,
+		route:          cfg.Path,
+		params:         routeParams(cfg.Path, req.Path),
+		flags:          p.flags.Snapshot(),
+		values:         &requestValues{},
+		plugin:         p,
+	}
+	r.rateLimit = panelRateLimit(req, p.clock().Now())
+	limit, wait, ok := p.allowRequest(cfg, r)
+	if !ok {
+		p.fireThrottled(RouteThrottleInfo{
+			Method:      r.Method,
+			Path:        r.Path,
+			Route:       cfg.Path,
+			UserID:      r.UserID,
+			ClientIP:    clientIP(r),
+			Limit:       limit.limit,
+			Scope:       cfg.RateLimitScope,
+			RetryAfter:  wait,
+			Count:       1,
+			SDKEnforced: true,
+		})
+		resp := errorResponse(429, "rate limit exceeded")
+		resp.Headers["Retry-After"] = strconv.Itoa(int((wait + time.Second - 1) / time.Second))
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: resp}}
+	}
+	if limit.known {
+		r.rateLimit = limit
+	}
+	if !cacheable(cfg, r.Method) {
+		resp := cfg.Handler(r)
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: &pb.HTTPResponse{
+			Status:  int32(resp.Status),
+			Headers: resp.Headers,
+			Body:    resp.body,
+		}}}
+	}
 
-func (p *Plugin) handleSchedule(req *pb.ScheduleRequest) *pb.PluginMessage {
-	for key, handler := range p.schedule {
-		id, _ := splitKey(key)
-		if id == req.ScheduleId {
-			handler()
-			break
+	key := cacheKey(cfg, r)
+	now := p.clock().Now()
+	cached, gen, ok := p.cache.get(key, now)
+	if ok {
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: cached}}
+	}
+	fill := func() *cacheEntry {
+		resp := cfg.Handler(r)
+		entry := &cacheEntry{
+			key:     key,
+			method:  r.Method,
+			path:    r.Path,
+			status:  int32(resp.Status),
+			headers: make(map[string]string, len(resp.Headers)),
+			body:    resp.body,
+			expires: now.Add(cfg.CacheTTL),
+		}
+		for k, v := range resp.Headers {
+			entry.headers[k] = v
 		}
+		if entry.storable() {
+			p.cache.put(gen, entry)
+		}
+		return entry
+	}
+	v, _, shared := p.cacheFlight.Do(key+"\x00"+strconv.FormatUint(gen, 10), func() (interface{}, error) { return fill(), nil })
+	entry := v.(*cacheEntry)
+	if shared && !entry.storable() {
+		entry = fill()
+	}
+	return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: entry.response("MISS")}}
+}
+
+func (p *Plugin) handleSchedule(ctx context.Context, req *pb.ScheduleRequest, requestID string) *pb.PluginMessage {
+	p.regMu.RLock()
+	cfg, ok := p.schedules[req.ScheduleId]
+	p.regMu.RUnlock()
+	if ok {
+		p.runSchedule(cfg, Sched{ID: cfg.ID, Cron: cfg.Cron, RequestID: requestID, ctx: ctx, flags: p.flags.Snapshot(), plugin: p, scheduledAt: p.clock().Now().Truncate(time.Minute)})
 	}
 	return &pb.PluginMessage{Payload: &pb.PluginMessage_ScheduleResponse{ScheduleResponse: &pb.Empty{}}}
 }
 
-func (p *Plugin) handleMixin(req *pb.MixinRequest) *pb.PluginMessage {
+func (p *Plugin) handleMixin(ctx context.Context, req *pb.MixinRequest, requestID string) *pb.PluginMessage {
 	var handler MixinHandler
+	p.regMu.RLock()
 	for _, m := range p.mixins {
 		if m.Target == req.Target {
 			handler = m.Handler
 			break
 		}
 	}
+	p.regMu.RUnlock()
 
 	if handler == nil {
 		return &pb.PluginMessage{Payload: &pb.PluginMessage_MixinResponse{MixinResponse: &pb.MixinResponse{Action: pb.MixinResponse_NEXT}}}
@@ -400,15 +1064,53 @@ func (p *Plugin) handleMixin(req *pb.MixinRequest) *pb.PluginMessage {
 		json.Unmarshal(req.ChainData, &chainData)
 	}
 
+	var output map[string]interface{}
+	if len(req.Output) > 0 {
+		json.Unmarshal(req.Output, &output)
+	}
+
+	replyID := requestID
+	if req.RequestId != "" {
+		requestID = req.RequestId
+	}
+	ctx, cancel := context.WithCancel(ctx)
 	mctx := &MixinContext{
 		Target:    req.Target,
-		RequestID: req.RequestId,
+		RequestID: requestID,
 		input:     input,
 		chainData: chainData,
+		output:    output,
+		dryRun:    req.DryRun,
+		ctx:       ctx,
+		flags:     p.flags.Snapshot(),
+		plugin:    p,
+	}
+	gen, _ := ctx.Value(streamGenKey{}).(uint64)
+	mctx.deferral = &deferredMixin{
+		plugin: p,
+		gen:    gen,
+		id:     "mixin-" + replyID,
+		msg:    &pb.PanelMessage{RequestId: replyID, Payload: &pb.PanelMessage_Mixin{Mixin: req}},
+		cancel: cancel,
+		stop:   make(chan struct{}),
 	}
 
 	result := handler(mctx)
+	if result.deferred {
+		early := mctx.deferral.park()
+		if early == nil {
+			return nil
+		}
+		result = *early
+	} else {
+		mctx.deferral.settle()
+	}
+	cancel()
 
+	return &pb.PluginMessage{Payload: &pb.PluginMessage_MixinResponse{MixinResponse: p.mixinResponse(req, result)}}
+}
+
+func (p *Plugin) mixinResponse(req *pb.MixinRequest, result MixinResult) *pb.MixinResponse {
 	resp := &pb.MixinResponse{
 		Action: pb.MixinResponse_Action(result.action),
 	}
@@ -422,19 +1124,30 @@ func (p *Plugin) handleMixin(req *pb.MixinRequest) *pb.PluginMessage {
 	if result.modifiedInput != nil {
 		resp.ModifiedInput, _ = json.Marshal(result.modifiedInput)
 	}
+	if result.outputPatch != nil {
+		resp.OutputPatch, _ = json.Marshal(result.outputPatch)
+	}
+	resp.DeleteOutputKeys = result.deleteKeys
 	for _, n := range result.notifications {
+		if req.DryRun && n.Type == "success" {
+			p.printf(LevelWarn, "mixin %s returned success notification %q during a dry run", req.Target, n.Title)
+		}
 		resp.Notifications = append(resp.Notifications, &pb.Notification{
 			Title:   n.Title,
 			Message: n.Message,
 			Type:    n.Type,
 		})
 	}
-
-	return &pb.PluginMessage{Payload: &pb.PluginMessage_MixinResponse{MixinResponse: resp}}
+	for _, s := range result.skipped {
+		resp.SkippedEffects = append(resp.SkippedEffects, &pb.SkippedEffect{Kind: s.Kind, Description: s.Description})
+	}
+	return resp
 }
 
-func (p *Plugin) handleAddonType(req *pb.AddonTypeRequest) *pb.PluginMessage {
+func (p *Plugin) handleAddonType(ctx context.Context, req *pb.AddonTypeRequest, requestID string) *pb.PluginMessage {
+	p.regMu.RLock()
 	handler, ok := p.addonTypes[req.TypeId]
+	p.regMu.RUnlock()
 	if !ok {
 		return &pb.PluginMessage{Payload: &pb.PluginMessage_AddonTypeResponse{AddonTypeResponse: &pb.AddonTypeResponse{
 			Success: false,
@@ -451,6 +1164,10 @@ func (p *Plugin) handleAddonType(req *pb.AddonTypeRequest) *pb.PluginMessage {
 		InstallPath:     req.InstallPath,
 		SourceInfo:      req.SourceInfo,
 		ServerVariables: req.ServerVariables,
+		RequestID:       requestID,
+		ctx:             ctx,
+		flags:           p.flags.Snapshot(),
+		plugin:          p,
 	}
 
 	result := handler(addonReq)
@@ -461,32 +1178,17 @@ func (p *Plugin) handleAddonType(req *pb.AddonTypeRequest) *pb.PluginMessage {
 		Message: result.Message,
 	}
 
-	for _, action := range result.Actions {
-		pbAction := &pb.AddonInstallAction{
-			Type:         pb.AddonInstallAction_ActionType(action.Type),
-			Url:          action.URL,
-			Path:         action.Path,
-			Content:      action.Content,
-			Command:      action.Command,
-			Headers:      action.Headers,
-			NodePayload:  action.NodePayload,
-			NodeEndpoint: action.NodeEndpoint,
-		}
-		resp.Actions = append(resp.Actions, pbAction)
+	actions, streams, err := p.addonActions(result.Actions, req.ServerVariables)
+	if err != nil {
+		resp = &pb.AddonTypeResponse{Success: false, Error: err.Error()}
+	} else if len(streams) > 0 {
+		p.queueActionContent(requestID, streams)
 	}
+	resp.Actions = actions
 
 	return &pb.PluginMessage{Payload: &pb.PluginMessage_AddonTypeResponse{AddonTypeResponse: resp}}
 }
 
-func splitKey(key string) (string, string) {
-	for i, c := range key {
-		if c == ':' {
-			return key[:i], key[i+1:]
-		}
-	}
-	return key, ""
-}
-
 func matchPath(pattern, path string) bool {
 	if pattern == path {
 		return true
@@ -494,10 +1196,55 @@ func matchPath(pattern, path string) bool {
 	if len(pattern) > 0 && pattern[len(pattern)-1] == '*' {
 		return len(path) >= len(pattern)-1 && path[:len(pattern)-1] == pattern[:len(pattern)-1]
 	}
+	if hasPathParams(pattern) {
+		_, ok := pathParams(pattern, path)
+		return ok
+	}
 	return false
 }
 
+func hasPathParams(pattern string) bool {
+	return strings.Contains(pattern, "/:")
+}
+
+func pathParams(pattern, path string) (map[string]string, bool) {
+	segs := strings.Split(strings.Trim(pattern, "/"), "/")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segs) != len(parts) {
+		return nil, false
+	}
+	var params map[string]string
+	for i, seg := range segs {
+		if len(seg) > 1 && seg[0] == ':' {
+			if parts[i] == "" {
+				return nil, false
+			}
+			v, err := url.PathUnescape(parts[i])
+			if err != nil {
+				v = parts[i]
+			}
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[seg[1:]] = v
+			continue
+		}
+		if seg != parts[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func routeParams(pattern, path string) map[string]string {
+	if !hasPathParams(pattern) {
+		return nil
+	}
+	params, _ := pathParams(pattern, path)
+	return params
+}
+
 func errorResponse(status int, msg string) *pb.HTTPResponse {
-	b, _ := json.Marshal(map[string]interface{}{"success": false, "error": msg})
+	b := marshalJSON(map[string]interface{}{"success": false, "error": msg})
 	return &pb.HTTPResponse{Status: int32(status), Headers: map[string]string{"Content-Type": "application/json"}, Body: b}
 }
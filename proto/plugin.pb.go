@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HealthReport_State int32
+
+const (
+	HealthReport_HEALTHY   HealthReport_State = 0
+	HealthReport_DEGRADED  HealthReport_State = 1
+	HealthReport_UNHEALTHY HealthReport_State = 2
+)
+
+// Enum value maps for HealthReport_State.
+var (
+	HealthReport_State_name = map[int32]string{
+		0: "HEALTHY",
+		1: "DEGRADED",
+		2: "UNHEALTHY",
+	}
+	HealthReport_State_value = map[string]int32{
+		"HEALTHY":   0,
+		"DEGRADED":  1,
+		"UNHEALTHY": 2,
+	}
+)
+
+func (x HealthReport_State) Enum() *HealthReport_State {
+	p := new(HealthReport_State)
+	*p = x
+	return p
+}
+
+func (x HealthReport_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthReport_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_enumTypes[0].Descriptor()
+}
+
+func (HealthReport_State) Type() protoreflect.EnumType {
+	return &file_plugin_proto_enumTypes[0]
+}
+
+func (x HealthReport_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthReport_State.Descriptor instead.
+func (HealthReport_State) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{7, 0}
+}
+
 type MixinResponse_Action int32
 
 const (
@@ -54,11 +103,11 @@ func (x MixinResponse_Action) String() string {
 }
 
 func (MixinResponse_Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_plugin_proto_enumTypes[0].Descriptor()
+	return file_plugin_proto_enumTypes[1].Descriptor()
 }
 
 func (MixinResponse_Action) Type() protoreflect.EnumType {
-	return &file_plugin_proto_enumTypes[0]
+	return &file_plugin_proto_enumTypes[1]
 }
 
 func (x MixinResponse_Action) Number() protoreflect.EnumNumber {
@@ -67,7 +116,7 @@ func (x MixinResponse_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MixinResponse_Action.Descriptor instead.
 func (MixinResponse_Action) EnumDescriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{15, 0}
+	return file_plugin_proto_rawDescGZIP(), []int{44, 0}
 }
 
 type AddonInstallAction_ActionType int32
@@ -115,11 +164,11 @@ func (x AddonInstallAction_ActionType) String() string {
 }
 
 func (AddonInstallAction_ActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plugin_proto_enumTypes[1].Descriptor()
+	return file_plugin_proto_enumTypes[2].Descriptor()
 }
 
 func (AddonInstallAction_ActionType) Type() protoreflect.EnumType {
-	return &file_plugin_proto_enumTypes[1]
+	return &file_plugin_proto_enumTypes[2]
 }
 
 func (x AddonInstallAction_ActionType) Number() protoreflect.EnumNumber {
@@ -128,7 +177,59 @@ func (x AddonInstallAction_ActionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AddonInstallAction_ActionType.Descriptor instead.
 func (AddonInstallAction_ActionType) EnumDescriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{106, 0}
+	return file_plugin_proto_rawDescGZIP(), []int{174, 0}
+}
+
+type ActionCondition_Kind int32
+
+const (
+	ActionCondition_KIND_UNSPECIFIED ActionCondition_Kind = 0
+	ActionCondition_VARIABLE_EQUALS  ActionCondition_Kind = 1
+	ActionCondition_FILE_EXISTS      ActionCondition_Kind = 2
+	ActionCondition_FILE_MISSING     ActionCondition_Kind = 3
+)
+
+// Enum value maps for ActionCondition_Kind.
+var (
+	ActionCondition_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "VARIABLE_EQUALS",
+		2: "FILE_EXISTS",
+		3: "FILE_MISSING",
+	}
+	ActionCondition_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"VARIABLE_EQUALS":  1,
+		"FILE_EXISTS":      2,
+		"FILE_MISSING":     3,
+	}
+)
+
+func (x ActionCondition_Kind) Enum() *ActionCondition_Kind {
+	p := new(ActionCondition_Kind)
+	*p = x
+	return p
+}
+
+func (x ActionCondition_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionCondition_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_enumTypes[3].Descriptor()
+}
+
+func (ActionCondition_Kind) Type() protoreflect.EnumType {
+	return &file_plugin_proto_enumTypes[3]
+}
+
+func (x ActionCondition_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionCondition_Kind.Descriptor instead.
+func (ActionCondition_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{176, 0}
 }
 
 type PluginMessage struct {
@@ -141,6 +242,15 @@ type PluginMessage struct {
 	//	*PluginMessage_ScheduleResponse
 	//	*PluginMessage_MixinResponse
 	//	*PluginMessage_AddonTypeResponse
+	//	*PluginMessage_BundleUpdate
+	//	*PluginMessage_BundleUpload
+	//	*PluginMessage_ApiCall
+	//	*PluginMessage_Ping
+	//	*PluginMessage_UpdateRegistration
+	//	*PluginMessage_Health
+	//	*PluginMessage_ApiBatch
+	//	*PluginMessage_ActionContent
+	//	*PluginMessage_UiPush
 	Payload       isPluginMessage_Payload `protobuf_oneof:"payload"`
 	RequestId     string                  `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -238,6 +348,87 @@ func (x *PluginMessage) GetAddonTypeResponse() *AddonTypeResponse {
 	return nil
 }
 
+func (x *PluginMessage) GetBundleUpdate() *BundleUpdate {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_BundleUpdate); ok {
+			return x.BundleUpdate
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetBundleUpload() *BundleUpload {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_BundleUpload); ok {
+			return x.BundleUpload
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetApiCall() *ApiCall {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_ApiCall); ok {
+			return x.ApiCall
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetPing() *Ping {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_Ping); ok {
+			return x.Ping
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetUpdateRegistration() *PluginInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_UpdateRegistration); ok {
+			return x.UpdateRegistration
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetHealth() *HealthReport {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_Health); ok {
+			return x.Health
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetApiBatch() *ApiBatch {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_ApiBatch); ok {
+			return x.ApiBatch
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetActionContent() *ActionContent {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_ActionContent); ok {
+			return x.ActionContent
+		}
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetUiPush() *UIPush {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginMessage_UiPush); ok {
+			return x.UiPush
+		}
+	}
+	return nil
+}
+
 func (x *PluginMessage) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
@@ -273,6 +464,42 @@ type PluginMessage_AddonTypeResponse struct {
 	AddonTypeResponse *AddonTypeResponse `protobuf:"bytes,6,opt,name=addon_type_response,json=addonTypeResponse,proto3,oneof"`
 }
 
+type PluginMessage_BundleUpdate struct {
+	BundleUpdate *BundleUpdate `protobuf:"bytes,7,opt,name=bundle_update,json=bundleUpdate,proto3,oneof"`
+}
+
+type PluginMessage_BundleUpload struct {
+	BundleUpload *BundleUpload `protobuf:"bytes,8,opt,name=bundle_upload,json=bundleUpload,proto3,oneof"`
+}
+
+type PluginMessage_ApiCall struct {
+	ApiCall *ApiCall `protobuf:"bytes,9,opt,name=api_call,json=apiCall,proto3,oneof"`
+}
+
+type PluginMessage_Ping struct {
+	Ping *Ping `protobuf:"bytes,11,opt,name=ping,proto3,oneof"`
+}
+
+type PluginMessage_UpdateRegistration struct {
+	UpdateRegistration *PluginInfo `protobuf:"bytes,12,opt,name=update_registration,json=updateRegistration,proto3,oneof"`
+}
+
+type PluginMessage_Health struct {
+	Health *HealthReport `protobuf:"bytes,13,opt,name=health,proto3,oneof"`
+}
+
+type PluginMessage_ApiBatch struct {
+	ApiBatch *ApiBatch `protobuf:"bytes,14,opt,name=api_batch,json=apiBatch,proto3,oneof"`
+}
+
+type PluginMessage_ActionContent struct {
+	ActionContent *ActionContent `protobuf:"bytes,15,opt,name=action_content,json=actionContent,proto3,oneof"`
+}
+
+type PluginMessage_UiPush struct {
+	UiPush *UIPush `protobuf:"bytes,16,opt,name=ui_push,json=uiPush,proto3,oneof"`
+}
+
 func (*PluginMessage_Register) isPluginMessage_Payload() {}
 
 func (*PluginMessage_EventResponse) isPluginMessage_Payload() {}
@@ -285,6 +512,24 @@ func (*PluginMessage_MixinResponse) isPluginMessage_Payload() {}
 
 func (*PluginMessage_AddonTypeResponse) isPluginMessage_Payload() {}
 
+func (*PluginMessage_BundleUpdate) isPluginMessage_Payload() {}
+
+func (*PluginMessage_BundleUpload) isPluginMessage_Payload() {}
+
+func (*PluginMessage_ApiCall) isPluginMessage_Payload() {}
+
+func (*PluginMessage_Ping) isPluginMessage_Payload() {}
+
+func (*PluginMessage_UpdateRegistration) isPluginMessage_Payload() {}
+
+func (*PluginMessage_Health) isPluginMessage_Payload() {}
+
+func (*PluginMessage_ApiBatch) isPluginMessage_Payload() {}
+
+func (*PluginMessage_ActionContent) isPluginMessage_Payload() {}
+
+func (*PluginMessage_UiPush) isPluginMessage_Payload() {}
+
 type PanelMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Payload:
@@ -296,8 +541,18 @@ type PanelMessage struct {
 	//	*PanelMessage_Mixin
 	//	*PanelMessage_Shutdown
 	//	*PanelMessage_AddonType
+	//	*PanelMessage_BundleRequest
+	//	*PanelMessage_ApiResult
+	//	*PanelMessage_Ping
+	//	*PanelMessage_RegistrationUpdated
+	//	*PanelMessage_HealthProbe
+	//	*PanelMessage_FlagsChanged
+	//	*PanelMessage_BodyChunk
+	//	*PanelMessage_ApiBatchResult
+	//	*PanelMessage_RouteThrottled
 	Payload       isPanelMessage_Payload `protobuf_oneof:"payload"`
 	RequestId     string                 `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Traceparent   string                 `protobuf:"bytes,16,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -339,7 +594,7 @@ func (x *PanelMessage) GetPayload() isPanelMessage_Payload {
 	return nil
 }
 
-func (x *PanelMessage) GetRegistered() *Empty {
+func (x *PanelMessage) GetRegistered() *Registered {
 	if x != nil {
 		if x, ok := x.Payload.(*PanelMessage_Registered); ok {
 			return x.Registered
@@ -402,6 +657,87 @@ func (x *PanelMessage) GetAddonType() *AddonTypeRequest {
 	return nil
 }
 
+func (x *PanelMessage) GetBundleRequest() *BundleRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_BundleRequest); ok {
+			return x.BundleRequest
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetApiResult() *ApiResult {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_ApiResult); ok {
+			return x.ApiResult
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetPing() *Ping {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_Ping); ok {
+			return x.Ping
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetRegistrationUpdated() *RegistrationUpdated {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_RegistrationUpdated); ok {
+			return x.RegistrationUpdated
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetHealthProbe() *Empty {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_HealthProbe); ok {
+			return x.HealthProbe
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetFlagsChanged() *FlagValues {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_FlagsChanged); ok {
+			return x.FlagsChanged
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetBodyChunk() *BodyChunk {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_BodyChunk); ok {
+			return x.BodyChunk
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetApiBatchResult() *ApiBatchResult {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_ApiBatchResult); ok {
+			return x.ApiBatchResult
+		}
+	}
+	return nil
+}
+
+func (x *PanelMessage) GetRouteThrottled() *RouteThrottled {
+	if x != nil {
+		if x, ok := x.Payload.(*PanelMessage_RouteThrottled); ok {
+			return x.RouteThrottled
+		}
+	}
+	return nil
+}
+
 func (x *PanelMessage) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
@@ -409,12 +745,19 @@ func (x *PanelMessage) GetRequestId() string {
 	return ""
 }
 
+func (x *PanelMessage) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
 type isPanelMessage_Payload interface {
 	isPanelMessage_Payload()
 }
 
 type PanelMessage_Registered struct {
-	Registered *Empty `protobuf:"bytes,1,opt,name=registered,proto3,oneof"`
+	Registered *Registered `protobuf:"bytes,1,opt,name=registered,proto3,oneof"`
 }
 
 type PanelMessage_Event struct {
@@ -441,6 +784,42 @@ type PanelMessage_AddonType struct {
 	AddonType *AddonTypeRequest `protobuf:"bytes,7,opt,name=addon_type,json=addonType,proto3,oneof"`
 }
 
+type PanelMessage_BundleRequest struct {
+	BundleRequest *BundleRequest `protobuf:"bytes,8,opt,name=bundle_request,json=bundleRequest,proto3,oneof"`
+}
+
+type PanelMessage_ApiResult struct {
+	ApiResult *ApiResult `protobuf:"bytes,9,opt,name=api_result,json=apiResult,proto3,oneof"`
+}
+
+type PanelMessage_Ping struct {
+	Ping *Ping `protobuf:"bytes,11,opt,name=ping,proto3,oneof"`
+}
+
+type PanelMessage_RegistrationUpdated struct {
+	RegistrationUpdated *RegistrationUpdated `protobuf:"bytes,12,opt,name=registration_updated,json=registrationUpdated,proto3,oneof"`
+}
+
+type PanelMessage_HealthProbe struct {
+	HealthProbe *Empty `protobuf:"bytes,13,opt,name=health_probe,json=healthProbe,proto3,oneof"`
+}
+
+type PanelMessage_FlagsChanged struct {
+	FlagsChanged *FlagValues `protobuf:"bytes,14,opt,name=flags_changed,json=flagsChanged,proto3,oneof"`
+}
+
+type PanelMessage_BodyChunk struct {
+	BodyChunk *BodyChunk `protobuf:"bytes,15,opt,name=body_chunk,json=bodyChunk,proto3,oneof"`
+}
+
+type PanelMessage_ApiBatchResult struct {
+	ApiBatchResult *ApiBatchResult `protobuf:"bytes,17,opt,name=api_batch_result,json=apiBatchResult,proto3,oneof"`
+}
+
+type PanelMessage_RouteThrottled struct {
+	RouteThrottled *RouteThrottled `protobuf:"bytes,18,opt,name=route_throttled,json=routeThrottled,proto3,oneof"`
+}
+
 func (*PanelMessage_Registered) isPanelMessage_Payload() {}
 
 func (*PanelMessage_Event) isPanelMessage_Payload() {}
@@ -455,27 +834,46 @@ func (*PanelMessage_Shutdown) isPanelMessage_Payload() {}
 
 func (*PanelMessage_AddonType) isPanelMessage_Payload() {}
 
-// Common
-type Empty struct {
+func (*PanelMessage_BundleRequest) isPanelMessage_Payload() {}
+
+func (*PanelMessage_ApiResult) isPanelMessage_Payload() {}
+
+func (*PanelMessage_Ping) isPanelMessage_Payload() {}
+
+func (*PanelMessage_RegistrationUpdated) isPanelMessage_Payload() {}
+
+func (*PanelMessage_HealthProbe) isPanelMessage_Payload() {}
+
+func (*PanelMessage_FlagsChanged) isPanelMessage_Payload() {}
+
+func (*PanelMessage_BodyChunk) isPanelMessage_Payload() {}
+
+func (*PanelMessage_ApiBatchResult) isPanelMessage_Payload() {}
+
+func (*PanelMessage_RouteThrottled) isPanelMessage_Payload() {}
+
+type ApiCall struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *ApiCall) Reset() {
+	*x = ApiCall{}
 	mi := &file_plugin_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *ApiCall) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*ApiCall) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *ApiCall) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,32 +885,48 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApiCall.ProtoReflect.Descriptor instead.
+func (*ApiCall) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{2}
 }
 
-type IDRequest struct {
+func (x *ApiCall) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ApiCall) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type BodyChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Eof           bool                   `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IDRequest) Reset() {
-	*x = IDRequest{}
+func (x *BodyChunk) Reset() {
+	*x = BodyChunk{}
 	mi := &file_plugin_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IDRequest) String() string {
+func (x *BodyChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDRequest) ProtoMessage() {}
+func (*BodyChunk) ProtoMessage() {}
 
-func (x *IDRequest) ProtoReflect() protoreflect.Message {
+func (x *BodyChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,39 +938,54 @@ func (x *IDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDRequest.ProtoReflect.Descriptor instead.
-func (*IDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BodyChunk.ProtoReflect.Descriptor instead.
+func (*BodyChunk) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *IDRequest) GetId() string {
+func (x *BodyChunk) GetData() []byte {
 	if x != nil {
-		return x.Id
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BodyChunk) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
+func (x *BodyChunk) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type EmailRequest struct {
+type RegistrationUpdated struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	AppliedRoutes []*AppliedRoute        `protobuf:"bytes,2,rep,name=applied_routes,json=appliedRoutes,proto3" json:"applied_routes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmailRequest) Reset() {
-	*x = EmailRequest{}
+func (x *RegistrationUpdated) Reset() {
+	*x = RegistrationUpdated{}
 	mi := &file_plugin_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmailRequest) String() string {
+func (x *RegistrationUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailRequest) ProtoMessage() {}
+func (*RegistrationUpdated) ProtoMessage() {}
 
-func (x *EmailRequest) ProtoReflect() protoreflect.Message {
+func (x *RegistrationUpdated) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -568,39 +997,48 @@ func (x *EmailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailRequest.ProtoReflect.Descriptor instead.
-func (*EmailRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistrationUpdated.ProtoReflect.Descriptor instead.
+func (*RegistrationUpdated) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *EmailRequest) GetEmail() string {
+func (x *RegistrationUpdated) GetError() string {
 	if x != nil {
-		return x.Email
+		return x.Error
 	}
 	return ""
 }
 
-type UsernameRequest struct {
+func (x *RegistrationUpdated) GetAppliedRoutes() []*AppliedRoute {
+	if x != nil {
+		return x.AppliedRoutes
+	}
+	return nil
+}
+
+type AppliedRoute struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	RateLimit     *RateLimitConfig       `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UsernameRequest) Reset() {
-	*x = UsernameRequest{}
+func (x *AppliedRoute) Reset() {
+	*x = AppliedRoute{}
 	mi := &file_plugin_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UsernameRequest) String() string {
+func (x *AppliedRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsernameRequest) ProtoMessage() {}
+func (*AppliedRoute) ProtoMessage() {}
 
-func (x *UsernameRequest) ProtoReflect() protoreflect.Message {
+func (x *AppliedRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,92 +1050,62 @@ func (x *UsernameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsernameRequest.ProtoReflect.Descriptor instead.
-func (*UsernameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppliedRoute.ProtoReflect.Descriptor instead.
+func (*AppliedRoute) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UsernameRequest) GetUsername() string {
+func (x *AppliedRoute) GetMethod() string {
 	if x != nil {
-		return x.Username
+		return x.Method
 	}
 	return ""
 }
 
-type BoolRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BoolRequest) Reset() {
-	*x = BoolRequest{}
-	mi := &file_plugin_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BoolRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BoolRequest) ProtoMessage() {}
-
-func (x *BoolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[6]
+func (x *AppliedRoute) GetPath() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Path
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BoolRequest.ProtoReflect.Descriptor instead.
-func (*BoolRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{6}
+	return ""
 }
 
-func (x *BoolRequest) GetValue() bool {
+func (x *AppliedRoute) GetRateLimit() *RateLimitConfig {
 	if x != nil {
-		return x.Value
+		return x.RateLimit
 	}
-	return false
+	return nil
 }
 
-type PluginInfo struct {
+type RouteThrottled struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Events        []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
-	Routes        []*RouteInfo           `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
-	Schedules     []*ScheduleInfo        `protobuf:"bytes,6,rep,name=schedules,proto3" json:"schedules,omitempty"`
-	Mixins        []*MixinInfo           `protobuf:"bytes,7,rep,name=mixins,proto3" json:"mixins,omitempty"`
-	AddonTypes    []*AddonTypeInfo       `protobuf:"bytes,9,rep,name=addon_types,json=addonTypes,proto3" json:"addon_types,omitempty"`
-	Ui            *PluginUIInfo          `protobuf:"bytes,10,opt,name=ui,proto3" json:"ui,omitempty"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Route         string                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Scope         string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
+	RetryAfterMs  int64                  `protobuf:"varint,8,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
+	Count         uint32                 `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginInfo) Reset() {
-	*x = PluginInfo{}
-	mi := &file_plugin_proto_msgTypes[7]
+func (x *RouteThrottled) Reset() {
+	*x = RouteThrottled{}
+	mi := &file_plugin_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginInfo) String() string {
+func (x *RouteThrottled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PluginInfo) ProtoMessage() {}
+func (*RouteThrottled) ProtoMessage() {}
 
-func (x *PluginInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[7]
+func (x *RouteThrottled) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,100 +1116,99 @@ func (x *PluginInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PluginInfo.ProtoReflect.Descriptor instead.
-func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RouteThrottled.ProtoReflect.Descriptor instead.
+func (*RouteThrottled) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PluginInfo) GetId() string {
+func (x *RouteThrottled) GetMethod() string {
 	if x != nil {
-		return x.Id
+		return x.Method
 	}
 	return ""
 }
 
-func (x *PluginInfo) GetName() string {
+func (x *RouteThrottled) GetPath() string {
 	if x != nil {
-		return x.Name
+		return x.Path
 	}
 	return ""
 }
 
-func (x *PluginInfo) GetVersion() string {
+func (x *RouteThrottled) GetRoute() string {
 	if x != nil {
-		return x.Version
+		return x.Route
 	}
 	return ""
 }
 
-func (x *PluginInfo) GetEvents() []string {
+func (x *RouteThrottled) GetUserId() string {
 	if x != nil {
-		return x.Events
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *PluginInfo) GetRoutes() []*RouteInfo {
+func (x *RouteThrottled) GetClientIp() string {
 	if x != nil {
-		return x.Routes
+		return x.ClientIp
 	}
-	return nil
+	return ""
 }
 
-func (x *PluginInfo) GetSchedules() []*ScheduleInfo {
+func (x *RouteThrottled) GetLimit() int32 {
 	if x != nil {
-		return x.Schedules
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *PluginInfo) GetMixins() []*MixinInfo {
+func (x *RouteThrottled) GetScope() string {
 	if x != nil {
-		return x.Mixins
+		return x.Scope
 	}
-	return nil
+	return ""
 }
 
-func (x *PluginInfo) GetAddonTypes() []*AddonTypeInfo {
+func (x *RouteThrottled) GetRetryAfterMs() int64 {
 	if x != nil {
-		return x.AddonTypes
+		return x.RetryAfterMs
 	}
-	return nil
+	return 0
 }
 
-func (x *PluginInfo) GetUi() *PluginUIInfo {
+func (x *RouteThrottled) GetCount() uint32 {
 	if x != nil {
-		return x.Ui
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type PluginUIInfo struct {
+type HealthReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HasBundle     bool                   `protobuf:"varint,1,opt,name=has_bundle,json=hasBundle,proto3" json:"has_bundle,omitempty"`
-	Pages         []*PluginUIPage        `protobuf:"bytes,2,rep,name=pages,proto3" json:"pages,omitempty"`
-	Tabs          []*PluginUITab         `protobuf:"bytes,4,rep,name=tabs,proto3" json:"tabs,omitempty"`
-	SidebarItems  []*PluginUISidebarItem `protobuf:"bytes,5,rep,name=sidebar_items,json=sidebarItems,proto3" json:"sidebar_items,omitempty"`
-	BundleData    []byte                 `protobuf:"bytes,6,opt,name=bundle_data,json=bundleData,proto3" json:"bundle_data,omitempty"`
+	State         HealthReport_State     `protobuf:"varint,1,opt,name=state,proto3,enum=plugins.HealthReport_State" json:"state,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CheckedUnixMs int64                  `protobuf:"varint,3,opt,name=checked_unix_ms,json=checkedUnixMs,proto3" json:"checked_unix_ms,omitempty"`
+	InitError     string                 `protobuf:"bytes,4,opt,name=init_error,json=initError,proto3" json:"init_error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginUIInfo) Reset() {
-	*x = PluginUIInfo{}
-	mi := &file_plugin_proto_msgTypes[8]
+func (x *HealthReport) Reset() {
+	*x = HealthReport{}
+	mi := &file_plugin_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginUIInfo) String() string {
+func (x *HealthReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PluginUIInfo) ProtoMessage() {}
+func (*HealthReport) ProtoMessage() {}
 
-func (x *PluginUIInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[8]
+func (x *HealthReport) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,72 +1219,62 @@ func (x *PluginUIInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PluginUIInfo.ProtoReflect.Descriptor instead.
-func (*PluginUIInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *PluginUIInfo) GetHasBundle() bool {
-	if x != nil {
-		return x.HasBundle
-	}
-	return false
+// Deprecated: Use HealthReport.ProtoReflect.Descriptor instead.
+func (*HealthReport) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PluginUIInfo) GetPages() []*PluginUIPage {
+func (x *HealthReport) GetState() HealthReport_State {
 	if x != nil {
-		return x.Pages
+		return x.State
 	}
-	return nil
+	return HealthReport_HEALTHY
 }
 
-func (x *PluginUIInfo) GetTabs() []*PluginUITab {
+func (x *HealthReport) GetMessage() string {
 	if x != nil {
-		return x.Tabs
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *PluginUIInfo) GetSidebarItems() []*PluginUISidebarItem {
+func (x *HealthReport) GetCheckedUnixMs() int64 {
 	if x != nil {
-		return x.SidebarItems
+		return x.CheckedUnixMs
 	}
-	return nil
+	return 0
 }
 
-func (x *PluginUIInfo) GetBundleData() []byte {
+func (x *HealthReport) GetInitError() string {
 	if x != nil {
-		return x.BundleData
+		return x.InitError
 	}
-	return nil
+	return ""
 }
 
-type PluginUIPage struct {
+type Ping struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Component     string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
-	Guard         string                 `protobuf:"bytes,5,opt,name=guard,proto3" json:"guard,omitempty"`
+	SentUnixMs    int64                  `protobuf:"varint,1,opt,name=sent_unix_ms,json=sentUnixMs,proto3" json:"sent_unix_ms,omitempty"`
+	Reply         bool                   `protobuf:"varint,2,opt,name=reply,proto3" json:"reply,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginUIPage) Reset() {
-	*x = PluginUIPage{}
-	mi := &file_plugin_proto_msgTypes[9]
+func (x *Ping) Reset() {
+	*x = Ping{}
+	mi := &file_plugin_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginUIPage) String() string {
+func (x *Ping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PluginUIPage) ProtoMessage() {}
+func (*Ping) ProtoMessage() {}
 
-func (x *PluginUIPage) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[9]
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,73 +1285,48 @@ func (x *PluginUIPage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PluginUIPage.ProtoReflect.Descriptor instead.
-func (*PluginUIPage) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *PluginUIPage) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *PluginUIPage) GetComponent() string {
-	if x != nil {
-		return x.Component
-	}
-	return ""
-}
-
-func (x *PluginUIPage) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
+func (*Ping) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PluginUIPage) GetIcon() string {
+func (x *Ping) GetSentUnixMs() int64 {
 	if x != nil {
-		return x.Icon
+		return x.SentUnixMs
 	}
-	return ""
+	return 0
 }
 
-func (x *PluginUIPage) GetGuard() string {
+func (x *Ping) GetReply() bool {
 	if x != nil {
-		return x.Guard
+		return x.Reply
 	}
-	return ""
+	return false
 }
 
-type PluginUITab struct {
+type ApiResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Component     string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
-	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
-	Icon          string                 `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
-	Order         int32                  `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status        []byte                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginUITab) Reset() {
-	*x = PluginUITab{}
-	mi := &file_plugin_proto_msgTypes[10]
+func (x *ApiResult) Reset() {
+	*x = ApiResult{}
+	mi := &file_plugin_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginUITab) String() string {
+func (x *ApiResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PluginUITab) ProtoMessage() {}
+func (*ApiResult) ProtoMessage() {}
 
-func (x *PluginUITab) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[10]
+func (x *ApiResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,82 +1337,47 @@ func (x *PluginUITab) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PluginUITab.ProtoReflect.Descriptor instead.
-func (*PluginUITab) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ApiResult.ProtoReflect.Descriptor instead.
+func (*ApiResult) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PluginUITab) GetId() string {
+func (x *ApiResult) GetPayload() []byte {
 	if x != nil {
-		return x.Id
+		return x.Payload
 	}
-	return ""
-}
-
-func (x *PluginUITab) GetComponent() string {
-	if x != nil {
-		return x.Component
-	}
-	return ""
-}
-
-func (x *PluginUITab) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *PluginUITab) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *PluginUITab) GetIcon() string {
-	if x != nil {
-		return x.Icon
-	}
-	return ""
+	return nil
 }
 
-func (x *PluginUITab) GetOrder() int32 {
+func (x *ApiResult) GetStatus() []byte {
 	if x != nil {
-		return x.Order
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-type PluginUISidebarItem struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label         string                  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Icon          string                  `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	Href          string                  `protobuf:"bytes,4,opt,name=href,proto3" json:"href,omitempty"`
-	Section       string                  `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`
-	Order         int32                   `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
-	Guard         string                  `protobuf:"bytes,7,opt,name=guard,proto3" json:"guard,omitempty"`
-	Children      []*PluginUISidebarChild `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+type ApiBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calls         []*ApiCall             `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginUISidebarItem) Reset() {
-	*x = PluginUISidebarItem{}
-	mi := &file_plugin_proto_msgTypes[11]
+func (x *ApiBatch) Reset() {
+	*x = ApiBatch{}
+	mi := &file_plugin_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginUISidebarItem) String() string {
+func (x *ApiBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PluginUISidebarItem) ProtoMessage() {}
+func (*ApiBatch) ProtoMessage() {}
 
-func (x *PluginUISidebarItem) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[11]
+func (x *ApiBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,89 +1388,83 @@ func (x *PluginUISidebarItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PluginUISidebarItem.ProtoReflect.Descriptor instead.
-func (*PluginUISidebarItem) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ApiBatch.ProtoReflect.Descriptor instead.
+func (*ApiBatch) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PluginUISidebarItem) GetId() string {
+func (x *ApiBatch) GetCalls() []*ApiCall {
 	if x != nil {
-		return x.Id
+		return x.Calls
 	}
-	return ""
+	return nil
 }
 
-func (x *PluginUISidebarItem) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+type ApiBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ApiResult           `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginUISidebarItem) GetIcon() string {
-	if x != nil {
-		return x.Icon
-	}
-	return ""
+func (x *ApiBatchResult) Reset() {
+	*x = ApiBatchResult{}
+	mi := &file_plugin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginUISidebarItem) GetHref() string {
-	if x != nil {
-		return x.Href
-	}
-	return ""
+func (x *ApiBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PluginUISidebarItem) GetSection() string {
-	if x != nil {
-		return x.Section
-	}
-	return ""
-}
+func (*ApiBatchResult) ProtoMessage() {}
 
-func (x *PluginUISidebarItem) GetOrder() int32 {
+func (x *ApiBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[11]
 	if x != nil {
-		return x.Order
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PluginUISidebarItem) GetGuard() string {
-	if x != nil {
-		return x.Guard
-	}
-	return ""
+// Deprecated: Use ApiBatchResult.ProtoReflect.Descriptor instead.
+func (*ApiBatchResult) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PluginUISidebarItem) GetChildren() []*PluginUISidebarChild {
+func (x *ApiBatchResult) GetResults() []*ApiResult {
 	if x != nil {
-		return x.Children
+		return x.Results
 	}
 	return nil
 }
 
-type PluginUISidebarChild struct {
+// Common
+type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Href          string                 `protobuf:"bytes,2,opt,name=href,proto3" json:"href,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PluginUISidebarChild) Reset() {
-	*x = PluginUISidebarChild{}
+func (x *Empty) Reset() {
+	*x = Empty{}
 	mi := &file_plugin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PluginUISidebarChild) String() string {
+func (x *Empty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PluginUISidebarChild) ProtoMessage() {}
+func (*Empty) ProtoMessage() {}
 
-func (x *PluginUISidebarChild) ProtoReflect() protoreflect.Message {
+func (x *Empty) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1145,47 +1476,32 @@ func (x *PluginUISidebarChild) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PluginUISidebarChild.ProtoReflect.Descriptor instead.
-func (*PluginUISidebarChild) Descriptor() ([]byte, []int) {
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PluginUISidebarChild) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *PluginUISidebarChild) GetHref() string {
-	if x != nil {
-		return x.Href
-	}
-	return ""
-}
-
-type MixinInfo struct {
+type IDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MixinInfo) Reset() {
-	*x = MixinInfo{}
+func (x *IDRequest) Reset() {
+	*x = IDRequest{}
 	mi := &file_plugin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MixinInfo) String() string {
+func (x *IDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MixinInfo) ProtoMessage() {}
+func (*IDRequest) ProtoMessage() {}
 
-func (x *MixinInfo) ProtoReflect() protoreflect.Message {
+func (x *IDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1197,49 +1513,39 @@ func (x *MixinInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MixinInfo.ProtoReflect.Descriptor instead.
-func (*MixinInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use IDRequest.ProtoReflect.Descriptor instead.
+func (*IDRequest) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MixinInfo) GetTarget() string {
+func (x *IDRequest) GetId() string {
 	if x != nil {
-		return x.Target
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MixinInfo) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-type MixinRequest struct {
+type EmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Input         []byte                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
-	ChainData     []byte                 `protobuf:"bytes,4,opt,name=chain_data,json=chainData,proto3" json:"chain_data,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MixinRequest) Reset() {
-	*x = MixinRequest{}
+func (x *EmailRequest) Reset() {
+	*x = EmailRequest{}
 	mi := &file_plugin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MixinRequest) String() string {
+func (x *EmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MixinRequest) ProtoMessage() {}
+func (*EmailRequest) ProtoMessage() {}
 
-func (x *MixinRequest) ProtoReflect() protoreflect.Message {
+func (x *EmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,64 +1557,39 @@ func (x *MixinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MixinRequest.ProtoReflect.Descriptor instead.
-func (*MixinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmailRequest.ProtoReflect.Descriptor instead.
+func (*EmailRequest) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MixinRequest) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *MixinRequest) GetRequestId() string {
+func (x *EmailRequest) GetEmail() string {
 	if x != nil {
-		return x.RequestId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *MixinRequest) GetInput() []byte {
-	if x != nil {
-		return x.Input
-	}
-	return nil
-}
-
-func (x *MixinRequest) GetChainData() []byte {
-	if x != nil {
-		return x.ChainData
-	}
-	return nil
-}
-
-type MixinResponse struct {
+type UsernameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Action        MixinResponse_Action   `protobuf:"varint,1,opt,name=action,proto3,enum=plugins.MixinResponse_Action" json:"action,omitempty"`
-	Output        []byte                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	ModifiedInput []byte                 `protobuf:"bytes,4,opt,name=modified_input,json=modifiedInput,proto3" json:"modified_input,omitempty"`
-	Notifications []*Notification        `protobuf:"bytes,5,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MixinResponse) Reset() {
-	*x = MixinResponse{}
+func (x *UsernameRequest) Reset() {
+	*x = UsernameRequest{}
 	mi := &file_plugin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MixinResponse) String() string {
+func (x *UsernameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MixinResponse) ProtoMessage() {}
+func (*UsernameRequest) ProtoMessage() {}
 
-func (x *MixinResponse) ProtoReflect() protoreflect.Message {
+func (x *UsernameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1320,70 +1601,108 @@ func (x *MixinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MixinResponse.ProtoReflect.Descriptor instead.
-func (*MixinResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsernameRequest.ProtoReflect.Descriptor instead.
+func (*UsernameRequest) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MixinResponse) GetAction() MixinResponse_Action {
+func (x *UsernameRequest) GetUsername() string {
 	if x != nil {
-		return x.Action
+		return x.Username
 	}
-	return MixinResponse_NEXT
+	return ""
 }
 
-func (x *MixinResponse) GetOutput() []byte {
-	if x != nil {
-		return x.Output
-	}
-	return nil
+type BoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MixinResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
+func (x *BoolRequest) Reset() {
+	*x = BoolRequest{}
+	mi := &file_plugin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MixinResponse) GetModifiedInput() []byte {
+func (x *BoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolRequest) ProtoMessage() {}
+
+func (x *BoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[16]
 	if x != nil {
-		return x.ModifiedInput
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MixinResponse) GetNotifications() []*Notification {
+// Deprecated: Use BoolRequest.ProtoReflect.Descriptor instead.
+func (*BoolRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BoolRequest) GetValue() bool {
 	if x != nil {
-		return x.Notifications
+		return x.Value
 	}
-	return nil
+	return false
 }
 
-type Notification struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // error, success, info
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PluginInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Events               []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Routes               []*RouteInfo           `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
+	Schedules            []*ScheduleInfo        `protobuf:"bytes,6,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	Mixins               []*MixinInfo           `protobuf:"bytes,7,rep,name=mixins,proto3" json:"mixins,omitempty"`
+	AddonTypes           []*AddonTypeInfo       `protobuf:"bytes,9,rep,name=addon_types,json=addonTypes,proto3" json:"addon_types,omitempty"`
+	Ui                   *PluginUIInfo          `protobuf:"bytes,10,opt,name=ui,proto3" json:"ui,omitempty"`
+	Description          string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	LongDescription      string                 `protobuf:"bytes,12,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
+	Author               string                 `protobuf:"bytes,13,opt,name=author,proto3" json:"author,omitempty"`
+	Website              string                 `protobuf:"bytes,14,opt,name=website,proto3" json:"website,omitempty"`
+	License              string                 `protobuf:"bytes,15,opt,name=license,proto3" json:"license,omitempty"`
+	Icon                 string                 `protobuf:"bytes,16,opt,name=icon,proto3" json:"icon,omitempty"`
+	RequiredPanelVersion string                 `protobuf:"bytes,17,opt,name=required_panel_version,json=requiredPanelVersion,proto3" json:"required_panel_version,omitempty"`
+	RequiredCapabilities []string               `protobuf:"bytes,18,rep,name=required_capabilities,json=requiredCapabilities,proto3" json:"required_capabilities,omitempty"`
+	Dependencies         []*PluginDependency    `protobuf:"bytes,19,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Permissions          []string               `protobuf:"bytes,20,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Flags                []*FlagDeclaration     `protobuf:"bytes,21,rep,name=flags,proto3" json:"flags,omitempty"`
+	ResumeEventSeq       uint64                 `protobuf:"varint,22,opt,name=resume_event_seq,json=resumeEventSeq,proto3" json:"resume_event_seq,omitempty"`
+	InstanceId           string                 `protobuf:"bytes,23,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	ThrottleEvents       bool                   `protobuf:"varint,24,opt,name=throttle_events,json=throttleEvents,proto3" json:"throttle_events,omitempty"`
+	SdkVersion           string                 `protobuf:"bytes,25,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	ProtocolVersion      int32                  `protobuf:"varint,26,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
-	mi := &file_plugin_proto_msgTypes[16]
+func (x *PluginInfo) Reset() {
+	*x = PluginInfo{}
+	mi := &file_plugin_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Notification) String() string {
+func (x *PluginInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*PluginInfo) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[16]
+func (x *PluginInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,175 +1713,210 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PluginInfo.ProtoReflect.Descriptor instead.
+func (*PluginInfo) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Notification) GetTitle() string {
+func (x *PluginInfo) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Notification) GetMessage() string {
+func (x *PluginInfo) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Notification) GetType() string {
+func (x *PluginInfo) GetVersion() string {
 	if x != nil {
-		return x.Type
+		return x.Version
 	}
 	return ""
 }
 
-type RouteInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	RateLimit     *RateLimitConfig       `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PluginInfo) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
 }
 
-func (x *RouteInfo) Reset() {
-	*x = RouteInfo{}
-	mi := &file_plugin_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PluginInfo) GetRoutes() []*RouteInfo {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
 }
 
-func (x *RouteInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PluginInfo) GetSchedules() []*ScheduleInfo {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
 }
 
-func (*RouteInfo) ProtoMessage() {}
+func (x *PluginInfo) GetMixins() []*MixinInfo {
+	if x != nil {
+		return x.Mixins
+	}
+	return nil
+}
 
-func (x *RouteInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[17]
+func (x *PluginInfo) GetAddonTypes() []*AddonTypeInfo {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AddonTypes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RouteInfo.ProtoReflect.Descriptor instead.
-func (*RouteInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{17}
+func (x *PluginInfo) GetUi() *PluginUIInfo {
+	if x != nil {
+		return x.Ui
+	}
+	return nil
 }
 
-func (x *RouteInfo) GetMethod() string {
+func (x *PluginInfo) GetDescription() string {
 	if x != nil {
-		return x.Method
+		return x.Description
 	}
 	return ""
 }
 
-func (x *RouteInfo) GetPath() string {
+func (x *PluginInfo) GetLongDescription() string {
 	if x != nil {
-		return x.Path
+		return x.LongDescription
 	}
 	return ""
 }
 
-func (x *RouteInfo) GetRateLimit() *RateLimitConfig {
+func (x *PluginInfo) GetAuthor() string {
 	if x != nil {
-		return x.RateLimit
+		return x.Author
 	}
-	return nil
+	return ""
 }
 
-type RateLimitConfig struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Preset            string                 `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
-	RequestsPerMinute int32                  `protobuf:"varint,2,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
-	BurstLimit        int32                  `protobuf:"varint,3,opt,name=burst_limit,json=burstLimit,proto3" json:"burst_limit,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *PluginInfo) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
 }
 
-func (x *RateLimitConfig) Reset() {
-	*x = RateLimitConfig{}
-	mi := &file_plugin_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PluginInfo) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
 }
 
-func (x *RateLimitConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PluginInfo) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
 }
 
-func (*RateLimitConfig) ProtoMessage() {}
+func (x *PluginInfo) GetRequiredPanelVersion() string {
+	if x != nil {
+		return x.RequiredPanelVersion
+	}
+	return ""
+}
 
-func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[18]
+func (x *PluginInfo) GetRequiredCapabilities() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RequiredCapabilities
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RateLimitConfig.ProtoReflect.Descriptor instead.
-func (*RateLimitConfig) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{18}
+func (x *PluginInfo) GetDependencies() []*PluginDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
 }
 
-func (x *RateLimitConfig) GetPreset() string {
+func (x *PluginInfo) GetPermissions() []string {
 	if x != nil {
-		return x.Preset
+		return x.Permissions
 	}
-	return ""
+	return nil
 }
 
-func (x *RateLimitConfig) GetRequestsPerMinute() int32 {
+func (x *PluginInfo) GetFlags() []*FlagDeclaration {
 	if x != nil {
-		return x.RequestsPerMinute
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *PluginInfo) GetResumeEventSeq() uint64 {
+	if x != nil {
+		return x.ResumeEventSeq
 	}
 	return 0
 }
 
-func (x *RateLimitConfig) GetBurstLimit() int32 {
+func (x *PluginInfo) GetInstanceId() string {
 	if x != nil {
-		return x.BurstLimit
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetThrottleEvents() bool {
+	if x != nil {
+		return x.ThrottleEvents
+	}
+	return false
+}
+
+func (x *PluginInfo) GetSdkVersion() string {
+	if x != nil {
+		return x.SdkVersion
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
 	}
 	return 0
 }
 
-type ScheduleInfo struct {
+type FlagDeclaration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cron          string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultValue  bool                   `protobuf:"varint,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduleInfo) Reset() {
-	*x = ScheduleInfo{}
-	mi := &file_plugin_proto_msgTypes[19]
+func (x *FlagDeclaration) Reset() {
+	*x = FlagDeclaration{}
+	mi := &file_plugin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduleInfo) String() string {
+func (x *FlagDeclaration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduleInfo) ProtoMessage() {}
+func (*FlagDeclaration) ProtoMessage() {}
 
-func (x *ScheduleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[19]
+func (x *FlagDeclaration) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,50 +1927,54 @@ func (x *ScheduleInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduleInfo.ProtoReflect.Descriptor instead.
-func (*ScheduleInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use FlagDeclaration.ProtoReflect.Descriptor instead.
+func (*FlagDeclaration) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ScheduleInfo) GetId() string {
+func (x *FlagDeclaration) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ScheduleInfo) GetCron() string {
+func (x *FlagDeclaration) GetDescription() string {
 	if x != nil {
-		return x.Cron
+		return x.Description
 	}
 	return ""
 }
 
-type Event struct {
+func (x *FlagDeclaration) GetDefaultValue() bool {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return false
+}
+
+type FlagValues struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Data          map[string]string      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Sync          bool                   `protobuf:"varint,4,opt,name=sync,proto3" json:"sync,omitempty"`
+	Values        map[string]bool        `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_plugin_proto_msgTypes[20]
+func (x *FlagValues) Reset() {
+	*x = FlagValues{}
+	mi := &file_plugin_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *FlagValues) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*FlagValues) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[20]
+func (x *FlagValues) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,61 +1985,101 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use FlagValues.ProtoReflect.Descriptor instead.
+func (*FlagValues) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Event) GetType() string {
+func (x *FlagValues) GetValues() map[string]bool {
 	if x != nil {
-		return x.Type
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-func (x *Event) GetTimestamp() string {
+type PluginDependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Optional      bool                   `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginDependency) Reset() {
+	*x = PluginDependency{}
+	mi := &file_plugin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginDependency) ProtoMessage() {}
+
+func (x *PluginDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[20]
 	if x != nil {
-		return x.Timestamp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginDependency.ProtoReflect.Descriptor instead.
+func (*PluginDependency) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PluginDependency) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
 	}
 	return ""
 }
 
-func (x *Event) GetData() map[string]string {
+func (x *PluginDependency) GetVersion() string {
 	if x != nil {
-		return x.Data
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *Event) GetSync() bool {
+func (x *PluginDependency) GetOptional() bool {
 	if x != nil {
-		return x.Sync
+		return x.Optional
 	}
 	return false
 }
 
-type EventResponse struct {
+type DependencyStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allow         bool                   `protobuf:"varint,1,opt,name=allow,proto3" json:"allow,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Present       bool                   `protobuf:"varint,2,opt,name=present,proto3" json:"present,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventResponse) Reset() {
-	*x = EventResponse{}
+func (x *DependencyStatus) Reset() {
+	*x = DependencyStatus{}
 	mi := &file_plugin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventResponse) String() string {
+func (x *DependencyStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventResponse) ProtoMessage() {}
+func (*DependencyStatus) ProtoMessage() {}
 
-func (x *EventResponse) ProtoReflect() protoreflect.Message {
+func (x *DependencyStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1693,51 +2091,60 @@ func (x *EventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
-func (*EventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DependencyStatus.ProtoReflect.Descriptor instead.
+func (*DependencyStatus) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *EventResponse) GetAllow() bool {
+func (x *DependencyStatus) GetPluginId() string {
 	if x != nil {
-		return x.Allow
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *DependencyStatus) GetPresent() bool {
+	if x != nil {
+		return x.Present
 	}
 	return false
 }
 
-func (x *EventResponse) GetMessage() string {
+func (x *DependencyStatus) GetVersion() string {
 	if x != nil {
-		return x.Message
+		return x.Version
 	}
 	return ""
 }
 
-type HTTPRequest struct {
+type PluginUIInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Query         map[string]string      `protobuf:"bytes,4,rep,name=query,proto3" json:"query,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Body          []byte                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HasBundle     bool                   `protobuf:"varint,1,opt,name=has_bundle,json=hasBundle,proto3" json:"has_bundle,omitempty"`
+	Pages         []*PluginUIPage        `protobuf:"bytes,2,rep,name=pages,proto3" json:"pages,omitempty"`
+	Tabs          []*PluginUITab         `protobuf:"bytes,4,rep,name=tabs,proto3" json:"tabs,omitempty"`
+	SidebarItems  []*PluginUISidebarItem `protobuf:"bytes,5,rep,name=sidebar_items,json=sidebarItems,proto3" json:"sidebar_items,omitempty"`
+	BundleData    []byte                 `protobuf:"bytes,6,opt,name=bundle_data,json=bundleData,proto3" json:"bundle_data,omitempty"`
+	Assets        []*PluginUIAsset       `protobuf:"bytes,7,rep,name=assets,proto3" json:"assets,omitempty"`
+	BundleHash    string                 `protobuf:"bytes,8,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
+	Locales       []*PluginUILocale      `protobuf:"bytes,9,rep,name=locales,proto3" json:"locales,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HTTPRequest) Reset() {
-	*x = HTTPRequest{}
+func (x *PluginUIInfo) Reset() {
+	*x = PluginUIInfo{}
 	mi := &file_plugin_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HTTPRequest) String() string {
+func (x *PluginUIInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HTTPRequest) ProtoMessage() {}
+func (*PluginUIInfo) ProtoMessage() {}
 
-func (x *HTTPRequest) ProtoReflect() protoreflect.Message {
+func (x *PluginUIInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1749,76 +2156,89 @@ func (x *HTTPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HTTPRequest.ProtoReflect.Descriptor instead.
-func (*HTTPRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginUIInfo.ProtoReflect.Descriptor instead.
+func (*PluginUIInfo) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *HTTPRequest) GetMethod() string {
+func (x *PluginUIInfo) GetHasBundle() bool {
 	if x != nil {
-		return x.Method
+		return x.HasBundle
 	}
-	return ""
+	return false
 }
 
-func (x *HTTPRequest) GetPath() string {
+func (x *PluginUIInfo) GetPages() []*PluginUIPage {
 	if x != nil {
-		return x.Path
+		return x.Pages
 	}
-	return ""
+	return nil
 }
 
-func (x *HTTPRequest) GetHeaders() map[string]string {
+func (x *PluginUIInfo) GetTabs() []*PluginUITab {
 	if x != nil {
-		return x.Headers
+		return x.Tabs
 	}
 	return nil
 }
 
-func (x *HTTPRequest) GetQuery() map[string]string {
+func (x *PluginUIInfo) GetSidebarItems() []*PluginUISidebarItem {
 	if x != nil {
-		return x.Query
+		return x.SidebarItems
 	}
 	return nil
 }
 
-func (x *HTTPRequest) GetBody() []byte {
+func (x *PluginUIInfo) GetBundleData() []byte {
 	if x != nil {
-		return x.Body
+		return x.BundleData
 	}
 	return nil
 }
 
-func (x *HTTPRequest) GetUserId() string {
+func (x *PluginUIInfo) GetAssets() []*PluginUIAsset {
 	if x != nil {
-		return x.UserId
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *PluginUIInfo) GetBundleHash() string {
+	if x != nil {
+		return x.BundleHash
 	}
 	return ""
 }
 
-type HTTPResponse struct {
+func (x *PluginUIInfo) GetLocales() []*PluginUILocale {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+type PluginUILocale struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Headers       map[string]string      `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Body          []byte                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Lang          string                 `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Strings       map[string]string      `protobuf:"bytes,2,rep,name=strings,proto3" json:"strings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HTTPResponse) Reset() {
-	*x = HTTPResponse{}
+func (x *PluginUILocale) Reset() {
+	*x = PluginUILocale{}
 	mi := &file_plugin_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HTTPResponse) String() string {
+func (x *PluginUILocale) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HTTPResponse) ProtoMessage() {}
+func (*PluginUILocale) ProtoMessage() {}
 
-func (x *HTTPResponse) ProtoReflect() protoreflect.Message {
+func (x *PluginUILocale) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1830,53 +2250,55 @@ func (x *HTTPResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HTTPResponse.ProtoReflect.Descriptor instead.
-func (*HTTPResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginUILocale.ProtoReflect.Descriptor instead.
+func (*PluginUILocale) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *HTTPResponse) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *HTTPResponse) GetHeaders() map[string]string {
+func (x *PluginUILocale) GetLang() string {
 	if x != nil {
-		return x.Headers
+		return x.Lang
 	}
-	return nil
+	return ""
 }
 
-func (x *HTTPResponse) GetBody() []byte {
+func (x *PluginUILocale) GetStrings() map[string]string {
 	if x != nil {
-		return x.Body
+		return x.Strings
 	}
 	return nil
 }
 
-type ScheduleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Registered struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BundleCache     bool                   `protobuf:"varint,1,opt,name=bundle_cache,json=bundleCache,proto3" json:"bundle_cache,omitempty"`
+	PanelVersion    string                 `protobuf:"bytes,2,opt,name=panel_version,json=panelVersion,proto3" json:"panel_version,omitempty"`
+	Capabilities    []string               `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Dependencies    []*DependencyStatus    `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Permissions     *PermissionGrant       `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Flags           *FlagValues            `protobuf:"bytes,6,opt,name=flags,proto3" json:"flags,omitempty"`
+	Egress          *EgressPolicy          `protobuf:"bytes,7,opt,name=egress,proto3" json:"egress,omitempty"`
+	AppliedRoutes   []*AppliedRoute        `protobuf:"bytes,8,rep,name=applied_routes,json=appliedRoutes,proto3" json:"applied_routes,omitempty"`
+	ProtocolVersion int32                  `protobuf:"varint,9,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Environment     *PanelEnvironment      `protobuf:"bytes,10,opt,name=environment,proto3" json:"environment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ScheduleRequest) Reset() {
-	*x = ScheduleRequest{}
+func (x *Registered) Reset() {
+	*x = Registered{}
 	mi := &file_plugin_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduleRequest) String() string {
+func (x *Registered) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduleRequest) ProtoMessage() {}
+func (*Registered) ProtoMessage() {}
 
-func (x *ScheduleRequest) ProtoReflect() protoreflect.Message {
+func (x *Registered) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1888,50 +2310,105 @@ func (x *ScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduleRequest.ProtoReflect.Descriptor instead.
-func (*ScheduleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Registered.ProtoReflect.Descriptor instead.
+func (*Registered) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ScheduleRequest) GetScheduleId() string {
+func (x *Registered) GetBundleCache() bool {
 	if x != nil {
-		return x.ScheduleId
+		return x.BundleCache
+	}
+	return false
+}
+
+func (x *Registered) GetPanelVersion() string {
+	if x != nil {
+		return x.PanelVersion
 	}
 	return ""
 }
 
-// Server
-type Server struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	UserId            string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NodeId            string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Memory            int32                  `protobuf:"varint,6,opt,name=memory,proto3" json:"memory,omitempty"`
-	Cpu               int32                  `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Disk              int32                  `protobuf:"varint,8,opt,name=disk,proto3" json:"disk,omitempty"`
-	Suspended         bool                   `protobuf:"varint,9,opt,name=suspended,proto3" json:"suspended,omitempty"`
-	PackageId         string                 `protobuf:"bytes,10,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	PrimaryAllocation string                 `protobuf:"bytes,11,opt,name=primary_allocation,json=primaryAllocation,proto3" json:"primary_allocation,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *Registered) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
+func (x *Registered) GetDependencies() []*DependencyStatus {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *Registered) GetPermissions() *PermissionGrant {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Registered) GetFlags() *FlagValues {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Registered) GetEgress() *EgressPolicy {
+	if x != nil {
+		return x.Egress
+	}
+	return nil
+}
+
+func (x *Registered) GetAppliedRoutes() []*AppliedRoute {
+	if x != nil {
+		return x.AppliedRoutes
+	}
+	return nil
+}
+
+func (x *Registered) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Registered) GetEnvironment() *PanelEnvironment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+type PanelEnvironment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppUrl        string                 `protobuf:"bytes,1,opt,name=app_url,json=appUrl,proto3" json:"app_url,omitempty"`
+	DefaultLocale string                 `protobuf:"bytes,2,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Features      map[string]bool        `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PanelEnvironment) Reset() {
+	*x = PanelEnvironment{}
 	mi := &file_plugin_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *PanelEnvironment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*PanelEnvironment) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
+func (x *PanelEnvironment) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1943,112 +2420,61 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelEnvironment.ProtoReflect.Descriptor instead.
+func (*PanelEnvironment) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Server) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Server) GetName() string {
+func (x *PanelEnvironment) GetAppUrl() string {
 	if x != nil {
-		return x.Name
+		return x.AppUrl
 	}
 	return ""
 }
 
-func (x *Server) GetUserId() string {
+func (x *PanelEnvironment) GetDefaultLocale() string {
 	if x != nil {
-		return x.UserId
+		return x.DefaultLocale
 	}
 	return ""
 }
 
-func (x *Server) GetNodeId() string {
+func (x *PanelEnvironment) GetTimezone() string {
 	if x != nil {
-		return x.NodeId
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *Server) GetStatus() string {
+func (x *PanelEnvironment) GetFeatures() map[string]bool {
 	if x != nil {
-		return x.Status
+		return x.Features
 	}
-	return ""
+	return nil
 }
 
-func (x *Server) GetMemory() int32 {
-	if x != nil {
-		return x.Memory
-	}
-	return 0
-}
-
-func (x *Server) GetCpu() int32 {
-	if x != nil {
-		return x.Cpu
-	}
-	return 0
-}
-
-func (x *Server) GetDisk() int32 {
-	if x != nil {
-		return x.Disk
-	}
-	return 0
-}
-
-func (x *Server) GetSuspended() bool {
-	if x != nil {
-		return x.Suspended
-	}
-	return false
-}
-
-func (x *Server) GetPackageId() string {
-	if x != nil {
-		return x.PackageId
-	}
-	return ""
-}
-
-func (x *Server) GetPrimaryAllocation() string {
-	if x != nil {
-		return x.PrimaryAllocation
-	}
-	return ""
-}
-
-type ListServersRequest struct {
+type EgressPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ProxyUrl      string                 `protobuf:"bytes,1,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url,omitempty"`
+	NoProxy       []string               `protobuf:"bytes,2,rep,name=no_proxy,json=noProxy,proto3" json:"no_proxy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListServersRequest) Reset() {
-	*x = ListServersRequest{}
+func (x *EgressPolicy) Reset() {
+	*x = EgressPolicy{}
 	mi := &file_plugin_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServersRequest) String() string {
+func (x *EgressPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServersRequest) ProtoMessage() {}
+func (*EgressPolicy) ProtoMessage() {}
 
-func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
+func (x *EgressPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2060,61 +2486,47 @@ func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServersRequest.ProtoReflect.Descriptor instead.
-func (*ListServersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EgressPolicy.ProtoReflect.Descriptor instead.
+func (*EgressPolicy) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ListServersRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *ListServersRequest) GetNodeId() string {
+func (x *EgressPolicy) GetProxyUrl() string {
 	if x != nil {
-		return x.NodeId
+		return x.ProxyUrl
 	}
 	return ""
 }
 
-func (x *ListServersRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListServersRequest) GetOffset() int32 {
+func (x *EgressPolicy) GetNoProxy() []string {
 	if x != nil {
-		return x.Offset
+		return x.NoProxy
 	}
-	return 0
+	return nil
 }
 
-type ListServersResponse struct {
+type PermissionGrant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Servers       []*Server              `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Granted       []string               `protobuf:"bytes,1,rep,name=granted,proto3" json:"granted,omitempty"`
+	ApiToken      string                 `protobuf:"bytes,2,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListServersResponse) Reset() {
-	*x = ListServersResponse{}
+func (x *PermissionGrant) Reset() {
+	*x = PermissionGrant{}
 	mi := &file_plugin_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServersResponse) String() string {
+func (x *PermissionGrant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServersResponse) ProtoMessage() {}
+func (*PermissionGrant) ProtoMessage() {}
 
-func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
+func (x *PermissionGrant) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2126,52 +2538,46 @@ func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServersResponse.ProtoReflect.Descriptor instead.
-func (*ListServersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PermissionGrant.ProtoReflect.Descriptor instead.
+func (*PermissionGrant) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListServersResponse) GetServers() []*Server {
+func (x *PermissionGrant) GetGranted() []string {
 	if x != nil {
-		return x.Servers
+		return x.Granted
 	}
 	return nil
 }
 
-func (x *ListServersResponse) GetTotal() int32 {
+func (x *PermissionGrant) GetApiToken() string {
 	if x != nil {
-		return x.Total
+		return x.ApiToken
 	}
-	return 0
+	return ""
 }
 
-type CreateServerRequest struct {
+type BundleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	PackageId     string                 `protobuf:"bytes,4,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	Memory        int32                  `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
-	Cpu           int32                  `protobuf:"varint,6,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Disk          int32                  `protobuf:"varint,7,opt,name=disk,proto3" json:"disk,omitempty"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateServerRequest) Reset() {
-	*x = CreateServerRequest{}
+func (x *BundleRequest) Reset() {
+	*x = BundleRequest{}
 	mi := &file_plugin_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateServerRequest) String() string {
+func (x *BundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateServerRequest) ProtoMessage() {}
+func (*BundleRequest) ProtoMessage() {}
 
-func (x *CreateServerRequest) ProtoReflect() protoreflect.Message {
+func (x *BundleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2183,86 +2589,42 @@ func (x *CreateServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateServerRequest.ProtoReflect.Descriptor instead.
-func (*CreateServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BundleRequest.ProtoReflect.Descriptor instead.
+func (*BundleRequest) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateServerRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateServerRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *CreateServerRequest) GetNodeId() string {
+func (x *BundleRequest) GetHash() string {
 	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *CreateServerRequest) GetPackageId() string {
-	if x != nil {
-		return x.PackageId
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *CreateServerRequest) GetMemory() int32 {
-	if x != nil {
-		return x.Memory
-	}
-	return 0
-}
-
-func (x *CreateServerRequest) GetCpu() int32 {
-	if x != nil {
-		return x.Cpu
-	}
-	return 0
-}
-
-func (x *CreateServerRequest) GetDisk() int32 {
-	if x != nil {
-		return x.Disk
-	}
-	return 0
-}
-
-type UpdateServerRequest struct {
+type BundleUpload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Memory        int32                  `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	Cpu           int32                  `protobuf:"varint,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Disk          int32                  `protobuf:"varint,5,opt,name=disk,proto3" json:"disk,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	BundleData    []byte                 `protobuf:"bytes,3,opt,name=bundle_data,json=bundleData,proto3" json:"bundle_data,omitempty"`
+	Assets        []*PluginUIAsset       `protobuf:"bytes,4,rep,name=assets,proto3" json:"assets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateServerRequest) Reset() {
-	*x = UpdateServerRequest{}
+func (x *BundleUpload) Reset() {
+	*x = BundleUpload{}
 	mi := &file_plugin_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateServerRequest) String() string {
+func (x *BundleUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateServerRequest) ProtoMessage() {}
+func (*BundleUpload) ProtoMessage() {}
 
-func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
+func (x *BundleUpload) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2274,75 +2636,64 @@ func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateServerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BundleUpload.ProtoReflect.Descriptor instead.
+func (*BundleUpload) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateServerRequest) GetId() string {
+func (x *BundleUpload) GetHash() string {
 	if x != nil {
-		return x.Id
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *UpdateServerRequest) GetName() string {
+func (x *BundleUpload) GetEncoding() string {
 	if x != nil {
-		return x.Name
+		return x.Encoding
 	}
 	return ""
 }
 
-func (x *UpdateServerRequest) GetMemory() int32 {
-	if x != nil {
-		return x.Memory
-	}
-	return 0
-}
-
-func (x *UpdateServerRequest) GetCpu() int32 {
-	if x != nil {
-		return x.Cpu
-	}
-	return 0
-}
-
-func (x *UpdateServerRequest) GetDisk() int32 {
+func (x *BundleUpload) GetBundleData() []byte {
 	if x != nil {
-		return x.Disk
+		return x.BundleData
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateServerRequest) GetUserId() string {
+func (x *BundleUpload) GetAssets() []*PluginUIAsset {
 	if x != nil {
-		return x.UserId
+		return x.Assets
 	}
-	return ""
+	return nil
 }
 
-type TransferServerRequest struct {
+type BundleChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	TargetNodeId  string                 `protobuf:"bytes,2,opt,name=target_node_id,json=targetNodeId,proto3" json:"target_node_id,omitempty"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Eof           bool                   `protobuf:"varint,4,opt,name=eof,proto3" json:"eof,omitempty"`
+	Checksum      string                 `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferServerRequest) Reset() {
-	*x = TransferServerRequest{}
+func (x *BundleChunk) Reset() {
+	*x = BundleChunk{}
 	mi := &file_plugin_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferServerRequest) String() string {
+func (x *BundleChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferServerRequest) ProtoMessage() {}
+func (*BundleChunk) ProtoMessage() {}
 
-func (x *TransferServerRequest) ProtoReflect() protoreflect.Message {
+func (x *BundleChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2354,47 +2705,69 @@ func (x *TransferServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferServerRequest.ProtoReflect.Descriptor instead.
-func (*TransferServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BundleChunk.ProtoReflect.Descriptor instead.
+func (*BundleChunk) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *TransferServerRequest) GetServerId() string {
+func (x *BundleChunk) GetHash() string {
 	if x != nil {
-		return x.ServerId
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *TransferServerRequest) GetTargetNodeId() string {
+func (x *BundleChunk) GetTotalSize() int64 {
 	if x != nil {
-		return x.TargetNodeId
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *BundleChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BundleChunk) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
+func (x *BundleChunk) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
 	}
 	return ""
 }
 
-type ConsoleLogRequest struct {
+type BundleUploadResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Lines         int32                  `protobuf:"varint,2,opt,name=lines,proto3" json:"lines,omitempty"`
+	Stored        bool                   `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"`
+	Skipped       bool                   `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsoleLogRequest) Reset() {
-	*x = ConsoleLogRequest{}
+func (x *BundleUploadResult) Reset() {
+	*x = BundleUploadResult{}
 	mi := &file_plugin_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsoleLogRequest) String() string {
+func (x *BundleUploadResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsoleLogRequest) ProtoMessage() {}
+func (*BundleUploadResult) ProtoMessage() {}
 
-func (x *ConsoleLogRequest) ProtoReflect() protoreflect.Message {
+func (x *BundleUploadResult) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2406,46 +2779,54 @@ func (x *ConsoleLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsoleLogRequest.ProtoReflect.Descriptor instead.
-func (*ConsoleLogRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BundleUploadResult.ProtoReflect.Descriptor instead.
+func (*BundleUploadResult) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ConsoleLogRequest) GetServerId() string {
+func (x *BundleUploadResult) GetStored() bool {
 	if x != nil {
-		return x.ServerId
+		return x.Stored
 	}
-	return ""
+	return false
 }
 
-func (x *ConsoleLogRequest) GetLines() int32 {
+func (x *BundleUploadResult) GetSkipped() bool {
 	if x != nil {
-		return x.Lines
+		return x.Skipped
 	}
-	return 0
+	return false
 }
 
-type ConsoleLogResponse struct {
+func (x *BundleUploadResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BundleUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lines         []string               `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	BundleData    []byte                 `protobuf:"bytes,1,opt,name=bundle_data,json=bundleData,proto3" json:"bundle_data,omitempty"`
+	Assets        []*PluginUIAsset       `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsoleLogResponse) Reset() {
-	*x = ConsoleLogResponse{}
+func (x *BundleUpdate) Reset() {
+	*x = BundleUpdate{}
 	mi := &file_plugin_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsoleLogResponse) String() string {
+func (x *BundleUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsoleLogResponse) ProtoMessage() {}
+func (*BundleUpdate) ProtoMessage() {}
 
-func (x *ConsoleLogResponse) ProtoReflect() protoreflect.Message {
+func (x *BundleUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2457,40 +2838,48 @@ func (x *ConsoleLogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsoleLogResponse.ProtoReflect.Descriptor instead.
-func (*ConsoleLogResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BundleUpdate.ProtoReflect.Descriptor instead.
+func (*BundleUpdate) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ConsoleLogResponse) GetLines() []string {
+func (x *BundleUpdate) GetBundleData() []byte {
 	if x != nil {
-		return x.Lines
+		return x.BundleData
 	}
 	return nil
 }
 
-type SendCommandRequest struct {
+func (x *BundleUpdate) GetAssets() []*PluginUIAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type PluginUIAsset struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendCommandRequest) Reset() {
-	*x = SendCommandRequest{}
+func (x *PluginUIAsset) Reset() {
+	*x = PluginUIAsset{}
 	mi := &file_plugin_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendCommandRequest) String() string {
+func (x *PluginUIAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendCommandRequest) ProtoMessage() {}
+func (*PluginUIAsset) ProtoMessage() {}
 
-func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
+func (x *PluginUIAsset) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2502,52 +2891,58 @@ func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
-func (*SendCommandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginUIAsset.ProtoReflect.Descriptor instead.
+func (*PluginUIAsset) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SendCommandRequest) GetServerId() string {
+func (x *PluginUIAsset) GetPath() string {
 	if x != nil {
-		return x.ServerId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *SendCommandRequest) GetCommand() string {
+func (x *PluginUIAsset) GetContentType() string {
 	if x != nil {
-		return x.Command
+		return x.ContentType
 	}
 	return ""
 }
 
-type ServerStats struct {
+func (x *PluginUIAsset) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PluginUIPage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemoryBytes   int64                  `protobuf:"varint,1,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	MemoryLimit   int64                  `protobuf:"varint,2,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
-	CpuPercent    float64                `protobuf:"fixed64,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
-	DiskBytes     int64                  `protobuf:"varint,4,opt,name=disk_bytes,json=diskBytes,proto3" json:"disk_bytes,omitempty"`
-	NetworkRx     int64                  `protobuf:"varint,5,opt,name=network_rx,json=networkRx,proto3" json:"network_rx,omitempty"`
-	NetworkTx     int64                  `protobuf:"varint,6,opt,name=network_tx,json=networkTx,proto3" json:"network_tx,omitempty"`
-	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Component     string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Guard         string                 `protobuf:"bytes,5,opt,name=guard,proto3" json:"guard,omitempty"`
+	Form          *PluginUIForm          `protobuf:"bytes,6,opt,name=form,proto3" json:"form,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerStats) Reset() {
-	*x = ServerStats{}
+func (x *PluginUIPage) Reset() {
+	*x = PluginUIPage{}
 	mi := &file_plugin_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerStats) String() string {
+func (x *PluginUIPage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStats) ProtoMessage() {}
+func (*PluginUIPage) ProtoMessage() {}
 
-func (x *ServerStats) ProtoReflect() protoreflect.Message {
+func (x *PluginUIPage) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2559,82 +2954,77 @@ func (x *ServerStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerStats.ProtoReflect.Descriptor instead.
-func (*ServerStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginUIPage.ProtoReflect.Descriptor instead.
+func (*PluginUIPage) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ServerStats) GetMemoryBytes() int64 {
-	if x != nil {
-		return x.MemoryBytes
-	}
-	return 0
-}
-
-func (x *ServerStats) GetMemoryLimit() int64 {
+func (x *PluginUIPage) GetPath() string {
 	if x != nil {
-		return x.MemoryLimit
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *ServerStats) GetCpuPercent() float64 {
+func (x *PluginUIPage) GetComponent() string {
 	if x != nil {
-		return x.CpuPercent
+		return x.Component
 	}
-	return 0
+	return ""
 }
 
-func (x *ServerStats) GetDiskBytes() int64 {
+func (x *PluginUIPage) GetTitle() string {
 	if x != nil {
-		return x.DiskBytes
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *ServerStats) GetNetworkRx() int64 {
+func (x *PluginUIPage) GetIcon() string {
 	if x != nil {
-		return x.NetworkRx
+		return x.Icon
 	}
-	return 0
+	return ""
 }
 
-func (x *ServerStats) GetNetworkTx() int64 {
+func (x *PluginUIPage) GetGuard() string {
 	if x != nil {
-		return x.NetworkTx
+		return x.Guard
 	}
-	return 0
+	return ""
 }
 
-func (x *ServerStats) GetState() string {
+func (x *PluginUIPage) GetForm() *PluginUIForm {
 	if x != nil {
-		return x.State
+		return x.Form
 	}
-	return ""
+	return nil
 }
 
-type AllocationRequest struct {
+type PluginUIForm struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	SubmitMethod  string                 `protobuf:"bytes,1,opt,name=submit_method,json=submitMethod,proto3" json:"submit_method,omitempty"`
+	SubmitPath    string                 `protobuf:"bytes,2,opt,name=submit_path,json=submitPath,proto3" json:"submit_path,omitempty"`
+	SubmitLabel   string                 `protobuf:"bytes,3,opt,name=submit_label,json=submitLabel,proto3" json:"submit_label,omitempty"`
+	Fields        []*PluginUIFormField   `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllocationRequest) Reset() {
-	*x = AllocationRequest{}
+func (x *PluginUIForm) Reset() {
+	*x = PluginUIForm{}
 	mi := &file_plugin_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllocationRequest) String() string {
+func (x *PluginUIForm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllocationRequest) ProtoMessage() {}
+func (*PluginUIForm) ProtoMessage() {}
 
-func (x *AllocationRequest) ProtoReflect() protoreflect.Message {
+func (x *PluginUIForm) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2646,48 +3036,72 @@ func (x *AllocationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllocationRequest.ProtoReflect.Descriptor instead.
-func (*AllocationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginUIForm.ProtoReflect.Descriptor instead.
+func (*PluginUIForm) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AllocationRequest) GetServerId() string {
+func (x *PluginUIForm) GetSubmitMethod() string {
 	if x != nil {
-		return x.ServerId
+		return x.SubmitMethod
 	}
 	return ""
 }
 
-func (x *AllocationRequest) GetPort() int32 {
+func (x *PluginUIForm) GetSubmitPath() string {
 	if x != nil {
-		return x.Port
+		return x.SubmitPath
 	}
-	return 0
+	return ""
 }
 
-type CompressRequest struct {
+func (x *PluginUIForm) GetSubmitLabel() string {
+	if x != nil {
+		return x.SubmitLabel
+	}
+	return ""
+}
+
+func (x *PluginUIForm) GetFields() []*PluginUIFormField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type PluginUIFormField struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Paths         []string               `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
-	Destination   string                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Secret        bool                   `protobuf:"varint,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	HasMin        bool                   `protobuf:"varint,7,opt,name=has_min,json=hasMin,proto3" json:"has_min,omitempty"`
+	Min           float64                `protobuf:"fixed64,8,opt,name=min,proto3" json:"min,omitempty"`
+	HasMax        bool                   `protobuf:"varint,9,opt,name=has_max,json=hasMax,proto3" json:"has_max,omitempty"`
+	Max           float64                `protobuf:"fixed64,10,opt,name=max,proto3" json:"max,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,11,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,12,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	Description   string                 `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompressRequest) Reset() {
-	*x = CompressRequest{}
+func (x *PluginUIFormField) Reset() {
+	*x = PluginUIFormField{}
 	mi := &file_plugin_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompressRequest) String() string {
+func (x *PluginUIFormField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompressRequest) ProtoMessage() {}
+func (*PluginUIFormField) ProtoMessage() {}
 
-func (x *CompressRequest) ProtoReflect() protoreflect.Message {
+func (x *PluginUIFormField) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2699,108 +3113,131 @@ func (x *CompressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompressRequest.ProtoReflect.Descriptor instead.
-func (*CompressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginUIFormField.ProtoReflect.Descriptor instead.
+func (*PluginUIFormField) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CompressRequest) GetServerId() string {
+func (x *PluginUIFormField) GetType() string {
 	if x != nil {
-		return x.ServerId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *CompressRequest) GetPaths() []string {
+func (x *PluginUIFormField) GetName() string {
 	if x != nil {
-		return x.Paths
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CompressRequest) GetDestination() string {
+func (x *PluginUIFormField) GetLabel() string {
 	if x != nil {
-		return x.Destination
+		return x.Label
 	}
 	return ""
 }
 
-type UpdateVariablesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Variables     map[string]string      `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PluginUIFormField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
 }
 
-func (x *UpdateVariablesRequest) Reset() {
-	*x = UpdateVariablesRequest{}
-	mi := &file_plugin_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PluginUIFormField) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
 }
 
-func (x *UpdateVariablesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PluginUIFormField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
 }
 
-func (*UpdateVariablesRequest) ProtoMessage() {}
+func (x *PluginUIFormField) GetHasMin() bool {
+	if x != nil {
+		return x.HasMin
+	}
+	return false
+}
 
-func (x *UpdateVariablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[37]
+func (x *PluginUIFormField) GetMin() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Min
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateVariablesRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVariablesRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{37}
+func (x *PluginUIFormField) GetHasMax() bool {
+	if x != nil {
+		return x.HasMax
+	}
+	return false
 }
 
-func (x *UpdateVariablesRequest) GetServerId() string {
+func (x *PluginUIFormField) GetMax() float64 {
 	if x != nil {
-		return x.ServerId
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PluginUIFormField) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
 	}
 	return ""
 }
 
-func (x *UpdateVariablesRequest) GetVariables() map[string]string {
+func (x *PluginUIFormField) GetPlaceholder() string {
 	if x != nil {
-		return x.Variables
+		return x.Placeholder
 	}
-	return nil
+	return ""
 }
 
-type StreamConsoleRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ServerId       string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	IncludeHistory bool                   `protobuf:"varint,2,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
-	HistoryLines   int32                  `protobuf:"varint,3,opt,name=history_lines,json=historyLines,proto3" json:"history_lines,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PluginUIFormField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *StreamConsoleRequest) Reset() {
-	*x = StreamConsoleRequest{}
-	mi := &file_plugin_proto_msgTypes[38]
+type PluginUITab struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Component     string                 `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Icon          string                 `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Order         int32                  `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
+	VisibleWhen   []*PluginUICondition   `protobuf:"bytes,7,rep,name=visible_when,json=visibleWhen,proto3" json:"visible_when,omitempty"`
+	Badge         *PluginUITabBadge      `protobuf:"bytes,8,opt,name=badge,proto3" json:"badge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginUITab) Reset() {
+	*x = PluginUITab{}
+	mi := &file_plugin_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamConsoleRequest) String() string {
+func (x *PluginUITab) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamConsoleRequest) ProtoMessage() {}
+func (*PluginUITab) ProtoMessage() {}
 
-func (x *StreamConsoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[38]
+func (x *PluginUITab) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2811,107 +3248,90 @@ func (x *StreamConsoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamConsoleRequest.ProtoReflect.Descriptor instead.
-func (*StreamConsoleRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use PluginUITab.ProtoReflect.Descriptor instead.
+func (*PluginUITab) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *StreamConsoleRequest) GetServerId() string {
+func (x *PluginUITab) GetId() string {
 	if x != nil {
-		return x.ServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StreamConsoleRequest) GetIncludeHistory() bool {
+func (x *PluginUITab) GetComponent() string {
 	if x != nil {
-		return x.IncludeHistory
+		return x.Component
 	}
-	return false
+	return ""
 }
 
-func (x *StreamConsoleRequest) GetHistoryLines() int32 {
+func (x *PluginUITab) GetTarget() string {
 	if x != nil {
-		return x.HistoryLines
+		return x.Target
 	}
-	return 0
-}
-
-type ConsoleLine struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ConsoleLine) Reset() {
-	*x = ConsoleLine{}
-	mi := &file_plugin_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *ConsoleLine) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PluginUITab) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (*ConsoleLine) ProtoMessage() {}
-
-func (x *ConsoleLine) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[39]
+func (x *PluginUITab) GetIcon() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Icon
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ConsoleLine.ProtoReflect.Descriptor instead.
-func (*ConsoleLine) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{39}
+func (x *PluginUITab) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
 }
 
-func (x *ConsoleLine) GetLine() string {
+func (x *PluginUITab) GetVisibleWhen() []*PluginUICondition {
 	if x != nil {
-		return x.Line
+		return x.VisibleWhen
 	}
-	return ""
+	return nil
 }
 
-func (x *ConsoleLine) GetTimestamp() int64 {
+func (x *PluginUITab) GetBadge() *PluginUITabBadge {
 	if x != nil {
-		return x.Timestamp
+		return x.Badge
 	}
-	return 0
+	return nil
 }
 
-type FullLogResponse struct {
+type PluginUITabBadge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Route         string                 `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FullLogResponse) Reset() {
-	*x = FullLogResponse{}
-	mi := &file_plugin_proto_msgTypes[40]
+func (x *PluginUITabBadge) Reset() {
+	*x = PluginUITabBadge{}
+	mi := &file_plugin_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FullLogResponse) String() string {
+func (x *PluginUITabBadge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FullLogResponse) ProtoMessage() {}
+func (*PluginUITabBadge) ProtoMessage() {}
 
-func (x *FullLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[40]
+func (x *PluginUITabBadge) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2922,51 +3342,56 @@ func (x *FullLogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FullLogResponse.ProtoReflect.Descriptor instead.
-func (*FullLogResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use PluginUITabBadge.ProtoReflect.Descriptor instead.
+func (*PluginUITabBadge) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *FullLogResponse) GetContent() []byte {
+func (x *PluginUITabBadge) GetRoute() string {
 	if x != nil {
-		return x.Content
+		return x.Route
 	}
-	return nil
+	return ""
 }
 
-func (x *FullLogResponse) GetSize() int64 {
+func (x *PluginUITabBadge) GetColor() string {
 	if x != nil {
-		return x.Size
+		return x.Color
 	}
-	return 0
+	return ""
 }
 
-type SearchLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Regex         bool                   `protobuf:"varint,3,opt,name=regex,proto3" json:"regex,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Since         int64                  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`
+type PluginUISidebarItem struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Icon          string                  `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Href          string                  `protobuf:"bytes,4,opt,name=href,proto3" json:"href,omitempty"`
+	Section       string                  `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`
+	Order         int32                   `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
+	Guard         string                  `protobuf:"bytes,7,opt,name=guard,proto3" json:"guard,omitempty"`
+	Children      []*PluginUISidebarChild `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	VisibleWhen   []*PluginUICondition    `protobuf:"bytes,9,rep,name=visible_when,json=visibleWhen,proto3" json:"visible_when,omitempty"`
+	Permission    string                  `protobuf:"bytes,10,opt,name=permission,proto3" json:"permission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchLogsRequest) Reset() {
-	*x = SearchLogsRequest{}
-	mi := &file_plugin_proto_msgTypes[41]
+func (x *PluginUISidebarItem) Reset() {
+	*x = PluginUISidebarItem{}
+	mi := &file_plugin_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchLogsRequest) String() string {
+func (x *PluginUISidebarItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchLogsRequest) ProtoMessage() {}
+func (*PluginUISidebarItem) ProtoMessage() {}
 
-func (x *SearchLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[41]
+func (x *PluginUISidebarItem) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2977,114 +3402,106 @@ func (x *SearchLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchLogsRequest.ProtoReflect.Descriptor instead.
-func (*SearchLogsRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use PluginUISidebarItem.ProtoReflect.Descriptor instead.
+func (*PluginUISidebarItem) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SearchLogsRequest) GetServerId() string {
+func (x *PluginUISidebarItem) GetId() string {
 	if x != nil {
-		return x.ServerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SearchLogsRequest) GetPattern() string {
+func (x *PluginUISidebarItem) GetLabel() string {
 	if x != nil {
-		return x.Pattern
+		return x.Label
 	}
 	return ""
 }
 
-func (x *SearchLogsRequest) GetRegex() bool {
+func (x *PluginUISidebarItem) GetIcon() string {
 	if x != nil {
-		return x.Regex
+		return x.Icon
 	}
-	return false
+	return ""
 }
 
-func (x *SearchLogsRequest) GetLimit() int32 {
+func (x *PluginUISidebarItem) GetHref() string {
 	if x != nil {
-		return x.Limit
+		return x.Href
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchLogsRequest) GetSince() int64 {
+func (x *PluginUISidebarItem) GetSection() string {
 	if x != nil {
-		return x.Since
+		return x.Section
 	}
-	return 0
-}
-
-type SearchLogsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*LogMatch            `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *SearchLogsResponse) Reset() {
-	*x = SearchLogsResponse{}
-	mi := &file_plugin_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PluginUISidebarItem) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
 }
 
-func (x *SearchLogsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PluginUISidebarItem) GetGuard() string {
+	if x != nil {
+		return x.Guard
+	}
+	return ""
 }
 
-func (*SearchLogsResponse) ProtoMessage() {}
-
-func (x *SearchLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[42]
+func (x *PluginUISidebarItem) GetChildren() []*PluginUISidebarChild {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Children
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SearchLogsResponse.ProtoReflect.Descriptor instead.
-func (*SearchLogsResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{42}
+func (x *PluginUISidebarItem) GetVisibleWhen() []*PluginUICondition {
+	if x != nil {
+		return x.VisibleWhen
+	}
+	return nil
 }
 
-func (x *SearchLogsResponse) GetMatches() []*LogMatch {
+func (x *PluginUISidebarItem) GetPermission() string {
 	if x != nil {
-		return x.Matches
+		return x.Permission
 	}
-	return nil
+	return ""
 }
 
-type LogMatch struct {
+type PluginUICondition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
-	LineNumber    int32                  `protobuf:"varint,2,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // variable, flag, guard
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Op            string                 `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogMatch) Reset() {
-	*x = LogMatch{}
-	mi := &file_plugin_proto_msgTypes[43]
+func (x *PluginUICondition) Reset() {
+	*x = PluginUICondition{}
+	mi := &file_plugin_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogMatch) String() string {
+func (x *PluginUICondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogMatch) ProtoMessage() {}
+func (*PluginUICondition) ProtoMessage() {}
 
-func (x *LogMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[43]
+func (x *PluginUICondition) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3095,54 +3512,62 @@ func (x *LogMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogMatch.ProtoReflect.Descriptor instead.
-func (*LogMatch) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use PluginUICondition.ProtoReflect.Descriptor instead.
+func (*PluginUICondition) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LogMatch) GetLine() string {
+func (x *PluginUICondition) GetType() string {
 	if x != nil {
-		return x.Line
+		return x.Type
 	}
 	return ""
 }
 
-func (x *LogMatch) GetLineNumber() int32 {
+func (x *PluginUICondition) GetKey() string {
 	if x != nil {
-		return x.LineNumber
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *LogMatch) GetTimestamp() int64 {
+func (x *PluginUICondition) GetOp() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Op
 	}
-	return 0
+	return ""
 }
 
-type LogFilesResponse struct {
+func (x *PluginUICondition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PluginUISidebarChild struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Files         []*LogFileInfo         `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Href          string                 `protobuf:"bytes,2,opt,name=href,proto3" json:"href,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogFilesResponse) Reset() {
-	*x = LogFilesResponse{}
-	mi := &file_plugin_proto_msgTypes[44]
+func (x *PluginUISidebarChild) Reset() {
+	*x = PluginUISidebarChild{}
+	mi := &file_plugin_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogFilesResponse) String() string {
+func (x *PluginUISidebarChild) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogFilesResponse) ProtoMessage() {}
+func (*PluginUISidebarChild) ProtoMessage() {}
 
-func (x *LogFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[44]
+func (x *PluginUISidebarChild) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3153,42 +3578,48 @@ func (x *LogFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogFilesResponse.ProtoReflect.Descriptor instead.
-func (*LogFilesResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use PluginUISidebarChild.ProtoReflect.Descriptor instead.
+func (*PluginUISidebarChild) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *LogFilesResponse) GetFiles() []*LogFileInfo {
+func (x *PluginUISidebarChild) GetLabel() string {
 	if x != nil {
-		return x.Files
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-type LogFileInfo struct {
+func (x *PluginUISidebarChild) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+type MixinInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Modified      string                 `protobuf:"bytes,3,opt,name=modified,proto3" json:"modified,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogFileInfo) Reset() {
-	*x = LogFileInfo{}
-	mi := &file_plugin_proto_msgTypes[45]
+func (x *MixinInfo) Reset() {
+	*x = MixinInfo{}
+	mi := &file_plugin_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogFileInfo) String() string {
+func (x *MixinInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogFileInfo) ProtoMessage() {}
+func (*MixinInfo) ProtoMessage() {}
 
-func (x *LogFileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[45]
+func (x *MixinInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3199,55 +3630,52 @@ func (x *LogFileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogFileInfo.ProtoReflect.Descriptor instead.
-func (*LogFileInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use MixinInfo.ProtoReflect.Descriptor instead.
+func (*MixinInfo) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *LogFileInfo) GetName() string {
+func (x *MixinInfo) GetTarget() string {
 	if x != nil {
-		return x.Name
+		return x.Target
 	}
 	return ""
 }
 
-func (x *LogFileInfo) GetSize() int64 {
+func (x *MixinInfo) GetPriority() int32 {
 	if x != nil {
-		return x.Size
+		return x.Priority
 	}
 	return 0
 }
 
-func (x *LogFileInfo) GetModified() string {
-	if x != nil {
-		return x.Modified
-	}
-	return ""
-}
-
-type ReadLogFileRequest struct {
+type MixinRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Input         []byte                 `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	ChainData     []byte                 `protobuf:"bytes,4,opt,name=chain_data,json=chainData,proto3" json:"chain_data,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Output        []byte                 `protobuf:"bytes,6,opt,name=output,proto3" json:"output,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadLogFileRequest) Reset() {
-	*x = ReadLogFileRequest{}
-	mi := &file_plugin_proto_msgTypes[46]
+func (x *MixinRequest) Reset() {
+	*x = MixinRequest{}
+	mi := &file_plugin_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadLogFileRequest) String() string {
+func (x *MixinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadLogFileRequest) ProtoMessage() {}
+func (*MixinRequest) ProtoMessage() {}
 
-func (x *ReadLogFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[46]
+func (x *MixinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3258,58 +3686,82 @@ func (x *ReadLogFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadLogFileRequest.ProtoReflect.Descriptor instead.
-func (*ReadLogFileRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use MixinRequest.ProtoReflect.Descriptor instead.
+func (*MixinRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ReadLogFileRequest) GetServerId() string {
+func (x *MixinRequest) GetTarget() string {
 	if x != nil {
-		return x.ServerId
+		return x.Target
 	}
 	return ""
 }
 
-func (x *ReadLogFileRequest) GetFilename() string {
+func (x *MixinRequest) GetRequestId() string {
 	if x != nil {
-		return x.Filename
+		return x.RequestId
 	}
 	return ""
 }
 
-// User
-type User struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username           string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email              string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	IsAdmin            bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
-	IsBanned           bool                   `protobuf:"varint,5,opt,name=is_banned,json=isBanned,proto3" json:"is_banned,omitempty"`
-	RamLimit           int32                  `protobuf:"varint,6,opt,name=ram_limit,json=ramLimit,proto3" json:"ram_limit,omitempty"`
-	CpuLimit           int32                  `protobuf:"varint,7,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`
-	DiskLimit          int32                  `protobuf:"varint,8,opt,name=disk_limit,json=diskLimit,proto3" json:"disk_limit,omitempty"`
-	ServerLimit        int32                  `protobuf:"varint,9,opt,name=server_limit,json=serverLimit,proto3" json:"server_limit,omitempty"`
-	ForcePasswordReset bool                   `protobuf:"varint,10,opt,name=force_password_reset,json=forcePasswordReset,proto3" json:"force_password_reset,omitempty"`
-	CreatedAt          string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *MixinRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_plugin_proto_msgTypes[47]
+func (x *MixinRequest) GetChainData() []byte {
+	if x != nil {
+		return x.ChainData
+	}
+	return nil
+}
+
+func (x *MixinRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *MixinRequest) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type MixinResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Action           MixinResponse_Action   `protobuf:"varint,1,opt,name=action,proto3,enum=plugins.MixinResponse_Action" json:"action,omitempty"`
+	Output           []byte                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	Error            string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ModifiedInput    []byte                 `protobuf:"bytes,4,opt,name=modified_input,json=modifiedInput,proto3" json:"modified_input,omitempty"`
+	Notifications    []*Notification        `protobuf:"bytes,5,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	SkippedEffects   []*SkippedEffect       `protobuf:"bytes,6,rep,name=skipped_effects,json=skippedEffects,proto3" json:"skipped_effects,omitempty"`
+	OutputPatch      []byte                 `protobuf:"bytes,7,opt,name=output_patch,json=outputPatch,proto3" json:"output_patch,omitempty"`
+	DeleteOutputKeys []string               `protobuf:"bytes,8,rep,name=delete_output_keys,json=deleteOutputKeys,proto3" json:"delete_output_keys,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MixinResponse) Reset() {
+	*x = MixinResponse{}
+	mi := &file_plugin_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *MixinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*MixinResponse) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[47]
+func (x *MixinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3320,113 +3772,143 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use MixinResponse.ProtoReflect.Descriptor instead.
+func (*MixinResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *User) GetId() string {
+func (x *MixinResponse) GetAction() MixinResponse_Action {
 	if x != nil {
-		return x.Id
+		return x.Action
 	}
-	return ""
+	return MixinResponse_NEXT
 }
 
-func (x *User) GetUsername() string {
+func (x *MixinResponse) GetOutput() []byte {
 	if x != nil {
-		return x.Username
+		return x.Output
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetEmail() string {
+func (x *MixinResponse) GetError() string {
 	if x != nil {
-		return x.Email
+		return x.Error
 	}
 	return ""
 }
 
-func (x *User) GetIsAdmin() bool {
+func (x *MixinResponse) GetModifiedInput() []byte {
 	if x != nil {
-		return x.IsAdmin
+		return x.ModifiedInput
 	}
-	return false
+	return nil
 }
 
-func (x *User) GetIsBanned() bool {
+func (x *MixinResponse) GetNotifications() []*Notification {
 	if x != nil {
-		return x.IsBanned
+		return x.Notifications
 	}
-	return false
+	return nil
 }
 
-func (x *User) GetRamLimit() int32 {
+func (x *MixinResponse) GetSkippedEffects() []*SkippedEffect {
 	if x != nil {
-		return x.RamLimit
+		return x.SkippedEffects
 	}
-	return 0
+	return nil
 }
 
-func (x *User) GetCpuLimit() int32 {
+func (x *MixinResponse) GetOutputPatch() []byte {
 	if x != nil {
-		return x.CpuLimit
+		return x.OutputPatch
 	}
-	return 0
+	return nil
 }
 
-func (x *User) GetDiskLimit() int32 {
+func (x *MixinResponse) GetDeleteOutputKeys() []string {
 	if x != nil {
-		return x.DiskLimit
+		return x.DeleteOutputKeys
 	}
-	return 0
+	return nil
 }
 
-func (x *User) GetServerLimit() int32 {
+type SkippedEffect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedEffect) Reset() {
+	*x = SkippedEffect{}
+	mi := &file_plugin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedEffect) ProtoMessage() {}
+
+func (x *SkippedEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[45]
 	if x != nil {
-		return x.ServerLimit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetForcePasswordReset() bool {
+// Deprecated: Use SkippedEffect.ProtoReflect.Descriptor instead.
+func (*SkippedEffect) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SkippedEffect) GetKind() string {
 	if x != nil {
-		return x.ForcePasswordReset
+		return x.Kind
 	}
-	return false
+	return ""
 }
 
-func (x *User) GetCreatedAt() string {
+func (x *SkippedEffect) GetDescription() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Description
 	}
 	return ""
 }
 
-type ListUsersRequest struct {
+type Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
-	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // error, success, info
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
-	mi := &file_plugin_proto_msgTypes[48]
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_plugin_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[48]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3437,62 +3919,148 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListUsersRequest) GetLimit() int32 {
+func (x *Notification) GetTitle() string {
 	if x != nil {
-		return x.Limit
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUsersRequest) GetOffset() int32 {
+func (x *Notification) GetMessage() string {
 	if x != nil {
-		return x.Offset
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUsersRequest) GetSearch() string {
+func (x *Notification) GetType() string {
 	if x != nil {
-		return x.Search
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ListUsersRequest) GetFilter() string {
+type RouteInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	RateLimit     *RateLimitConfig       `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	StreamBody    bool                   `protobuf:"varint,4,opt,name=stream_body,json=streamBody,proto3" json:"stream_body,omitempty"`
+	MaxBodySize   int64                  `protobuf:"varint,5,opt,name=max_body_size,json=maxBodySize,proto3" json:"max_body_size,omitempty"`
+	Cors          bool                   `protobuf:"varint,6,opt,name=cors,proto3" json:"cors,omitempty"`
+	Schema        *RouteSchema           `protobuf:"bytes,7,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteInfo) Reset() {
+	*x = RouteInfo{}
+	mi := &file_plugin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteInfo) ProtoMessage() {}
+
+func (x *RouteInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[47]
 	if x != nil {
-		return x.Filter
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteInfo.ProtoReflect.Descriptor instead.
+func (*RouteInfo) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RouteInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
 	return ""
 }
 
-type ListUsersResponse struct {
+func (x *RouteInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetRateLimit() *RateLimitConfig {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *RouteInfo) GetStreamBody() bool {
+	if x != nil {
+		return x.StreamBody
+	}
+	return false
+}
+
+func (x *RouteInfo) GetMaxBodySize() int64 {
+	if x != nil {
+		return x.MaxBodySize
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetCors() bool {
+	if x != nil {
+		return x.Cors
+	}
+	return false
+}
+
+func (x *RouteInfo) GetSchema() *RouteSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type RouteSchema struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	RequestBody   []byte                 `protobuf:"bytes,1,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
+	Response      []byte                 `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Params        []*RouteParam          `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_plugin_proto_msgTypes[49]
+func (x *RouteSchema) Reset() {
+	*x = RouteSchema{}
+	mi := &file_plugin_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *RouteSchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*RouteSchema) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[49]
+func (x *RouteSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3503,48 +4071,125 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use RouteSchema.ProtoReflect.Descriptor instead.
+func (*RouteSchema) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListUsersResponse) GetUsers() []*User {
+func (x *RouteSchema) GetRequestBody() []byte {
 	if x != nil {
-		return x.Users
+		return x.RequestBody
 	}
 	return nil
 }
 
-func (x *ListUsersResponse) GetTotal() int32 {
+func (x *RouteSchema) GetResponse() []byte {
 	if x != nil {
-		return x.Total
+		return x.Response
 	}
-	return 0
+	return nil
 }
 
-type CreateUserRequest struct {
+func (x *RouteSchema) GetParams() []*RouteParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type RouteParam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	In            string                 `protobuf:"bytes,2,opt,name=in,proto3" json:"in,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Schema        []byte                 `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *RouteParam) Reset() {
+	*x = RouteParam{}
+	mi := &file_plugin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteParam) ProtoMessage() {}
+
+func (x *RouteParam) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteParam.ProtoReflect.Descriptor instead.
+func (*RouteParam) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RouteParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RouteParam) GetIn() string {
+	if x != nil {
+		return x.In
+	}
+	return ""
+}
+
+func (x *RouteParam) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *RouteParam) GetSchema() []byte {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type RateLimitConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Preset            string                 `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	RequestsPerMinute int32                  `protobuf:"varint,2,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
+	BurstLimit        int32                  `protobuf:"varint,3,opt,name=burst_limit,json=burstLimit,proto3" json:"burst_limit,omitempty"`
+	Scope             string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	SdkEnforced       bool                   `protobuf:"varint,5,opt,name=sdk_enforced,json=sdkEnforced,proto3" json:"sdk_enforced,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RateLimitConfig) Reset() {
+	*x = RateLimitConfig{}
 	mi := &file_plugin_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *RateLimitConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*RateLimitConfig) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3556,57 +4201,4475 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimitConfig.ProtoReflect.Descriptor instead.
+func (*RateLimitConfig) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreateUserRequest) GetEmail() string {
+func (x *RateLimitConfig) GetPreset() string {
 	if x != nil {
-		return x.Email
+		return x.Preset
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetUsername() string {
+func (x *RateLimitConfig) GetRequestsPerMinute() int32 {
 	if x != nil {
-		return x.Username
+		return x.RequestsPerMinute
+	}
+	return 0
+}
+
+func (x *RateLimitConfig) GetBurstLimit() int32 {
+	if x != nil {
+		return x.BurstLimit
+	}
+	return 0
+}
+
+func (x *RateLimitConfig) GetScope() string {
+	if x != nil {
+		return x.Scope
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetPassword() string {
+func (x *RateLimitConfig) GetSdkEnforced() bool {
 	if x != nil {
-		return x.Password
+		return x.SdkEnforced
+	}
+	return false
+}
+
+type ScheduleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cron          string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	RouteMethod   string                 `protobuf:"bytes,3,opt,name=route_method,json=routeMethod,proto3" json:"route_method,omitempty"`
+	RoutePath     string                 `protobuf:"bytes,4,opt,name=route_path,json=routePath,proto3" json:"route_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleInfo) Reset() {
+	*x = ScheduleInfo{}
+	mi := &file_plugin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleInfo) ProtoMessage() {}
+
+func (x *ScheduleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleInfo.ProtoReflect.Descriptor instead.
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ScheduleInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetRouteMethod() string {
+	if x != nil {
+		return x.RouteMethod
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetRoutePath() string {
+	if x != nil {
+		return x.RoutePath
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sync          bool                   `protobuf:"varint,4,opt,name=sync,proto3" json:"sync,omitempty"`
+	Seq           uint64                 `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	Replayed      bool                   `protobuf:"varint,6,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_plugin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Event) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Event) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *Event) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Event) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type EventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allow         bool                   `protobuf:"varint,1,opt,name=allow,proto3" json:"allow,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventResponse) Reset() {
+	*x = EventResponse{}
+	mi := &file_plugin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResponse) ProtoMessage() {}
+
+func (x *EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *EventResponse) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+func (x *EventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type HTTPRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Method         string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Path           string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Headers        map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Query          map[string]string      `protobuf:"bytes,4,rep,name=query,proto3" json:"query,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body           []byte                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	UserId         string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CallerPluginId string                 `protobuf:"bytes,7,opt,name=caller_plugin_id,json=callerPluginId,proto3" json:"caller_plugin_id,omitempty"`
+	Streamed       bool                   `protobuf:"varint,8,opt,name=streamed,proto3" json:"streamed,omitempty"`
+	RateLimit      *RateLimitState        `protobuf:"bytes,9,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HTTPRequest) Reset() {
+	*x = HTTPRequest{}
+	mi := &file_plugin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRequest) ProtoMessage() {}
+
+func (x *HTTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRequest.ProtoReflect.Descriptor instead.
+func (*HTTPRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HTTPRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HTTPRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HTTPRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPRequest) GetQuery() map[string]string {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *HTTPRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HTTPRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HTTPRequest) GetCallerPluginId() string {
+	if x != nil {
+		return x.CallerPluginId
+	}
+	return ""
+}
+
+func (x *HTTPRequest) GetStreamed() bool {
+	if x != nil {
+		return x.Streamed
+	}
+	return false
+}
+
+func (x *HTTPRequest) GetRateLimit() *RateLimitState {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+type RateLimitState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Remaining     int32                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	ResetUnixMs   int64                  `protobuf:"varint,3,opt,name=reset_unix_ms,json=resetUnixMs,proto3" json:"reset_unix_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitState) Reset() {
+	*x = RateLimitState{}
+	mi := &file_plugin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitState) ProtoMessage() {}
+
+func (x *RateLimitState) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitState.ProtoReflect.Descriptor instead.
+func (*RateLimitState) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RateLimitState) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RateLimitState) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RateLimitState) GetResetUnixMs() int64 {
+	if x != nil {
+		return x.ResetUnixMs
+	}
+	return 0
+}
+
+type HTTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          []byte                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPResponse) Reset() {
+	*x = HTTPResponse{}
+	mi := &file_plugin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPResponse) ProtoMessage() {}
+
+func (x *HTTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPResponse.ProtoReflect.Descriptor instead.
+func (*HTTPResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *HTTPResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *HTTPResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPResponse) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type ScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRequest) Reset() {
+	*x = ScheduleRequest{}
+	mi := &file_plugin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRequest) ProtoMessage() {}
+
+func (x *ScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+// Server
+type Server struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId            string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NodeId            string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Memory            int32                  `protobuf:"varint,6,opt,name=memory,proto3" json:"memory,omitempty"`
+	Cpu               int32                  `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Disk              int32                  `protobuf:"varint,8,opt,name=disk,proto3" json:"disk,omitempty"`
+	Suspended         bool                   `protobuf:"varint,9,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	PackageId         string                 `protobuf:"bytes,10,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	PrimaryAllocation string                 `protobuf:"bytes,11,opt,name=primary_allocation,json=primaryAllocation,proto3" json:"primary_allocation,omitempty"`
+	Allocations       []*Allocation          `protobuf:"bytes,12,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_plugin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Server) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Server) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Server) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Server) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Server) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Server) GetMemory() int32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Server) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *Server) GetDisk() int32 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
+func (x *Server) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *Server) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *Server) GetPrimaryAllocation() string {
+	if x != nil {
+		return x.PrimaryAllocation
+	}
+	return ""
+}
+
+func (x *Server) GetAllocations() []*Allocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+type Allocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	ServerId      string                 `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Primary       bool                   `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
+	ReservedBy    string                 `protobuf:"bytes,7,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	ReservedUntil int64                  `protobuf:"varint,8,opt,name=reserved_until,json=reservedUntil,proto3" json:"reserved_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Allocation) Reset() {
+	*x = Allocation{}
+	mi := &file_plugin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allocation) ProtoMessage() {}
+
+func (x *Allocation) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allocation.ProtoReflect.Descriptor instead.
+func (*Allocation) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Allocation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Allocation) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Allocation) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Allocation) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Allocation) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Allocation) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *Allocation) GetReservedBy() string {
+	if x != nil {
+		return x.ReservedBy
+	}
+	return ""
+}
+
+func (x *Allocation) GetReservedUntil() int64 {
+	if x != nil {
+		return x.ReservedUntil
+	}
+	return 0
+}
+
+type ListAllocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	ServerId      string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Unassigned    bool                   `protobuf:"varint,4,opt,name=unassigned,proto3" json:"unassigned,omitempty"`
+	PortMin       int32                  `protobuf:"varint,5,opt,name=port_min,json=portMin,proto3" json:"port_min,omitempty"`
+	PortMax       int32                  `protobuf:"varint,6,opt,name=port_max,json=portMax,proto3" json:"port_max,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllocationsRequest) Reset() {
+	*x = ListAllocationsRequest{}
+	mi := &file_plugin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllocationsRequest) ProtoMessage() {}
+
+func (x *ListAllocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllocationsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListAllocationsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListAllocationsRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ListAllocationsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ListAllocationsRequest) GetUnassigned() bool {
+	if x != nil {
+		return x.Unassigned
+	}
+	return false
+}
+
+func (x *ListAllocationsRequest) GetPortMin() int32 {
+	if x != nil {
+		return x.PortMin
+	}
+	return 0
+}
+
+func (x *ListAllocationsRequest) GetPortMax() int32 {
+	if x != nil {
+		return x.PortMax
+	}
+	return 0
+}
+
+func (x *ListAllocationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAllocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allocations   []*Allocation          `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllocationsResponse) Reset() {
+	*x = ListAllocationsResponse{}
+	mi := &file_plugin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllocationsResponse) ProtoMessage() {}
+
+func (x *ListAllocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllocationsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListAllocationsResponse) GetAllocations() []*Allocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+type CreateAllocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Ports         []int32                `protobuf:"varint,3,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllocationsRequest) Reset() {
+	*x = CreateAllocationsRequest{}
+	mi := &file_plugin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllocationsRequest) ProtoMessage() {}
+
+func (x *CreateAllocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllocationsRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllocationsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateAllocationsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CreateAllocationsRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CreateAllocationsRequest) GetPorts() []int32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type AssignAllocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	AllocationId  string                 `protobuf:"bytes,2,opt,name=allocation_id,json=allocationId,proto3" json:"allocation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignAllocationRequest) Reset() {
+	*x = AssignAllocationRequest{}
+	mi := &file_plugin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignAllocationRequest) ProtoMessage() {}
+
+func (x *AssignAllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignAllocationRequest.ProtoReflect.Descriptor instead.
+func (*AssignAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AssignAllocationRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AssignAllocationRequest) GetAllocationId() string {
+	if x != nil {
+		return x.AllocationId
+	}
+	return ""
+}
+
+type ServerSchedule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId       string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Cron           string                 `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	Enabled        bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	OnlyWhenOnline bool                   `protobuf:"varint,6,opt,name=only_when_online,json=onlyWhenOnline,proto3" json:"only_when_online,omitempty"`
+	Tasks          []*ScheduleTask        `protobuf:"bytes,7,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	LastRun        int64                  `protobuf:"varint,8,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	NextRun        int64                  `protobuf:"varint,9,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ServerSchedule) Reset() {
+	*x = ServerSchedule{}
+	mi := &file_plugin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSchedule) ProtoMessage() {}
+
+func (x *ServerSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSchedule.ProtoReflect.Descriptor instead.
+func (*ServerSchedule) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ServerSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServerSchedule) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerSchedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *ServerSchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ServerSchedule) GetOnlyWhenOnline() bool {
+	if x != nil {
+		return x.OnlyWhenOnline
+	}
+	return false
+}
+
+func (x *ServerSchedule) GetTasks() []*ScheduleTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ServerSchedule) GetLastRun() int64 {
+	if x != nil {
+		return x.LastRun
+	}
+	return 0
+}
+
+func (x *ServerSchedule) GetNextRun() int64 {
+	if x != nil {
+		return x.NextRun
+	}
+	return 0
+}
+
+type ScheduleTask struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId        string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Sequence          int32                  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Action            string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Payload           string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	DelaySeconds      int32                  `protobuf:"varint,6,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	ContinueOnFailure bool                   `protobuf:"varint,7,opt,name=continue_on_failure,json=continueOnFailure,proto3" json:"continue_on_failure,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduleTask) Reset() {
+	*x = ScheduleTask{}
+	mi := &file_plugin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTask) ProtoMessage() {}
+
+func (x *ScheduleTask) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTask.ProtoReflect.Descriptor instead.
+func (*ScheduleTask) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ScheduleTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleTask) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleTask) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ScheduleTask) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ScheduleTask) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ScheduleTask) GetDelaySeconds() int32 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+func (x *ScheduleTask) GetContinueOnFailure() bool {
+	if x != nil {
+		return x.ContinueOnFailure
+	}
+	return false
+}
+
+type ListServerSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*ServerSchedule      `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServerSchedulesResponse) Reset() {
+	*x = ListServerSchedulesResponse{}
+	mi := &file_plugin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServerSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerSchedulesResponse) ProtoMessage() {}
+
+func (x *ListServerSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListServerSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListServerSchedulesResponse) GetSchedules() []*ServerSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type CreateServerScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServerId       string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cron           string                 `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	Enabled        bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	OnlyWhenOnline bool                   `protobuf:"varint,5,opt,name=only_when_online,json=onlyWhenOnline,proto3" json:"only_when_online,omitempty"`
+	Tasks          []*ScheduleTask        `protobuf:"bytes,6,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateServerScheduleRequest) Reset() {
+	*x = CreateServerScheduleRequest{}
+	mi := &file_plugin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServerScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerScheduleRequest) ProtoMessage() {}
+
+func (x *CreateServerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateServerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateServerScheduleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateServerScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServerScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *CreateServerScheduleRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CreateServerScheduleRequest) GetOnlyWhenOnline() bool {
+	if x != nil {
+		return x.OnlyWhenOnline
+	}
+	return false
+}
+
+func (x *CreateServerScheduleRequest) GetTasks() []*ScheduleTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type AddScheduleTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Task          *ScheduleTask          `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddScheduleTaskRequest) Reset() {
+	*x = AddScheduleTaskRequest{}
+	mi := &file_plugin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddScheduleTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddScheduleTaskRequest) ProtoMessage() {}
+
+func (x *AddScheduleTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddScheduleTaskRequest.ProtoReflect.Descriptor instead.
+func (*AddScheduleTaskRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AddScheduleTaskRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *AddScheduleTaskRequest) GetTask() *ScheduleTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ReserveAllocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	PortMin       int32                  `protobuf:"varint,3,opt,name=port_min,json=portMin,proto3" json:"port_min,omitempty"`
+	PortMax       int32                  `protobuf:"varint,4,opt,name=port_max,json=portMax,proto3" json:"port_max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveAllocationRequest) Reset() {
+	*x = ReserveAllocationRequest{}
+	mi := &file_plugin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveAllocationRequest) ProtoMessage() {}
+
+func (x *ReserveAllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveAllocationRequest.ProtoReflect.Descriptor instead.
+func (*ReserveAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReserveAllocationRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ReserveAllocationRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ReserveAllocationRequest) GetPortMin() int32 {
+	if x != nil {
+		return x.PortMin
+	}
+	return 0
+}
+
+func (x *ReserveAllocationRequest) GetPortMax() int32 {
+	if x != nil {
+		return x.PortMax
+	}
+	return 0
+}
+
+type ListServersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeShared bool                   `protobuf:"varint,6,opt,name=include_shared,json=includeShared,proto3" json:"include_shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServersRequest) Reset() {
+	*x = ListServersRequest{}
+	mi := &file_plugin_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServersRequest) ProtoMessage() {}
+
+func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServersRequest.ProtoReflect.Descriptor instead.
+func (*ListServersRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListServersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListServersRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListServersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListServersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListServersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListServersRequest) GetIncludeShared() bool {
+	if x != nil {
+		return x.IncludeShared
+	}
+	return false
+}
+
+type ListServersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*Server              `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServersResponse) Reset() {
+	*x = ListServersResponse{}
+	mi := &file_plugin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServersResponse) ProtoMessage() {}
+
+func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServersResponse.ProtoReflect.Descriptor instead.
+func (*ListServersResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListServersResponse) GetServers() []*Server {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *ListServersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PackageId     string                 `protobuf:"bytes,4,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Memory        int32                  `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	Cpu           int32                  `protobuf:"varint,6,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Disk          int32                  `protobuf:"varint,7,opt,name=disk,proto3" json:"disk,omitempty"`
+	Deployment    *Deployment            `protobuf:"bytes,8,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	Image         string                 `protobuf:"bytes,9,opt,name=image,proto3" json:"image,omitempty"`
+	Startup       string                 `protobuf:"bytes,10,opt,name=startup,proto3" json:"startup,omitempty"`
+	Variables     map[string]string      `protobuf:"bytes,11,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ports         []int32                `protobuf:"varint,12,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	AllocationIds []string               `protobuf:"bytes,13,rep,name=allocation_ids,json=allocationIds,proto3" json:"allocation_ids,omitempty"`
+	Swap          int32                  `protobuf:"varint,14,opt,name=swap,proto3" json:"swap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServerRequest) Reset() {
+	*x = CreateServerRequest{}
+	mi := &file_plugin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerRequest) ProtoMessage() {}
+
+func (x *CreateServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerRequest.ProtoReflect.Descriptor instead.
+func (*CreateServerRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetMemory() int32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *CreateServerRequest) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *CreateServerRequest) GetDisk() int32 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
+func (x *CreateServerRequest) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+func (x *CreateServerRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetStartup() string {
+	if x != nil {
+		return x.Startup
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateServerRequest) GetPorts() []int32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *CreateServerRequest) GetAllocationIds() []string {
+	if x != nil {
+		return x.AllocationIds
+	}
+	return nil
+}
+
+func (x *CreateServerRequest) GetSwap() int32 {
+	if x != nil {
+		return x.Swap
+	}
+	return 0
+}
+
+type Deployment struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	NodeIds            []string               `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Tags               []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExcludeMaintenance bool                   `protobuf:"varint,3,opt,name=exclude_maintenance,json=excludeMaintenance,proto3" json:"exclude_maintenance,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Deployment) Reset() {
+	*x = Deployment{}
+	mi := &file_plugin_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deployment) ProtoMessage() {}
+
+func (x *Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Deployment) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *Deployment) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Deployment) GetExcludeMaintenance() bool {
+	if x != nil {
+		return x.ExcludeMaintenance
+	}
+	return false
+}
+
+type DeleteServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServerRequest) Reset() {
+	*x = DeleteServerRequest{}
+	mi := &file_plugin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServerRequest) ProtoMessage() {}
+
+func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServerRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteServerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteServerRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type UpdateServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Memory        int32                  `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Cpu           int32                  `protobuf:"varint,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Disk          int32                  `protobuf:"varint,5,opt,name=disk,proto3" json:"disk,omitempty"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServerRequest) Reset() {
+	*x = UpdateServerRequest{}
+	mi := &file_plugin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerRequest) ProtoMessage() {}
+
+func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateServerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetMemory() int32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *UpdateServerRequest) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *UpdateServerRequest) GetDisk() int32 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
+func (x *UpdateServerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type TransferServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	TargetNodeId  string                 `protobuf:"bytes,2,opt,name=target_node_id,json=targetNodeId,proto3" json:"target_node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferServerRequest) Reset() {
+	*x = TransferServerRequest{}
+	mi := &file_plugin_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferServerRequest) ProtoMessage() {}
+
+func (x *TransferServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferServerRequest.ProtoReflect.Descriptor instead.
+func (*TransferServerRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TransferServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *TransferServerRequest) GetTargetNodeId() string {
+	if x != nil {
+		return x.TargetNodeId
+	}
+	return ""
+}
+
+type ConsoleLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Lines         int32                  `protobuf:"varint,2,opt,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsoleLogRequest) Reset() {
+	*x = ConsoleLogRequest{}
+	mi := &file_plugin_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsoleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleLogRequest) ProtoMessage() {}
+
+func (x *ConsoleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleLogRequest.ProtoReflect.Descriptor instead.
+func (*ConsoleLogRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ConsoleLogRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ConsoleLogRequest) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+type ConsoleLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []string               `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsoleLogResponse) Reset() {
+	*x = ConsoleLogResponse{}
+	mi := &file_plugin_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsoleLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleLogResponse) ProtoMessage() {}
+
+func (x *ConsoleLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleLogResponse.ProtoReflect.Descriptor instead.
+func (*ConsoleLogResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ConsoleLogResponse) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type SendCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandRequest) Reset() {
+	*x = SendCommandRequest{}
+	mi := &file_plugin_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandRequest) ProtoMessage() {}
+
+func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SendCommandRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SendCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type ServerStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryBytes   int64                  `protobuf:"varint,1,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	MemoryLimit   int64                  `protobuf:"varint,2,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	CpuPercent    float64                `protobuf:"fixed64,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	DiskBytes     int64                  `protobuf:"varint,4,opt,name=disk_bytes,json=diskBytes,proto3" json:"disk_bytes,omitempty"`
+	NetworkRx     int64                  `protobuf:"varint,5,opt,name=network_rx,json=networkRx,proto3" json:"network_rx,omitempty"`
+	NetworkTx     int64                  `protobuf:"varint,6,opt,name=network_tx,json=networkTx,proto3" json:"network_tx,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerStats) Reset() {
+	*x = ServerStats{}
+	mi := &file_plugin_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStats) ProtoMessage() {}
+
+func (x *ServerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStats.ProtoReflect.Descriptor instead.
+func (*ServerStats) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ServerStats) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ServerStats) GetMemoryLimit() int64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *ServerStats) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ServerStats) GetDiskBytes() int64 {
+	if x != nil {
+		return x.DiskBytes
+	}
+	return 0
+}
+
+func (x *ServerStats) GetNetworkRx() int64 {
+	if x != nil {
+		return x.NetworkRx
+	}
+	return 0
+}
+
+func (x *ServerStats) GetNetworkTx() int64 {
+	if x != nil {
+		return x.NetworkTx
+	}
+	return 0
+}
+
+func (x *ServerStats) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type AllocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocationRequest) Reset() {
+	*x = AllocationRequest{}
+	mi := &file_plugin_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocationRequest) ProtoMessage() {}
+
+func (x *AllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocationRequest.ProtoReflect.Descriptor instead.
+func (*AllocationRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AllocationRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AllocationRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type CompressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Paths         []string               `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Destination   string                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompressRequest) Reset() {
+	*x = CompressRequest{}
+	mi := &file_plugin_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressRequest) ProtoMessage() {}
+
+func (x *CompressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressRequest.ProtoReflect.Descriptor instead.
+func (*CompressRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CompressRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CompressRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *CompressRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type UpdateVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Variables     map[string]string      `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariablesRequest) Reset() {
+	*x = UpdateVariablesRequest{}
+	mi := &file_plugin_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariablesRequest) ProtoMessage() {}
+
+func (x *UpdateVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariablesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateVariablesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *UpdateVariablesRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type StreamConsoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServerId       string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	IncludeHistory bool                   `protobuf:"varint,2,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+	HistoryLines   int32                  `protobuf:"varint,3,opt,name=history_lines,json=historyLines,proto3" json:"history_lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamConsoleRequest) Reset() {
+	*x = StreamConsoleRequest{}
+	mi := &file_plugin_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamConsoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConsoleRequest) ProtoMessage() {}
+
+func (x *StreamConsoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConsoleRequest.ProtoReflect.Descriptor instead.
+func (*StreamConsoleRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *StreamConsoleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *StreamConsoleRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+func (x *StreamConsoleRequest) GetHistoryLines() int32 {
+	if x != nil {
+		return x.HistoryLines
+	}
+	return 0
+}
+
+type ConsoleLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsoleLine) Reset() {
+	*x = ConsoleLine{}
+	mi := &file_plugin_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsoleLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleLine) ProtoMessage() {}
+
+func (x *ConsoleLine) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleLine.ProtoReflect.Descriptor instead.
+func (*ConsoleLine) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ConsoleLine) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *ConsoleLine) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ServerStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	OldStatus     string                 `protobuf:"bytes,2,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus     string                 `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerStatusChange) Reset() {
+	*x = ServerStatusChange{}
+	mi := &file_plugin_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStatusChange) ProtoMessage() {}
+
+func (x *ServerStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStatusChange.ProtoReflect.Descriptor instead.
+func (*ServerStatusChange) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ServerStatusChange) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerStatusChange) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *ServerStatusChange) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *ServerStatusChange) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ServerSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Values        map[string]string      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerSettings) Reset() {
+	*x = ServerSettings{}
+	mi := &file_plugin_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSettings) ProtoMessage() {}
+
+func (x *ServerSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSettings.ProtoReflect.Descriptor instead.
+func (*ServerSettings) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ServerSettings) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerSettings) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SetServerSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Values        map[string]string      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DeleteKeys    []string               `protobuf:"bytes,3,rep,name=delete_keys,json=deleteKeys,proto3" json:"delete_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetServerSettingsRequest) Reset() {
+	*x = SetServerSettingsRequest{}
+	mi := &file_plugin_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetServerSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServerSettingsRequest) ProtoMessage() {}
+
+func (x *SetServerSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServerSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetServerSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetServerSettingsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SetServerSettingsRequest) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SetServerSettingsRequest) GetDeleteKeys() []string {
+	if x != nil {
+		return x.DeleteKeys
+	}
+	return nil
+}
+
+type ServerSettingsChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Deleted       bool                   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	ServerDeleted bool                   `protobuf:"varint,5,opt,name=server_deleted,json=serverDeleted,proto3" json:"server_deleted,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerSettingsChange) Reset() {
+	*x = ServerSettingsChange{}
+	mi := &file_plugin_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerSettingsChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSettingsChange) ProtoMessage() {}
+
+func (x *ServerSettingsChange) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSettingsChange.ProtoReflect.Descriptor instead.
+func (*ServerSettingsChange) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ServerSettingsChange) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerSettingsChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ServerSettingsChange) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ServerSettingsChange) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ServerSettingsChange) GetServerDeleted() bool {
+	if x != nil {
+		return x.ServerDeleted
+	}
+	return false
+}
+
+func (x *ServerSettingsChange) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type FullLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FullLogResponse) Reset() {
+	*x = FullLogResponse{}
+	mi := &file_plugin_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FullLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FullLogResponse) ProtoMessage() {}
+
+func (x *FullLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FullLogResponse.ProtoReflect.Descriptor instead.
+func (*FullLogResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *FullLogResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *FullLogResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SearchLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Regex         bool                   `protobuf:"varint,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Since         int64                  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogsRequest) Reset() {
+	*x = SearchLogsRequest{}
+	mi := &file_plugin_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsRequest) ProtoMessage() {}
+
+func (x *SearchLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLogsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SearchLogsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *SearchLogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchLogsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type SearchLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*LogMatch            `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogsResponse) Reset() {
+	*x = SearchLogsResponse{}
+	mi := &file_plugin_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsResponse) ProtoMessage() {}
+
+func (x *SearchLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLogsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SearchLogsResponse) GetMatches() []*LogMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type LogMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	LineNumber    int32                  `protobuf:"varint,2,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogMatch) Reset() {
+	*x = LogMatch{}
+	mi := &file_plugin_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMatch) ProtoMessage() {}
+
+func (x *LogMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMatch.ProtoReflect.Descriptor instead.
+func (*LogMatch) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *LogMatch) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *LogMatch) GetLineNumber() int32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *LogMatch) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type LogFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*LogFileInfo         `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogFilesResponse) Reset() {
+	*x = LogFilesResponse{}
+	mi := &file_plugin_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogFilesResponse) ProtoMessage() {}
+
+func (x *LogFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogFilesResponse.ProtoReflect.Descriptor instead.
+func (*LogFilesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *LogFilesResponse) GetFiles() []*LogFileInfo {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type LogFileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Modified      string                 `protobuf:"bytes,3,opt,name=modified,proto3" json:"modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogFileInfo) Reset() {
+	*x = LogFileInfo{}
+	mi := &file_plugin_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogFileInfo) ProtoMessage() {}
+
+func (x *LogFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogFileInfo.ProtoReflect.Descriptor instead.
+func (*LogFileInfo) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *LogFileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogFileInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *LogFileInfo) GetModified() string {
+	if x != nil {
+		return x.Modified
+	}
+	return ""
+}
+
+type ReadLogFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadLogFileRequest) Reset() {
+	*x = ReadLogFileRequest{}
+	mi := &file_plugin_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadLogFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadLogFileRequest) ProtoMessage() {}
+
+func (x *ReadLogFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadLogFileRequest.ProtoReflect.Descriptor instead.
+func (*ReadLogFileRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReadLogFileRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ReadLogFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// User
+type User struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username           string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email              string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	IsAdmin            bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	IsBanned           bool                   `protobuf:"varint,5,opt,name=is_banned,json=isBanned,proto3" json:"is_banned,omitempty"`
+	RamLimit           int32                  `protobuf:"varint,6,opt,name=ram_limit,json=ramLimit,proto3" json:"ram_limit,omitempty"`
+	CpuLimit           int32                  `protobuf:"varint,7,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`
+	DiskLimit          int32                  `protobuf:"varint,8,opt,name=disk_limit,json=diskLimit,proto3" json:"disk_limit,omitempty"`
+	ServerLimit        int32                  `protobuf:"varint,9,opt,name=server_limit,json=serverLimit,proto3" json:"server_limit,omitempty"`
+	ForcePasswordReset bool                   `protobuf:"varint,10,opt,name=force_password_reset,json=forcePasswordReset,proto3" json:"force_password_reset,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_plugin_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *User) GetIsBanned() bool {
+	if x != nil {
+		return x.IsBanned
+	}
+	return false
+}
+
+func (x *User) GetRamLimit() int32 {
+	if x != nil {
+		return x.RamLimit
+	}
+	return 0
+}
+
+func (x *User) GetCpuLimit() int32 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
+}
+
+func (x *User) GetDiskLimit() int32 {
+	if x != nil {
+		return x.DiskLimit
+	}
+	return 0
+}
+
+func (x *User) GetServerLimit() int32 {
+	if x != nil {
+		return x.ServerLimit
+	}
+	return 0
+}
+
+func (x *User) GetForcePasswordReset() bool {
+	if x != nil {
+		return x.ForcePasswordReset
+	}
+	return false
+}
+
+func (x *User) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_plugin_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_plugin_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_plugin_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_plugin_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SetUserResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RamLimit      int32                  `protobuf:"varint,2,opt,name=ram_limit,json=ramLimit,proto3" json:"ram_limit,omitempty"`
+	CpuLimit      int32                  `protobuf:"varint,3,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`
+	DiskLimit     int32                  `protobuf:"varint,4,opt,name=disk_limit,json=diskLimit,proto3" json:"disk_limit,omitempty"`
+	ServerLimit   int32                  `protobuf:"varint,5,opt,name=server_limit,json=serverLimit,proto3" json:"server_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserResourcesRequest) Reset() {
+	*x = SetUserResourcesRequest{}
+	mi := &file_plugin_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserResourcesRequest) ProtoMessage() {}
+
+func (x *SetUserResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserResourcesRequest.ProtoReflect.Descriptor instead.
+func (*SetUserResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SetUserResourcesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserResourcesRequest) GetRamLimit() int32 {
+	if x != nil {
+		return x.RamLimit
+	}
+	return 0
+}
+
+func (x *SetUserResourcesRequest) GetCpuLimit() int32 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
+}
+
+func (x *SetUserResourcesRequest) GetDiskLimit() int32 {
+	if x != nil {
+		return x.DiskLimit
+	}
+	return 0
+}
+
+func (x *SetUserResourcesRequest) GetServerLimit() int32 {
+	if x != nil {
+		return x.ServerLimit
+	}
+	return 0
+}
+
+// Subusers
+type Subuser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subuser) Reset() {
+	*x = Subuser{}
+	mi := &file_plugin_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subuser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subuser) ProtoMessage() {}
+
+func (x *Subuser) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subuser.ProtoReflect.Descriptor instead.
+func (*Subuser) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Subuser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subuser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Subuser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Subuser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Subuser) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListSubusersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subusers      []*Subuser             `protobuf:"bytes,1,rep,name=subusers,proto3" json:"subusers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubusersResponse) Reset() {
+	*x = ListSubusersResponse{}
+	mi := &file_plugin_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubusersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubusersResponse) ProtoMessage() {}
+
+func (x *ListSubusersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubusersResponse.ProtoReflect.Descriptor instead.
+func (*ListSubusersResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListSubusersResponse) GetSubusers() []*Subuser {
+	if x != nil {
+		return x.Subusers
+	}
+	return nil
+}
+
+type AddSubuserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSubuserRequest) Reset() {
+	*x = AddSubuserRequest{}
+	mi := &file_plugin_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSubuserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubuserRequest) ProtoMessage() {}
+
+func (x *AddSubuserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubuserRequest.ProtoReflect.Descriptor instead.
+func (*AddSubuserRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AddSubuserRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AddSubuserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddSubuserRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UpdateSubuserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	SubuserId     string                 `protobuf:"bytes,2,opt,name=subuser_id,json=subuserId,proto3" json:"subuser_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubuserRequest) Reset() {
+	*x = UpdateSubuserRequest{}
+	mi := &file_plugin_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubuserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubuserRequest) ProtoMessage() {}
+
+func (x *UpdateSubuserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubuserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubuserRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UpdateSubuserRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *UpdateSubuserRequest) GetSubuserId() string {
+	if x != nil {
+		return x.SubuserId
+	}
+	return ""
+}
+
+func (x *UpdateSubuserRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type RemoveSubuserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	SubuserId     string                 `protobuf:"bytes,2,opt,name=subuser_id,json=subuserId,proto3" json:"subuser_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSubuserRequest) Reset() {
+	*x = RemoveSubuserRequest{}
+	mi := &file_plugin_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSubuserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSubuserRequest) ProtoMessage() {}
+
+func (x *RemoveSubuserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSubuserRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSubuserRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RemoveSubuserRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *RemoveSubuserRequest) GetSubuserId() string {
+	if x != nil {
+		return x.SubuserId
+	}
+	return ""
+}
+
+type SubuserPermissionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubuserPermissionInfo) Reset() {
+	*x = SubuserPermissionInfo{}
+	mi := &file_plugin_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubuserPermissionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubuserPermissionInfo) ProtoMessage() {}
+
+func (x *SubuserPermissionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubuserPermissionInfo.ProtoReflect.Descriptor instead.
+func (*SubuserPermissionInfo) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SubuserPermissionInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SubuserPermissionInfo) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SubuserPermissionInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListSubuserPermissionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Permissions   []*SubuserPermissionInfo `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubuserPermissionsResponse) Reset() {
+	*x = ListSubuserPermissionsResponse{}
+	mi := &file_plugin_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubuserPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubuserPermissionsResponse) ProtoMessage() {}
+
+func (x *ListSubuserPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubuserPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubuserPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListSubuserPermissionsResponse) GetPermissions() []*SubuserPermissionInfo {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// Database
+type Database struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Host          string                 `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	ServerId      string                 `protobuf:"bytes,7,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	AllowedHost   string                 `protobuf:"bytes,8,opt,name=allowed_host,json=allowedHost,proto3" json:"allowed_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Database) Reset() {
+	*x = Database{}
+	mi := &file_plugin_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Database) ProtoMessage() {}
+
+func (x *Database) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Database.ProtoReflect.Descriptor instead.
+func (*Database) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Database) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Database) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Database) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Database) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Database) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Database) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Database) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Database) GetAllowedHost() string {
+	if x != nil {
+		return x.AllowedHost
+	}
+	return ""
+}
+
+type ListDatabasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Databases     []*Database            `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatabasesResponse) Reset() {
+	*x = ListDatabasesResponse{}
+	mi := &file_plugin_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatabasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatabasesResponse) ProtoMessage() {}
+
+func (x *ListDatabasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatabasesResponse.ProtoReflect.Descriptor instead.
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListDatabasesResponse) GetDatabases() []*Database {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type CreateDatabaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	HostId        string                 `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	AllowedHost   string                 `protobuf:"bytes,4,opt,name=allowed_host,json=allowedHost,proto3" json:"allowed_host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDatabaseRequest) Reset() {
+	*x = CreateDatabaseRequest{}
+	mi := &file_plugin_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatabaseRequest) ProtoMessage() {}
+
+func (x *CreateDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CreateDatabaseRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateDatabaseRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *CreateDatabaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDatabaseRequest) GetAllowedHost() string {
+	if x != nil {
+		return x.AllowedHost
+	}
+	return ""
+}
+
+// Database Hosts
+type DatabaseHost struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Host           string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port           int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Username       string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	MaxDatabases   int32                  `protobuf:"varint,6,opt,name=max_databases,json=maxDatabases,proto3" json:"max_databases,omitempty"`
+	DatabasesCount int32                  `protobuf:"varint,7,opt,name=databases_count,json=databasesCount,proto3" json:"databases_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DatabaseHost) Reset() {
+	*x = DatabaseHost{}
+	mi := &file_plugin_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseHost) ProtoMessage() {}
+
+func (x *DatabaseHost) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseHost.ProtoReflect.Descriptor instead.
+func (*DatabaseHost) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DatabaseHost) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DatabaseHost) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseHost) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *DatabaseHost) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DatabaseHost) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DatabaseHost) GetMaxDatabases() int32 {
+	if x != nil {
+		return x.MaxDatabases
+	}
+	return 0
+}
+
+func (x *DatabaseHost) GetDatabasesCount() int32 {
+	if x != nil {
+		return x.DatabasesCount
+	}
+	return 0
+}
+
+type ListDatabaseHostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hosts         []*DatabaseHost        `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatabaseHostsResponse) Reset() {
+	*x = ListDatabaseHostsResponse{}
+	mi := &file_plugin_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatabaseHostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatabaseHostsResponse) ProtoMessage() {}
+
+func (x *ListDatabaseHostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatabaseHostsResponse.ProtoReflect.Descriptor instead.
+func (*ListDatabaseHostsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListDatabaseHostsResponse) GetHosts() []*DatabaseHost {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type CreateDatabaseHostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	MaxDatabases  int32                  `protobuf:"varint,6,opt,name=max_databases,json=maxDatabases,proto3" json:"max_databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDatabaseHostRequest) Reset() {
+	*x = CreateDatabaseHostRequest{}
+	mi := &file_plugin_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDatabaseHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatabaseHostRequest) ProtoMessage() {}
+
+func (x *CreateDatabaseHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatabaseHostRequest.ProtoReflect.Descriptor instead.
+func (*CreateDatabaseHostRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateDatabaseHostRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDatabaseHostRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CreateDatabaseHostRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *CreateDatabaseHostRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateDatabaseHostRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateDatabaseHostRequest) GetMaxDatabases() int32 {
+	if x != nil {
+		return x.MaxDatabases
 	}
-	return ""
+	return 0
 }
 
-type UpdateUserRequest struct {
+type UpdateDatabaseHostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	MaxDatabases  int32                  `protobuf:"varint,7,opt,name=max_databases,json=maxDatabases,proto3" json:"max_databases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	mi := &file_plugin_proto_msgTypes[51]
+func (x *UpdateDatabaseHostRequest) Reset() {
+	*x = UpdateDatabaseHostRequest{}
+	mi := &file_plugin_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *UpdateDatabaseHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*UpdateDatabaseHostRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[51]
+func (x *UpdateDatabaseHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3617,65 +8680,87 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use UpdateDatabaseHostRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDatabaseHostRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *UpdateUserRequest) GetId() string {
+func (x *UpdateDatabaseHostRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetEmail() string {
+func (x *UpdateDatabaseHostRequest) GetName() string {
 	if x != nil {
-		return x.Email
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetUsername() string {
+func (x *UpdateDatabaseHostRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *UpdateDatabaseHostRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *UpdateDatabaseHostRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetPassword() string {
+func (x *UpdateDatabaseHostRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type SetUserResourcesRequest struct {
+func (x *UpdateDatabaseHostRequest) GetMaxDatabases() int32 {
+	if x != nil {
+		return x.MaxDatabases
+	}
+	return 0
+}
+
+// Files
+type FileInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RamLimit      int32                  `protobuf:"varint,2,opt,name=ram_limit,json=ramLimit,proto3" json:"ram_limit,omitempty"`
-	CpuLimit      int32                  `protobuf:"varint,3,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`
-	DiskLimit     int32                  `protobuf:"varint,4,opt,name=disk_limit,json=diskLimit,proto3" json:"disk_limit,omitempty"`
-	ServerLimit   int32                  `protobuf:"varint,5,opt,name=server_limit,json=serverLimit,proto3" json:"server_limit,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsDir         bool                   `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Modified      string                 `protobuf:"bytes,4,opt,name=modified,proto3" json:"modified,omitempty"`
+	Mime          string                 `protobuf:"bytes,5,opt,name=mime,proto3" json:"mime,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetUserResourcesRequest) Reset() {
-	*x = SetUserResourcesRequest{}
-	mi := &file_plugin_proto_msgTypes[52]
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_plugin_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetUserResourcesRequest) String() string {
+func (x *FileInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserResourcesRequest) ProtoMessage() {}
+func (*FileInfo) ProtoMessage() {}
 
-func (x *SetUserResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[52]
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3686,73 +8771,68 @@ func (x *SetUserResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserResourcesRequest.ProtoReflect.Descriptor instead.
-func (*SetUserResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *SetUserResourcesRequest) GetUserId() string {
+func (x *FileInfo) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SetUserResourcesRequest) GetRamLimit() int32 {
+func (x *FileInfo) GetIsDir() bool {
 	if x != nil {
-		return x.RamLimit
+		return x.IsDir
 	}
-	return 0
+	return false
 }
 
-func (x *SetUserResourcesRequest) GetCpuLimit() int32 {
+func (x *FileInfo) GetSize() int64 {
 	if x != nil {
-		return x.CpuLimit
+		return x.Size
 	}
 	return 0
 }
 
-func (x *SetUserResourcesRequest) GetDiskLimit() int32 {
+func (x *FileInfo) GetModified() string {
 	if x != nil {
-		return x.DiskLimit
+		return x.Modified
 	}
-	return 0
+	return ""
 }
 
-func (x *SetUserResourcesRequest) GetServerLimit() int32 {
+func (x *FileInfo) GetMime() string {
 	if x != nil {
-		return x.ServerLimit
+		return x.Mime
 	}
-	return 0
+	return ""
 }
 
-// Subusers
-type Subuser struct {
+type ListFilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Files         []*FileInfo            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Subuser) Reset() {
-	*x = Subuser{}
-	mi := &file_plugin_proto_msgTypes[53]
+func (x *ListFilesResponse) Reset() {
+	*x = ListFilesResponse{}
+	mi := &file_plugin_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Subuser) String() string {
+func (x *ListFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subuser) ProtoMessage() {}
+func (*ListFilesResponse) ProtoMessage() {}
 
-func (x *Subuser) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[53]
+func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3763,68 +8843,41 @@ func (x *Subuser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subuser.ProtoReflect.Descriptor instead.
-func (*Subuser) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *Subuser) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Subuser) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *Subuser) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *Subuser) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *Subuser) GetPermissions() []string {
+func (x *ListFilesResponse) GetFiles() []*FileInfo {
 	if x != nil {
-		return x.Permissions
+		return x.Files
 	}
 	return nil
 }
 
-type ListSubusersResponse struct {
+type FilePathRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subusers      []*Subuser             `protobuf:"bytes,1,rep,name=subusers,proto3" json:"subusers,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSubusersResponse) Reset() {
-	*x = ListSubusersResponse{}
-	mi := &file_plugin_proto_msgTypes[54]
+func (x *FilePathRequest) Reset() {
+	*x = FilePathRequest{}
+	mi := &file_plugin_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSubusersResponse) String() string {
+func (x *FilePathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubusersResponse) ProtoMessage() {}
+func (*FilePathRequest) ProtoMessage() {}
 
-func (x *ListSubusersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[54]
+func (x *FilePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3835,42 +8888,48 @@ func (x *ListSubusersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubusersResponse.ProtoReflect.Descriptor instead.
-func (*ListSubusersResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use FilePathRequest.ProtoReflect.Descriptor instead.
+func (*FilePathRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *ListSubusersResponse) GetSubusers() []*Subuser {
+func (x *FilePathRequest) GetServerId() string {
 	if x != nil {
-		return x.Subusers
+		return x.ServerId
 	}
-	return nil
+	return ""
 }
 
-type AddSubuserRequest struct {
+func (x *FilePathRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FileContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Mime          string                 `protobuf:"bytes,2,opt,name=mime,proto3" json:"mime,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddSubuserRequest) Reset() {
-	*x = AddSubuserRequest{}
-	mi := &file_plugin_proto_msgTypes[55]
+func (x *FileContent) Reset() {
+	*x = FileContent{}
+	mi := &file_plugin_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSubuserRequest) String() string {
+func (x *FileContent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSubuserRequest) ProtoMessage() {}
+func (*FileContent) ProtoMessage() {}
 
-func (x *AddSubuserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[55]
+func (x *FileContent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3881,56 +8940,49 @@ func (x *AddSubuserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSubuserRequest.ProtoReflect.Descriptor instead.
-func (*AddSubuserRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
+func (*FileContent) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *AddSubuserRequest) GetServerId() string {
+func (x *FileContent) GetContent() []byte {
 	if x != nil {
-		return x.ServerId
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *AddSubuserRequest) GetEmail() string {
+func (x *FileContent) GetMime() string {
 	if x != nil {
-		return x.Email
+		return x.Mime
 	}
 	return ""
 }
 
-func (x *AddSubuserRequest) GetPermissions() []string {
-	if x != nil {
-		return x.Permissions
-	}
-	return nil
-}
-
-type UpdateSubuserRequest struct {
+type WriteFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	SubuserId     string                 `protobuf:"bytes,2,opt,name=subuser_id,json=subuserId,proto3" json:"subuser_id,omitempty"`
-	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSubuserRequest) Reset() {
-	*x = UpdateSubuserRequest{}
-	mi := &file_plugin_proto_msgTypes[56]
+func (x *WriteFileRequest) Reset() {
+	*x = WriteFileRequest{}
+	mi := &file_plugin_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSubuserRequest) String() string {
+func (x *WriteFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubuserRequest) ProtoMessage() {}
+func (*WriteFileRequest) ProtoMessage() {}
 
-func (x *UpdateSubuserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[56]
+func (x *WriteFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3941,55 +8993,56 @@ func (x *UpdateSubuserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubuserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubuserRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use WriteFileRequest.ProtoReflect.Descriptor instead.
+func (*WriteFileRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *UpdateSubuserRequest) GetServerId() string {
+func (x *WriteFileRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *UpdateSubuserRequest) GetSubuserId() string {
+func (x *WriteFileRequest) GetPath() string {
 	if x != nil {
-		return x.SubuserId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *UpdateSubuserRequest) GetPermissions() []string {
+func (x *WriteFileRequest) GetContent() []byte {
 	if x != nil {
-		return x.Permissions
+		return x.Content
 	}
 	return nil
 }
 
-type RemoveSubuserRequest struct {
+type MoveFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	SubuserId     string                 `protobuf:"bytes,2,opt,name=subuser_id,json=subuserId,proto3" json:"subuser_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveSubuserRequest) Reset() {
-	*x = RemoveSubuserRequest{}
-	mi := &file_plugin_proto_msgTypes[57]
+func (x *MoveFileRequest) Reset() {
+	*x = MoveFileRequest{}
+	mi := &file_plugin_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveSubuserRequest) String() string {
+func (x *MoveFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveSubuserRequest) ProtoMessage() {}
+func (*MoveFileRequest) ProtoMessage() {}
 
-func (x *RemoveSubuserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[57]
+func (x *MoveFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4000,53 +9053,62 @@ func (x *RemoveSubuserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveSubuserRequest.ProtoReflect.Descriptor instead.
-func (*RemoveSubuserRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use MoveFileRequest.ProtoReflect.Descriptor instead.
+func (*MoveFileRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *RemoveSubuserRequest) GetServerId() string {
+func (x *MoveFileRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *RemoveSubuserRequest) GetSubuserId() string {
+func (x *MoveFileRequest) GetFrom() string {
 	if x != nil {
-		return x.SubuserId
+		return x.From
 	}
 	return ""
 }
 
-// Database
-type Database struct {
+func (x *MoveFileRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Backups
+type Backup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Host          string                 `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int32                  `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ServerId      string                 `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Database) Reset() {
-	*x = Database{}
-	mi := &file_plugin_proto_msgTypes[58]
+func (x *Backup) Reset() {
+	*x = Backup{}
+	mi := &file_plugin_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Database) String() string {
+func (x *Backup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Database) ProtoMessage() {}
+func (*Backup) ProtoMessage() {}
 
-func (x *Database) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[58]
+func (x *Backup) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4057,75 +9119,89 @@ func (x *Database) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Database.ProtoReflect.Descriptor instead.
-func (*Database) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
+func (*Backup) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *Database) GetId() string {
+func (x *Backup) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Database) GetName() string {
+func (x *Backup) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Database) GetUsername() string {
+func (x *Backup) GetSize() int64 {
 	if x != nil {
-		return x.Username
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Backup) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *Database) GetPassword() string {
+func (x *Backup) GetServerId() string {
 	if x != nil {
-		return x.Password
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *Database) GetHost() string {
+func (x *Backup) GetState() string {
 	if x != nil {
-		return x.Host
+		return x.State
 	}
 	return ""
 }
 
-func (x *Database) GetPort() int32 {
+func (x *Backup) GetError() string {
 	if x != nil {
-		return x.Port
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type ListDatabasesResponse struct {
+func (x *Backup) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type ListBackupsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Databases     []*Database            `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	Backups       []*Backup              `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDatabasesResponse) Reset() {
-	*x = ListDatabasesResponse{}
-	mi := &file_plugin_proto_msgTypes[59]
+func (x *ListBackupsResponse) Reset() {
+	*x = ListBackupsResponse{}
+	mi := &file_plugin_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDatabasesResponse) String() string {
+func (x *ListBackupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDatabasesResponse) ProtoMessage() {}
+func (*ListBackupsResponse) ProtoMessage() {}
 
-func (x *ListDatabasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[59]
+func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4136,42 +9212,41 @@ func (x *ListDatabasesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDatabasesResponse.ProtoReflect.Descriptor instead.
-func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ListBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *ListDatabasesResponse) GetDatabases() []*Database {
+func (x *ListBackupsResponse) GetBackups() []*Backup {
 	if x != nil {
-		return x.Databases
+		return x.Backups
 	}
 	return nil
 }
 
-type CreateDatabaseRequest struct {
+type CreateBackupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	HostId        string                 `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDatabaseRequest) Reset() {
-	*x = CreateDatabaseRequest{}
-	mi := &file_plugin_proto_msgTypes[60]
+func (x *CreateBackupRequest) Reset() {
+	*x = CreateBackupRequest{}
+	mi := &file_plugin_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDatabaseRequest) String() string {
+func (x *CreateBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDatabaseRequest) ProtoMessage() {}
+func (*CreateBackupRequest) ProtoMessage() {}
 
-func (x *CreateDatabaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[60]
+func (x *CreateBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4182,61 +9257,48 @@ func (x *CreateDatabaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDatabaseRequest.ProtoReflect.Descriptor instead.
-func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use CreateBackupRequest.ProtoReflect.Descriptor instead.
+func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *CreateDatabaseRequest) GetServerId() string {
+func (x *CreateBackupRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *CreateDatabaseRequest) GetHostId() string {
-	if x != nil {
-		return x.HostId
-	}
-	return ""
-}
-
-func (x *CreateDatabaseRequest) GetName() string {
+func (x *CreateBackupRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// Database Hosts
-type DatabaseHost struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Host           string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
-	Port           int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
-	Username       string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	MaxDatabases   int32                  `protobuf:"varint,6,opt,name=max_databases,json=maxDatabases,proto3" json:"max_databases,omitempty"`
-	DatabasesCount int32                  `protobuf:"varint,7,opt,name=databases_count,json=databasesCount,proto3" json:"databases_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	BackupId      string                 `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseHost) Reset() {
-	*x = DatabaseHost{}
-	mi := &file_plugin_proto_msgTypes[61]
+func (x *DeleteBackupRequest) Reset() {
+	*x = DeleteBackupRequest{}
+	mi := &file_plugin_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseHost) String() string {
+func (x *DeleteBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseHost) ProtoMessage() {}
+func (*DeleteBackupRequest) ProtoMessage() {}
 
-func (x *DatabaseHost) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[61]
+func (x *DeleteBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4247,82 +9309,48 @@ func (x *DatabaseHost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseHost.ProtoReflect.Descriptor instead.
-func (*DatabaseHost) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *DatabaseHost) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *DatabaseHost) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *DatabaseHost) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *DatabaseHost) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+// Deprecated: Use DeleteBackupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBackupRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *DatabaseHost) GetUsername() string {
+func (x *DeleteBackupRequest) GetServerId() string {
 	if x != nil {
-		return x.Username
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *DatabaseHost) GetMaxDatabases() int32 {
-	if x != nil {
-		return x.MaxDatabases
-	}
-	return 0
-}
-
-func (x *DatabaseHost) GetDatabasesCount() int32 {
+func (x *DeleteBackupRequest) GetBackupId() string {
 	if x != nil {
-		return x.DatabasesCount
+		return x.BackupId
 	}
-	return 0
+	return ""
 }
 
-type ListDatabaseHostsResponse struct {
+type BackupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hosts         []*DatabaseHost        `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	BackupId      string                 `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDatabaseHostsResponse) Reset() {
-	*x = ListDatabaseHostsResponse{}
-	mi := &file_plugin_proto_msgTypes[62]
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	mi := &file_plugin_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDatabaseHostsResponse) String() string {
+func (x *BackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDatabaseHostsResponse) ProtoMessage() {}
+func (*BackupRequest) ProtoMessage() {}
 
-func (x *ListDatabaseHostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[62]
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4333,45 +9361,49 @@ func (x *ListDatabaseHostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDatabaseHostsResponse.ProtoReflect.Descriptor instead.
-func (*ListDatabaseHostsResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *ListDatabaseHostsResponse) GetHosts() []*DatabaseHost {
+func (x *BackupRequest) GetServerId() string {
 	if x != nil {
-		return x.Hosts
+		return x.ServerId
 	}
-	return nil
+	return ""
 }
 
-type CreateDatabaseHostRequest struct {
+func (x *BackupRequest) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
+	}
+	return ""
+}
+
+type RestoreBackupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
-	MaxDatabases  int32                  `protobuf:"varint,6,opt,name=max_databases,json=maxDatabases,proto3" json:"max_databases,omitempty"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	BackupId      string                 `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	Truncate      bool                   `protobuf:"varint,3,opt,name=truncate,proto3" json:"truncate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDatabaseHostRequest) Reset() {
-	*x = CreateDatabaseHostRequest{}
-	mi := &file_plugin_proto_msgTypes[63]
+func (x *RestoreBackupRequest) Reset() {
+	*x = RestoreBackupRequest{}
+	mi := &file_plugin_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDatabaseHostRequest) String() string {
+func (x *RestoreBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDatabaseHostRequest) ProtoMessage() {}
+func (*RestoreBackupRequest) ProtoMessage() {}
 
-func (x *CreateDatabaseHostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[63]
+func (x *RestoreBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4382,81 +9414,61 @@ func (x *CreateDatabaseHostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDatabaseHostRequest.ProtoReflect.Descriptor instead.
-func (*CreateDatabaseHostRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *CreateDatabaseHostRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateDatabaseHostRequest) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *CreateDatabaseHostRequest) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+// Deprecated: Use RestoreBackupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBackupRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *CreateDatabaseHostRequest) GetUsername() string {
+func (x *RestoreBackupRequest) GetServerId() string {
 	if x != nil {
-		return x.Username
+		return x.ServerId
 	}
 	return ""
 }
 
-func (x *CreateDatabaseHostRequest) GetPassword() string {
+func (x *RestoreBackupRequest) GetBackupId() string {
 	if x != nil {
-		return x.Password
+		return x.BackupId
 	}
 	return ""
 }
 
-func (x *CreateDatabaseHostRequest) GetMaxDatabases() int32 {
+func (x *RestoreBackupRequest) GetTruncate() bool {
 	if x != nil {
-		return x.MaxDatabases
+		return x.Truncate
 	}
-	return 0
+	return false
 }
 
-type UpdateDatabaseHostRequest struct {
+// Nodes
+type Node struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Fqdn          string                 `protobuf:"bytes,3,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
 	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
-	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	MaxDatabases  int32                  `protobuf:"varint,7,opt,name=max_databases,json=maxDatabases,proto3" json:"max_databases,omitempty"`
+	IsOnline      bool                   `protobuf:"varint,5,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	LastHeartbeat string                 `protobuf:"bytes,6,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	Maintenance   bool                   `protobuf:"varint,7,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDatabaseHostRequest) Reset() {
-	*x = UpdateDatabaseHostRequest{}
-	mi := &file_plugin_proto_msgTypes[64]
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_plugin_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDatabaseHostRequest) String() string {
+func (x *Node) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDatabaseHostRequest) ProtoMessage() {}
+func (*Node) ProtoMessage() {}
 
-func (x *UpdateDatabaseHostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[64]
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4467,87 +9479,94 @@ func (x *UpdateDatabaseHostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDatabaseHostRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDatabaseHostRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *UpdateDatabaseHostRequest) GetId() string {
+func (x *Node) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateDatabaseHostRequest) GetName() string {
+func (x *Node) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateDatabaseHostRequest) GetHost() string {
+func (x *Node) GetFqdn() string {
 	if x != nil {
-		return x.Host
+		return x.Fqdn
 	}
 	return ""
 }
 
-func (x *UpdateDatabaseHostRequest) GetPort() int32 {
+func (x *Node) GetPort() int32 {
 	if x != nil {
 		return x.Port
 	}
 	return 0
 }
 
-func (x *UpdateDatabaseHostRequest) GetUsername() string {
+func (x *Node) GetIsOnline() bool {
 	if x != nil {
-		return x.Username
+		return x.IsOnline
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateDatabaseHostRequest) GetPassword() string {
+func (x *Node) GetLastHeartbeat() string {
 	if x != nil {
-		return x.Password
+		return x.LastHeartbeat
 	}
 	return ""
 }
 
-func (x *UpdateDatabaseHostRequest) GetMaxDatabases() int32 {
+func (x *Node) GetMaintenance() bool {
 	if x != nil {
-		return x.MaxDatabases
+		return x.Maintenance
 	}
-	return 0
-}
-
-// Files
-type FileInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	IsDir         bool                   `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Modified      string                 `protobuf:"bytes,4,opt,name=modified,proto3" json:"modified,omitempty"`
-	Mime          string                 `protobuf:"bytes,5,opt,name=mime,proto3" json:"mime,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return false
 }
 
-func (x *FileInfo) Reset() {
-	*x = FileInfo{}
-	mi := &file_plugin_proto_msgTypes[65]
+type NodeStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NodeId           string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Servers          int32                  `protobuf:"varint,2,opt,name=servers,proto3" json:"servers,omitempty"`
+	AllocationsTotal int32                  `protobuf:"varint,3,opt,name=allocations_total,json=allocationsTotal,proto3" json:"allocations_total,omitempty"`
+	AllocationsUsed  int32                  `protobuf:"varint,4,opt,name=allocations_used,json=allocationsUsed,proto3" json:"allocations_used,omitempty"`
+	MemoryTotal      int64                  `protobuf:"varint,5,opt,name=memory_total,json=memoryTotal,proto3" json:"memory_total,omitempty"`
+	MemoryAllocated  int64                  `protobuf:"varint,6,opt,name=memory_allocated,json=memoryAllocated,proto3" json:"memory_allocated,omitempty"`
+	MemoryUsed       int64                  `protobuf:"varint,7,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
+	DiskTotal        int64                  `protobuf:"varint,8,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`
+	DiskAllocated    int64                  `protobuf:"varint,9,opt,name=disk_allocated,json=diskAllocated,proto3" json:"disk_allocated,omitempty"`
+	DiskUsed         int64                  `protobuf:"varint,10,opt,name=disk_used,json=diskUsed,proto3" json:"disk_used,omitempty"`
+	CpuPercent       float64                `protobuf:"fixed64,11,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	Maintenance      bool                   `protobuf:"varint,12,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	Timestamp        int64                  `protobuf:"varint,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NodeStats) Reset() {
+	*x = NodeStats{}
+	mi := &file_plugin_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileInfo) String() string {
+func (x *NodeStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfo) ProtoMessage() {}
+func (*NodeStats) ProtoMessage() {}
 
-func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[65]
+func (x *NodeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4558,113 +9577,124 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
-func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use NodeStats.ProtoReflect.Descriptor instead.
+func (*NodeStats) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *FileInfo) GetName() string {
+func (x *NodeStats) GetNodeId() string {
 	if x != nil {
-		return x.Name
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *FileInfo) GetIsDir() bool {
+func (x *NodeStats) GetServers() int32 {
 	if x != nil {
-		return x.IsDir
+		return x.Servers
 	}
-	return false
+	return 0
 }
 
-func (x *FileInfo) GetSize() int64 {
+func (x *NodeStats) GetAllocationsTotal() int32 {
 	if x != nil {
-		return x.Size
+		return x.AllocationsTotal
 	}
 	return 0
 }
 
-func (x *FileInfo) GetModified() string {
+func (x *NodeStats) GetAllocationsUsed() int32 {
 	if x != nil {
-		return x.Modified
+		return x.AllocationsUsed
 	}
-	return ""
+	return 0
 }
 
-func (x *FileInfo) GetMime() string {
+func (x *NodeStats) GetMemoryTotal() int64 {
 	if x != nil {
-		return x.Mime
+		return x.MemoryTotal
 	}
-	return ""
+	return 0
 }
 
-type ListFilesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Files         []*FileInfo            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NodeStats) GetMemoryAllocated() int64 {
+	if x != nil {
+		return x.MemoryAllocated
+	}
+	return 0
 }
 
-func (x *ListFilesResponse) Reset() {
-	*x = ListFilesResponse{}
-	mi := &file_plugin_proto_msgTypes[66]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NodeStats) GetMemoryUsed() int64 {
+	if x != nil {
+		return x.MemoryUsed
+	}
+	return 0
 }
 
-func (x *ListFilesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeStats) GetDiskTotal() int64 {
+	if x != nil {
+		return x.DiskTotal
+	}
+	return 0
 }
 
-func (*ListFilesResponse) ProtoMessage() {}
+func (x *NodeStats) GetDiskAllocated() int64 {
+	if x != nil {
+		return x.DiskAllocated
+	}
+	return 0
+}
 
-func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[66]
+func (x *NodeStats) GetDiskUsed() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DiskUsed
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
-func (*ListFilesResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{66}
+func (x *NodeStats) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
 }
 
-func (x *ListFilesResponse) GetFiles() []*FileInfo {
+func (x *NodeStats) GetMaintenance() bool {
 	if x != nil {
-		return x.Files
+		return x.Maintenance
 	}
-	return nil
+	return false
 }
 
-type FilePathRequest struct {
+func (x *NodeStats) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ListNodeStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Stats         []*NodeStats           `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FilePathRequest) Reset() {
-	*x = FilePathRequest{}
-	mi := &file_plugin_proto_msgTypes[67]
+func (x *ListNodeStatsResponse) Reset() {
+	*x = ListNodeStatsResponse{}
+	mi := &file_plugin_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilePathRequest) String() string {
+func (x *ListNodeStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilePathRequest) ProtoMessage() {}
+func (*ListNodeStatsResponse) ProtoMessage() {}
 
-func (x *FilePathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[67]
+func (x *ListNodeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4675,48 +9705,40 @@ func (x *FilePathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilePathRequest.ProtoReflect.Descriptor instead.
-func (*FilePathRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *FilePathRequest) GetServerId() string {
-	if x != nil {
-		return x.ServerId
-	}
-	return ""
+// Deprecated: Use ListNodeStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *FilePathRequest) GetPath() string {
+func (x *ListNodeStatsResponse) GetStats() []*NodeStats {
 	if x != nil {
-		return x.Path
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type FileContent struct {
+type ListNodesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Mime          string                 `protobuf:"bytes,2,opt,name=mime,proto3" json:"mime,omitempty"`
+	Nodes         []*Node                `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileContent) Reset() {
-	*x = FileContent{}
-	mi := &file_plugin_proto_msgTypes[68]
+func (x *ListNodesResponse) Reset() {
+	*x = ListNodesResponse{}
+	mi := &file_plugin_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileContent) String() string {
+func (x *ListNodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileContent) ProtoMessage() {}
+func (*ListNodesResponse) ProtoMessage() {}
 
-func (x *FileContent) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[68]
+func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4727,49 +9749,42 @@ func (x *FileContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
-func (*FileContent) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *FileContent) GetContent() []byte {
+func (x *ListNodesResponse) GetNodes() []*Node {
 	if x != nil {
-		return x.Content
+		return x.Nodes
 	}
 	return nil
 }
 
-func (x *FileContent) GetMime() string {
-	if x != nil {
-		return x.Mime
-	}
-	return ""
-}
-
-type WriteFileRequest struct {
+type CreateNodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fqdn          string                 `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WriteFileRequest) Reset() {
-	*x = WriteFileRequest{}
-	mi := &file_plugin_proto_msgTypes[69]
+func (x *CreateNodeRequest) Reset() {
+	*x = CreateNodeRequest{}
+	mi := &file_plugin_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WriteFileRequest) String() string {
+func (x *CreateNodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteFileRequest) ProtoMessage() {}
+func (*CreateNodeRequest) ProtoMessage() {}
 
-func (x *WriteFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[69]
+func (x *CreateNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4780,56 +9795,55 @@ func (x *WriteFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteFileRequest.ProtoReflect.Descriptor instead.
-func (*WriteFileRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use CreateNodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateNodeRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *WriteFileRequest) GetServerId() string {
+func (x *CreateNodeRequest) GetName() string {
 	if x != nil {
-		return x.ServerId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WriteFileRequest) GetPath() string {
+func (x *CreateNodeRequest) GetFqdn() string {
 	if x != nil {
-		return x.Path
+		return x.Fqdn
 	}
 	return ""
 }
 
-func (x *WriteFileRequest) GetContent() []byte {
+func (x *CreateNodeRequest) GetPort() int32 {
 	if x != nil {
-		return x.Content
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-type MoveFileRequest struct {
+type NodeWithToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveFileRequest) Reset() {
-	*x = MoveFileRequest{}
-	mi := &file_plugin_proto_msgTypes[70]
+func (x *NodeWithToken) Reset() {
+	*x = NodeWithToken{}
+	mi := &file_plugin_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveFileRequest) String() string {
+func (x *NodeWithToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveFileRequest) ProtoMessage() {}
+func (*NodeWithToken) ProtoMessage() {}
 
-func (x *MoveFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[70]
+func (x *NodeWithToken) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4840,58 +9854,48 @@ func (x *MoveFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveFileRequest.ProtoReflect.Descriptor instead.
-func (*MoveFileRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *MoveFileRequest) GetServerId() string {
-	if x != nil {
-		return x.ServerId
-	}
-	return ""
+// Deprecated: Use NodeWithToken.ProtoReflect.Descriptor instead.
+func (*NodeWithToken) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *MoveFileRequest) GetFrom() string {
+func (x *NodeWithToken) GetNode() *Node {
 	if x != nil {
-		return x.From
+		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *MoveFileRequest) GetTo() string {
+func (x *NodeWithToken) GetToken() string {
 	if x != nil {
-		return x.To
+		return x.Token
 	}
 	return ""
 }
 
-// Backups
-type Backup struct {
+type NodeToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Backup) Reset() {
-	*x = Backup{}
-	mi := &file_plugin_proto_msgTypes[71]
+func (x *NodeToken) Reset() {
+	*x = NodeToken{}
+	mi := &file_plugin_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Backup) String() string {
+func (x *NodeToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Backup) ProtoMessage() {}
+func (*NodeToken) ProtoMessage() {}
 
-func (x *Backup) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[71]
+func (x *NodeToken) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4902,61 +9906,61 @@ func (x *Backup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
-func (*Backup) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *Backup) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use NodeToken.ProtoReflect.Descriptor instead.
+func (*NodeToken) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *Backup) GetName() string {
+func (x *NodeToken) GetTokenId() string {
 	if x != nil {
-		return x.Name
+		return x.TokenId
 	}
 	return ""
 }
 
-func (x *Backup) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *Backup) GetCreatedAt() string {
+func (x *NodeToken) GetToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Token
 	}
 	return ""
 }
 
-type ListBackupsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Backups       []*Backup              `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Packages
+type Package struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DockerImage    string                 `protobuf:"bytes,4,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	StartupCommand string                 `protobuf:"bytes,5,opt,name=startup_command,json=startupCommand,proto3" json:"startup_command,omitempty"`
+	StopCommand    string                 `protobuf:"bytes,6,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
+	ConfigFiles    string                 `protobuf:"bytes,7,opt,name=config_files,json=configFiles,proto3" json:"config_files,omitempty"`
+	DefaultMemory  int32                  `protobuf:"varint,8,opt,name=default_memory,json=defaultMemory,proto3" json:"default_memory,omitempty"`
+	DefaultCpu     int32                  `protobuf:"varint,9,opt,name=default_cpu,json=defaultCpu,proto3" json:"default_cpu,omitempty"`
+	DefaultDisk    int32                  `protobuf:"varint,10,opt,name=default_disk,json=defaultDisk,proto3" json:"default_disk,omitempty"`
+	IsPublic       bool                   `protobuf:"varint,11,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	DockerImages   []string               `protobuf:"bytes,12,rep,name=docker_images,json=dockerImages,proto3" json:"docker_images,omitempty"`
+	Variables      []*PackageVariable     `protobuf:"bytes,13,rep,name=variables,proto3" json:"variables,omitempty"`
+	Group          string                 `protobuf:"bytes,14,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListBackupsResponse) Reset() {
-	*x = ListBackupsResponse{}
-	mi := &file_plugin_proto_msgTypes[72]
+func (x *Package) Reset() {
+	*x = Package{}
+	mi := &file_plugin_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBackupsResponse) String() string {
+func (x *Package) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBackupsResponse) ProtoMessage() {}
+func (*Package) ProtoMessage() {}
 
-func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[72]
+func (x *Package) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4967,150 +9971,137 @@ func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBackupsResponse.ProtoReflect.Descriptor instead.
-func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use Package.ProtoReflect.Descriptor instead.
+func (*Package) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *ListBackupsResponse) GetBackups() []*Backup {
+func (x *Package) GetId() string {
 	if x != nil {
-		return x.Backups
+		return x.Id
 	}
-	return nil
-}
-
-type CreateBackupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *CreateBackupRequest) Reset() {
-	*x = CreateBackupRequest{}
-	mi := &file_plugin_proto_msgTypes[73]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Package) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *CreateBackupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Package) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*CreateBackupRequest) ProtoMessage() {}
-
-func (x *CreateBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[73]
+func (x *Package) GetDockerImage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DockerImage
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateBackupRequest.ProtoReflect.Descriptor instead.
-func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{73}
+func (x *Package) GetStartupCommand() string {
+	if x != nil {
+		return x.StartupCommand
+	}
+	return ""
 }
 
-func (x *CreateBackupRequest) GetServerId() string {
+func (x *Package) GetStopCommand() string {
 	if x != nil {
-		return x.ServerId
+		return x.StopCommand
 	}
 	return ""
 }
 
-func (x *CreateBackupRequest) GetName() string {
+func (x *Package) GetConfigFiles() string {
 	if x != nil {
-		return x.Name
+		return x.ConfigFiles
 	}
 	return ""
 }
 
-type DeleteBackupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	BackupId      string                 `protobuf:"bytes,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Package) GetDefaultMemory() int32 {
+	if x != nil {
+		return x.DefaultMemory
+	}
+	return 0
 }
 
-func (x *DeleteBackupRequest) Reset() {
-	*x = DeleteBackupRequest{}
-	mi := &file_plugin_proto_msgTypes[74]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Package) GetDefaultCpu() int32 {
+	if x != nil {
+		return x.DefaultCpu
+	}
+	return 0
 }
 
-func (x *DeleteBackupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Package) GetDefaultDisk() int32 {
+	if x != nil {
+		return x.DefaultDisk
+	}
+	return 0
 }
 
-func (*DeleteBackupRequest) ProtoMessage() {}
-
-func (x *DeleteBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[74]
+func (x *Package) GetIsPublic() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IsPublic
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DeleteBackupRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBackupRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{74}
+func (x *Package) GetDockerImages() []string {
+	if x != nil {
+		return x.DockerImages
+	}
+	return nil
 }
 
-func (x *DeleteBackupRequest) GetServerId() string {
+func (x *Package) GetVariables() []*PackageVariable {
 	if x != nil {
-		return x.ServerId
+		return x.Variables
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteBackupRequest) GetBackupId() string {
+func (x *Package) GetGroup() string {
 	if x != nil {
-		return x.BackupId
+		return x.Group
 	}
 	return ""
 }
 
-// Nodes
-type Node struct {
+type PackageVariable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Fqdn          string                 `protobuf:"bytes,3,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
-	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
-	IsOnline      bool                   `protobuf:"varint,5,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
-	LastHeartbeat string                 `protobuf:"bytes,6,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	EnvKey        string                 `protobuf:"bytes,2,opt,name=env_key,json=envKey,proto3" json:"env_key,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Rules         string                 `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+	UserEditable  bool                   `protobuf:"varint,6,opt,name=user_editable,json=userEditable,proto3" json:"user_editable,omitempty"`
+	UserViewable  bool                   `protobuf:"varint,7,opt,name=user_viewable,json=userViewable,proto3" json:"user_viewable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Node) Reset() {
-	*x = Node{}
-	mi := &file_plugin_proto_msgTypes[75]
+func (x *PackageVariable) Reset() {
+	*x = PackageVariable{}
+	mi := &file_plugin_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Node) String() string {
+func (x *PackageVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Node) ProtoMessage() {}
+func (*PackageVariable) ProtoMessage() {}
 
-func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[75]
+func (x *PackageVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,75 +10112,82 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Node.ProtoReflect.Descriptor instead.
-func (*Node) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use PackageVariable.ProtoReflect.Descriptor instead.
+func (*PackageVariable) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *Node) GetId() string {
+func (x *PackageVariable) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Node) GetName() string {
+func (x *PackageVariable) GetEnvKey() string {
 	if x != nil {
-		return x.Name
+		return x.EnvKey
 	}
 	return ""
 }
 
-func (x *Node) GetFqdn() string {
+func (x *PackageVariable) GetDescription() string {
 	if x != nil {
-		return x.Fqdn
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Node) GetPort() int32 {
+func (x *PackageVariable) GetDefaultValue() string {
 	if x != nil {
-		return x.Port
+		return x.DefaultValue
 	}
-	return 0
+	return ""
 }
 
-func (x *Node) GetIsOnline() bool {
+func (x *PackageVariable) GetRules() string {
 	if x != nil {
-		return x.IsOnline
+		return x.Rules
+	}
+	return ""
+}
+
+func (x *PackageVariable) GetUserEditable() bool {
+	if x != nil {
+		return x.UserEditable
 	}
 	return false
 }
 
-func (x *Node) GetLastHeartbeat() string {
+func (x *PackageVariable) GetUserViewable() bool {
 	if x != nil {
-		return x.LastHeartbeat
+		return x.UserViewable
 	}
-	return ""
+	return false
 }
 
-type ListNodesResponse struct {
+type ListPackagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*Node                `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Packages      []*Package             `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodesResponse) Reset() {
-	*x = ListNodesResponse{}
-	mi := &file_plugin_proto_msgTypes[76]
+func (x *ListPackagesResponse) Reset() {
+	*x = ListPackagesResponse{}
+	mi := &file_plugin_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodesResponse) String() string {
+func (x *ListPackagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodesResponse) ProtoMessage() {}
+func (*ListPackagesResponse) ProtoMessage() {}
 
-func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[76]
+func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5200,42 +10198,49 @@ func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
-func (*ListNodesResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ListPackagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *ListNodesResponse) GetNodes() []*Node {
+func (x *ListPackagesResponse) GetPackages() []*Package {
 	if x != nil {
-		return x.Nodes
+		return x.Packages
 	}
 	return nil
 }
 
-type CreateNodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fqdn          string                 `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
-	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreatePackageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DockerImage    string                 `protobuf:"bytes,3,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
+	StartupCommand string                 `protobuf:"bytes,4,opt,name=startup_command,json=startupCommand,proto3" json:"startup_command,omitempty"`
+	StopCommand    string                 `protobuf:"bytes,5,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
+	ConfigFiles    string                 `protobuf:"bytes,6,opt,name=config_files,json=configFiles,proto3" json:"config_files,omitempty"`
+	DefaultMemory  int32                  `protobuf:"varint,7,opt,name=default_memory,json=defaultMemory,proto3" json:"default_memory,omitempty"`
+	DefaultCpu     int32                  `protobuf:"varint,8,opt,name=default_cpu,json=defaultCpu,proto3" json:"default_cpu,omitempty"`
+	DefaultDisk    int32                  `protobuf:"varint,9,opt,name=default_disk,json=defaultDisk,proto3" json:"default_disk,omitempty"`
+	IsPublic       bool                   `protobuf:"varint,10,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateNodeRequest) Reset() {
-	*x = CreateNodeRequest{}
-	mi := &file_plugin_proto_msgTypes[77]
+func (x *CreatePackageRequest) Reset() {
+	*x = CreatePackageRequest{}
+	mi := &file_plugin_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNodeRequest) String() string {
+func (x *CreatePackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNodeRequest) ProtoMessage() {}
+func (*CreatePackageRequest) ProtoMessage() {}
 
-func (x *CreateNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[77]
+func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5246,138 +10251,82 @@ func (x *CreateNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNodeRequest.ProtoReflect.Descriptor instead.
-func (*CreateNodeRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use CreatePackageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackageRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *CreateNodeRequest) GetName() string {
+func (x *CreatePackageRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateNodeRequest) GetFqdn() string {
+func (x *CreatePackageRequest) GetDescription() string {
 	if x != nil {
-		return x.Fqdn
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateNodeRequest) GetPort() int32 {
+func (x *CreatePackageRequest) GetDockerImage() string {
 	if x != nil {
-		return x.Port
+		return x.DockerImage
 	}
-	return 0
-}
-
-type NodeWithToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *Node                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *NodeWithToken) Reset() {
-	*x = NodeWithToken{}
-	mi := &file_plugin_proto_msgTypes[78]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *NodeWithToken) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*NodeWithToken) ProtoMessage() {}
-
-func (x *NodeWithToken) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[78]
+func (x *CreatePackageRequest) GetStartupCommand() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartupCommand
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NodeWithToken.ProtoReflect.Descriptor instead.
-func (*NodeWithToken) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{78}
+	return ""
 }
 
-func (x *NodeWithToken) GetNode() *Node {
+func (x *CreatePackageRequest) GetStopCommand() string {
 	if x != nil {
-		return x.Node
+		return x.StopCommand
 	}
-	return nil
+	return ""
 }
 
-func (x *NodeWithToken) GetToken() string {
+func (x *CreatePackageRequest) GetConfigFiles() string {
 	if x != nil {
-		return x.Token
+		return x.ConfigFiles
 	}
 	return ""
 }
 
-type NodeToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *NodeToken) Reset() {
-	*x = NodeToken{}
-	mi := &file_plugin_proto_msgTypes[79]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *NodeToken) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*NodeToken) ProtoMessage() {}
-
-func (x *NodeToken) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[79]
+func (x *CreatePackageRequest) GetDefaultMemory() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DefaultMemory
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NodeToken.ProtoReflect.Descriptor instead.
-func (*NodeToken) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{79}
+func (x *CreatePackageRequest) GetDefaultCpu() int32 {
+	if x != nil {
+		return x.DefaultCpu
+	}
+	return 0
 }
 
-func (x *NodeToken) GetTokenId() string {
+func (x *CreatePackageRequest) GetDefaultDisk() int32 {
 	if x != nil {
-		return x.TokenId
+		return x.DefaultDisk
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeToken) GetToken() string {
+func (x *CreatePackageRequest) GetIsPublic() bool {
 	if x != nil {
-		return x.Token
+		return x.IsPublic
 	}
-	return ""
+	return false
 }
 
-// Packages
-type Package struct {
+type UpdatePackageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -5394,21 +10343,21 @@ type Package struct {
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Package) Reset() {
-	*x = Package{}
-	mi := &file_plugin_proto_msgTypes[80]
+func (x *UpdatePackageRequest) Reset() {
+	*x = UpdatePackageRequest{}
+	mi := &file_plugin_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Package) String() string {
+func (x *UpdatePackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Package) ProtoMessage() {}
+func (*UpdatePackageRequest) ProtoMessage() {}
 
-func (x *Package) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[80]
+func (x *UpdatePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5419,110 +10368,114 @@ func (x *Package) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Package.ProtoReflect.Descriptor instead.
-func (*Package) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use UpdatePackageRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePackageRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *Package) GetId() string {
+func (x *UpdatePackageRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Package) GetName() string {
+func (x *UpdatePackageRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Package) GetDescription() string {
+func (x *UpdatePackageRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *Package) GetDockerImage() string {
+func (x *UpdatePackageRequest) GetDockerImage() string {
 	if x != nil {
 		return x.DockerImage
 	}
 	return ""
 }
 
-func (x *Package) GetStartupCommand() string {
+func (x *UpdatePackageRequest) GetStartupCommand() string {
 	if x != nil {
 		return x.StartupCommand
 	}
 	return ""
 }
 
-func (x *Package) GetStopCommand() string {
+func (x *UpdatePackageRequest) GetStopCommand() string {
 	if x != nil {
 		return x.StopCommand
 	}
 	return ""
 }
 
-func (x *Package) GetConfigFiles() string {
+func (x *UpdatePackageRequest) GetConfigFiles() string {
 	if x != nil {
 		return x.ConfigFiles
 	}
 	return ""
 }
 
-func (x *Package) GetDefaultMemory() int32 {
+func (x *UpdatePackageRequest) GetDefaultMemory() int32 {
 	if x != nil {
 		return x.DefaultMemory
 	}
 	return 0
 }
 
-func (x *Package) GetDefaultCpu() int32 {
+func (x *UpdatePackageRequest) GetDefaultCpu() int32 {
 	if x != nil {
 		return x.DefaultCpu
 	}
 	return 0
 }
 
-func (x *Package) GetDefaultDisk() int32 {
+func (x *UpdatePackageRequest) GetDefaultDisk() int32 {
 	if x != nil {
 		return x.DefaultDisk
 	}
 	return 0
 }
 
-func (x *Package) GetIsPublic() bool {
+func (x *UpdatePackageRequest) GetIsPublic() bool {
 	if x != nil {
 		return x.IsPublic
 	}
 	return false
 }
 
-type ListPackagesResponse struct {
+// IP Bans
+type IPBan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Packages      []*Package             `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPackagesResponse) Reset() {
-	*x = ListPackagesResponse{}
-	mi := &file_plugin_proto_msgTypes[81]
+func (x *IPBan) Reset() {
+	*x = IPBan{}
+	mi := &file_plugin_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPackagesResponse) String() string {
+func (x *IPBan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPackagesResponse) ProtoMessage() {}
+func (*IPBan) ProtoMessage() {}
 
-func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[81]
+func (x *IPBan) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5533,49 +10486,61 @@ func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPackagesResponse.ProtoReflect.Descriptor instead.
-func (*ListPackagesResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use IPBan.ProtoReflect.Descriptor instead.
+func (*IPBan) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *ListPackagesResponse) GetPackages() []*Package {
+func (x *IPBan) GetId() string {
 	if x != nil {
-		return x.Packages
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CreatePackageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	DockerImage    string                 `protobuf:"bytes,3,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
-	StartupCommand string                 `protobuf:"bytes,4,opt,name=startup_command,json=startupCommand,proto3" json:"startup_command,omitempty"`
-	StopCommand    string                 `protobuf:"bytes,5,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
-	ConfigFiles    string                 `protobuf:"bytes,6,opt,name=config_files,json=configFiles,proto3" json:"config_files,omitempty"`
-	DefaultMemory  int32                  `protobuf:"varint,7,opt,name=default_memory,json=defaultMemory,proto3" json:"default_memory,omitempty"`
-	DefaultCpu     int32                  `protobuf:"varint,8,opt,name=default_cpu,json=defaultCpu,proto3" json:"default_cpu,omitempty"`
-	DefaultDisk    int32                  `protobuf:"varint,9,opt,name=default_disk,json=defaultDisk,proto3" json:"default_disk,omitempty"`
-	IsPublic       bool                   `protobuf:"varint,10,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *IPBan) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
 }
 
-func (x *CreatePackageRequest) Reset() {
-	*x = CreatePackageRequest{}
-	mi := &file_plugin_proto_msgTypes[82]
+func (x *IPBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IPBan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListIPBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*IPBan               `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIPBansResponse) Reset() {
+	*x = ListIPBansResponse{}
+	mi := &file_plugin_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePackageRequest) String() string {
+func (x *ListIPBansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePackageRequest) ProtoMessage() {}
+func (*ListIPBansResponse) ProtoMessage() {}
 
-func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[82]
+func (x *ListIPBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5586,113 +10551,153 @@ func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePackageRequest.ProtoReflect.Descriptor instead.
-func (*CreatePackageRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use ListIPBansResponse.ProtoReflect.Descriptor instead.
+func (*ListIPBansResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *CreatePackageRequest) GetName() string {
+func (x *ListIPBansResponse) GetBans() []*IPBan {
 	if x != nil {
-		return x.Name
+		return x.Bans
 	}
-	return ""
+	return nil
 }
 
-func (x *CreatePackageRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type CreateIPBanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePackageRequest) GetDockerImage() string {
-	if x != nil {
-		return x.DockerImage
-	}
-	return ""
+func (x *CreateIPBanRequest) Reset() {
+	*x = CreateIPBanRequest{}
+	mi := &file_plugin_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePackageRequest) GetStartupCommand() string {
+func (x *CreateIPBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIPBanRequest) ProtoMessage() {}
+
+func (x *CreateIPBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[143]
 	if x != nil {
-		return x.StartupCommand
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePackageRequest) GetStopCommand() string {
+// Deprecated: Use CreateIPBanRequest.ProtoReflect.Descriptor instead.
+func (*CreateIPBanRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CreateIPBanRequest) GetIp() string {
 	if x != nil {
-		return x.StopCommand
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *CreatePackageRequest) GetConfigFiles() string {
+func (x *CreateIPBanRequest) GetReason() string {
 	if x != nil {
-		return x.ConfigFiles
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreatePackageRequest) GetDefaultMemory() int32 {
-	if x != nil {
-		return x.DefaultMemory
-	}
-	return 0
+// Settings
+type Settings struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationEnabled   bool                   `protobuf:"varint,1,opt,name=registration_enabled,json=registrationEnabled,proto3" json:"registration_enabled,omitempty"`
+	ServerCreationEnabled bool                   `protobuf:"varint,2,opt,name=server_creation_enabled,json=serverCreationEnabled,proto3" json:"server_creation_enabled,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CreatePackageRequest) GetDefaultCpu() int32 {
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_plugin_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[144]
 	if x != nil {
-		return x.DefaultCpu
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePackageRequest) GetDefaultDisk() int32 {
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *Settings) GetRegistrationEnabled() bool {
 	if x != nil {
-		return x.DefaultDisk
+		return x.RegistrationEnabled
 	}
-	return 0
+	return false
 }
 
-func (x *CreatePackageRequest) GetIsPublic() bool {
+func (x *Settings) GetServerCreationEnabled() bool {
 	if x != nil {
-		return x.IsPublic
+		return x.ServerCreationEnabled
 	}
 	return false
 }
 
-type UpdatePackageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	DockerImage    string                 `protobuf:"bytes,4,opt,name=docker_image,json=dockerImage,proto3" json:"docker_image,omitempty"`
-	StartupCommand string                 `protobuf:"bytes,5,opt,name=startup_command,json=startupCommand,proto3" json:"startup_command,omitempty"`
-	StopCommand    string                 `protobuf:"bytes,6,opt,name=stop_command,json=stopCommand,proto3" json:"stop_command,omitempty"`
-	ConfigFiles    string                 `protobuf:"bytes,7,opt,name=config_files,json=configFiles,proto3" json:"config_files,omitempty"`
-	DefaultMemory  int32                  `protobuf:"varint,8,opt,name=default_memory,json=defaultMemory,proto3" json:"default_memory,omitempty"`
-	DefaultCpu     int32                  `protobuf:"varint,9,opt,name=default_cpu,json=defaultCpu,proto3" json:"default_cpu,omitempty"`
-	DefaultDisk    int32                  `protobuf:"varint,10,opt,name=default_disk,json=defaultDisk,proto3" json:"default_disk,omitempty"`
-	IsPublic       bool                   `protobuf:"varint,11,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Activity Logs
+type ActivityLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Ip            string                 `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,7,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePackageRequest) Reset() {
-	*x = UpdatePackageRequest{}
-	mi := &file_plugin_proto_msgTypes[83]
+func (x *ActivityLog) Reset() {
+	*x = ActivityLog{}
+	mi := &file_plugin_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePackageRequest) String() string {
+func (x *ActivityLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePackageRequest) ProtoMessage() {}
+func (*ActivityLog) ProtoMessage() {}
 
-func (x *UpdatePackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[83]
+func (x *ActivityLog) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5703,114 +10708,92 @@ func (x *UpdatePackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePackageRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePackageRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use ActivityLog.ProtoReflect.Descriptor instead.
+func (*ActivityLog) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *UpdatePackageRequest) GetId() string {
+func (x *ActivityLog) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdatePackageRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdatePackageRequest) GetDescription() string {
+func (x *ActivityLog) GetUserId() string {
 	if x != nil {
-		return x.Description
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdatePackageRequest) GetDockerImage() string {
+func (x *ActivityLog) GetUsername() string {
 	if x != nil {
-		return x.DockerImage
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UpdatePackageRequest) GetStartupCommand() string {
+func (x *ActivityLog) GetAction() string {
 	if x != nil {
-		return x.StartupCommand
+		return x.Action
 	}
 	return ""
 }
 
-func (x *UpdatePackageRequest) GetStopCommand() string {
+func (x *ActivityLog) GetDescription() string {
 	if x != nil {
-		return x.StopCommand
+		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdatePackageRequest) GetConfigFiles() string {
+func (x *ActivityLog) GetIp() string {
 	if x != nil {
-		return x.ConfigFiles
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *UpdatePackageRequest) GetDefaultMemory() int32 {
-	if x != nil {
-		return x.DefaultMemory
-	}
-	return 0
-}
-
-func (x *UpdatePackageRequest) GetDefaultCpu() int32 {
-	if x != nil {
-		return x.DefaultCpu
-	}
-	return 0
-}
-
-func (x *UpdatePackageRequest) GetDefaultDisk() int32 {
+func (x *ActivityLog) GetIsAdmin() bool {
 	if x != nil {
-		return x.DefaultDisk
+		return x.IsAdmin
 	}
-	return 0
+	return false
 }
 
-func (x *UpdatePackageRequest) GetIsPublic() bool {
+func (x *ActivityLog) GetCreatedAt() string {
 	if x != nil {
-		return x.IsPublic
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-// IP Bans
-type IPBan struct {
+type GetLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IPBan) Reset() {
-	*x = IPBan{}
-	mi := &file_plugin_proto_msgTypes[84]
+func (x *GetLogsRequest) Reset() {
+	*x = GetLogsRequest{}
+	mi := &file_plugin_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IPBan) String() string {
+func (x *GetLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPBan) ProtoMessage() {}
+func (*GetLogsRequest) ProtoMessage() {}
 
-func (x *IPBan) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[84]
+func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5821,61 +10804,62 @@ func (x *IPBan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPBan.ProtoReflect.Descriptor instead.
-func (*IPBan) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *IPBan) GetId() string {
+func (x *GetLogsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *IPBan) GetIp() string {
+func (x *GetLogsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Ip
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *IPBan) GetReason() string {
+func (x *GetLogsRequest) GetSearch() string {
 	if x != nil {
-		return x.Reason
+		return x.Search
 	}
 	return ""
 }
 
-func (x *IPBan) GetCreatedAt() string {
+func (x *GetLogsRequest) GetFilter() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Filter
 	}
 	return ""
 }
 
-type ListIPBansResponse struct {
+type GetLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bans          []*IPBan               `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	Logs          []*ActivityLog         `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIPBansResponse) Reset() {
-	*x = ListIPBansResponse{}
-	mi := &file_plugin_proto_msgTypes[85]
+func (x *GetLogsResponse) Reset() {
+	*x = GetLogsResponse{}
+	mi := &file_plugin_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIPBansResponse) String() string {
+func (x *GetLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIPBansResponse) ProtoMessage() {}
+func (*GetLogsResponse) ProtoMessage() {}
 
-func (x *ListIPBansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[85]
+func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5886,41 +10870,51 @@ func (x *ListIPBansResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIPBansResponse.ProtoReflect.Descriptor instead.
-func (*ListIPBansResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *ListIPBansResponse) GetBans() []*IPBan {
+func (x *GetLogsResponse) GetLogs() []*ActivityLog {
 	if x != nil {
-		return x.Bans
+		return x.Logs
 	}
 	return nil
 }
 
-type CreateIPBanRequest struct {
+func (x *GetLogsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AuditEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TargetType    string                 `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateIPBanRequest) Reset() {
-	*x = CreateIPBanRequest{}
-	mi := &file_plugin_proto_msgTypes[86]
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_plugin_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateIPBanRequest) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateIPBanRequest) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *CreateIPBanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[86]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5931,49 +10925,115 @@ func (x *CreateIPBanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateIPBanRequest.ProtoReflect.Descriptor instead.
-func (*CreateIPBanRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *CreateIPBanRequest) GetIp() string {
+func (x *AuditEntry) GetAction() string {
 	if x != nil {
-		return x.Ip
+		return x.Action
 	}
 	return ""
 }
 
-func (x *CreateIPBanRequest) GetReason() string {
+func (x *AuditEntry) GetActorId() string {
 	if x != nil {
-		return x.Reason
+		return x.ActorId
 	}
 	return ""
 }
 
-// Settings
-type Settings struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	RegistrationEnabled   bool                   `protobuf:"varint,1,opt,name=registration_enabled,json=registrationEnabled,proto3" json:"registration_enabled,omitempty"`
-	ServerCreationEnabled bool                   `protobuf:"varint,2,opt,name=server_creation_enabled,json=serverCreationEnabled,proto3" json:"server_creation_enabled,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *AuditEntry) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
 }
 
-func (x *Settings) Reset() {
-	*x = Settings{}
-	mi := &file_plugin_proto_msgTypes[87]
+func (x *AuditEntry) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type AuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRequest) Reset() {
+	*x = AuditRequest{}
+	mi := &file_plugin_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Settings) String() string {
+func (x *AuditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Settings) ProtoMessage() {}
+func (*AuditRequest) ProtoMessage() {}
 
-func (x *Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[87]
+func (x *AuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequest.ProtoReflect.Descriptor instead.
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AuditRequest) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Utility
+type LogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Fields        map[string]string      `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	mi := &file_plugin_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRequest) ProtoMessage() {}
+
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5984,55 +11044,60 @@ func (x *Settings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
-func (*Settings) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *LogRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
 }
 
-func (x *Settings) GetRegistrationEnabled() bool {
+func (x *LogRequest) GetMessage() string {
 	if x != nil {
-		return x.RegistrationEnabled
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *Settings) GetServerCreationEnabled() bool {
+func (x *LogRequest) GetFields() map[string]string {
 	if x != nil {
-		return x.ServerCreationEnabled
+		return x.Fields
 	}
-	return false
+	return nil
 }
 
-// Activity Logs
-type ActivityLog struct {
+type ErrorReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Ip            string                 `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,7,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Stack         string                 `protobuf:"bytes,2,opt,name=stack,proto3" json:"stack,omitempty"`
+	PluginVersion string                 `protobuf:"bytes,3,opt,name=plugin_version,json=pluginVersion,proto3" json:"plugin_version,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	RequestId     string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Panic         bool                   `protobuf:"varint,6,opt,name=panic,proto3" json:"panic,omitempty"`
+	Suppressed    int32                  `protobuf:"varint,7,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityLog) Reset() {
-	*x = ActivityLog{}
-	mi := &file_plugin_proto_msgTypes[88]
+func (x *ErrorReport) Reset() {
+	*x = ErrorReport{}
+	mi := &file_plugin_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityLog) String() string {
+func (x *ErrorReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityLog) ProtoMessage() {}
+func (*ErrorReport) ProtoMessage() {}
 
-func (x *ActivityLog) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[88]
+func (x *ErrorReport) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6043,92 +11108,82 @@ func (x *ActivityLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityLog.ProtoReflect.Descriptor instead.
-func (*ActivityLog) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{88}
-}
-
-func (x *ActivityLog) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ErrorReport.ProtoReflect.Descriptor instead.
+func (*ErrorReport) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *ActivityLog) GetUserId() string {
+func (x *ErrorReport) GetMessage() string {
 	if x != nil {
-		return x.UserId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ActivityLog) GetUsername() string {
+func (x *ErrorReport) GetStack() string {
 	if x != nil {
-		return x.Username
+		return x.Stack
 	}
 	return ""
 }
 
-func (x *ActivityLog) GetAction() string {
+func (x *ErrorReport) GetPluginVersion() string {
 	if x != nil {
-		return x.Action
+		return x.PluginVersion
 	}
 	return ""
 }
 
-func (x *ActivityLog) GetDescription() string {
+func (x *ErrorReport) GetSource() string {
 	if x != nil {
-		return x.Description
+		return x.Source
 	}
 	return ""
 }
 
-func (x *ActivityLog) GetIp() string {
+func (x *ErrorReport) GetRequestId() string {
 	if x != nil {
-		return x.Ip
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ActivityLog) GetIsAdmin() bool {
+func (x *ErrorReport) GetPanic() bool {
 	if x != nil {
-		return x.IsAdmin
+		return x.Panic
 	}
 	return false
 }
 
-func (x *ActivityLog) GetCreatedAt() string {
+func (x *ErrorReport) GetSuppressed() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Suppressed
 	}
-	return ""
+	return 0
 }
 
-type GetLogsRequest struct {
+type KVRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
-	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLogsRequest) Reset() {
-	*x = GetLogsRequest{}
-	mi := &file_plugin_proto_msgTypes[89]
+func (x *KVRequest) Reset() {
+	*x = KVRequest{}
+	mi := &file_plugin_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLogsRequest) String() string {
+func (x *KVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLogsRequest) ProtoMessage() {}
+func (*KVRequest) ProtoMessage() {}
 
-func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[89]
+func (x *KVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6139,62 +11194,103 @@ func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use KVRequest.ProtoReflect.Descriptor instead.
+func (*KVRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetLogsRequest) GetLimit() int32 {
+func (x *KVRequest) GetKey() string {
 	if x != nil {
-		return x.Limit
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLogsRequest) GetOffset() int32 {
+type KVResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVResponse) Reset() {
+	*x = KVResponse{}
+	mi := &file_plugin_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVResponse) ProtoMessage() {}
+
+func (x *KVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[153]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetLogsRequest) GetSearch() string {
+// Deprecated: Use KVResponse.ProtoReflect.Descriptor instead.
+func (*KVResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *KVResponse) GetValue() string {
 	if x != nil {
-		return x.Search
+		return x.Value
 	}
 	return ""
 }
 
-func (x *GetLogsRequest) GetFilter() string {
+func (x *KVResponse) GetFound() bool {
 	if x != nil {
-		return x.Filter
+		return x.Found
 	}
-	return ""
+	return false
 }
 
-type GetLogsResponse struct {
+func (x *KVResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type KVSetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          []*ActivityLog         `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLogsResponse) Reset() {
-	*x = GetLogsResponse{}
-	mi := &file_plugin_proto_msgTypes[90]
+func (x *KVSetRequest) Reset() {
+	*x = KVSetRequest{}
+	mi := &file_plugin_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLogsResponse) String() string {
+func (x *KVSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLogsResponse) ProtoMessage() {}
+func (*KVSetRequest) ProtoMessage() {}
 
-func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[90]
+func (x *KVSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6205,49 +11301,61 @@ func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetLogsResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use KVSetRequest.ProtoReflect.Descriptor instead.
+func (*KVSetRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *GetLogsResponse) GetLogs() []*ActivityLog {
+func (x *KVSetRequest) GetKey() string {
 	if x != nil {
-		return x.Logs
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KVSetRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *KVSetRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-func (x *GetLogsResponse) GetTotal() int32 {
+func (x *KVSetRequest) GetTtlSeconds() int64 {
 	if x != nil {
-		return x.Total
+		return x.TtlSeconds
 	}
 	return 0
 }
 
-// Utility
-type LogRequest struct {
+type KVListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogRequest) Reset() {
-	*x = LogRequest{}
-	mi := &file_plugin_proto_msgTypes[91]
+func (x *KVListRequest) Reset() {
+	*x = KVListRequest{}
+	mi := &file_plugin_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogRequest) String() string {
+func (x *KVListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogRequest) ProtoMessage() {}
+func (*KVListRequest) ProtoMessage() {}
 
-func (x *LogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[91]
+func (x *KVListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6258,47 +11366,40 @@ func (x *LogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
-func (*LogRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{91}
-}
-
-func (x *LogRequest) GetLevel() string {
-	if x != nil {
-		return x.Level
-	}
-	return ""
+// Deprecated: Use KVListRequest.ProtoReflect.Descriptor instead.
+func (*KVListRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *LogRequest) GetMessage() string {
+func (x *KVListRequest) GetPrefix() string {
 	if x != nil {
-		return x.Message
+		return x.Prefix
 	}
 	return ""
 }
 
-type KVRequest struct {
+type KVListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KVRequest) Reset() {
-	*x = KVRequest{}
-	mi := &file_plugin_proto_msgTypes[92]
+func (x *KVListResponse) Reset() {
+	*x = KVListResponse{}
+	mi := &file_plugin_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KVRequest) String() string {
+func (x *KVListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVRequest) ProtoMessage() {}
+func (*KVListResponse) ProtoMessage() {}
 
-func (x *KVRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[92]
+func (x *KVListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6309,41 +11410,43 @@ func (x *KVRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVRequest.ProtoReflect.Descriptor instead.
-func (*KVRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use KVListResponse.ProtoReflect.Descriptor instead.
+func (*KVListResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *KVRequest) GetKey() string {
+func (x *KVListResponse) GetKeys() []string {
 	if x != nil {
-		return x.Key
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
-type KVResponse struct {
+type KVCompareAndSwapRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Old           []byte                 `protobuf:"bytes,2,opt,name=old,proto3" json:"old,omitempty"`
+	New           []byte                 `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`
+	OldMissing    bool                   `protobuf:"varint,4,opt,name=old_missing,json=oldMissing,proto3" json:"old_missing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KVResponse) Reset() {
-	*x = KVResponse{}
-	mi := &file_plugin_proto_msgTypes[93]
+func (x *KVCompareAndSwapRequest) Reset() {
+	*x = KVCompareAndSwapRequest{}
+	mi := &file_plugin_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KVResponse) String() string {
+func (x *KVCompareAndSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVResponse) ProtoMessage() {}
+func (*KVCompareAndSwapRequest) ProtoMessage() {}
 
-func (x *KVResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[93]
+func (x *KVCompareAndSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6354,48 +11457,61 @@ func (x *KVResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVResponse.ProtoReflect.Descriptor instead.
-func (*KVResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use KVCompareAndSwapRequest.ProtoReflect.Descriptor instead.
+func (*KVCompareAndSwapRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *KVResponse) GetValue() string {
+func (x *KVCompareAndSwapRequest) GetKey() string {
 	if x != nil {
-		return x.Value
+		return x.Key
 	}
 	return ""
 }
 
-func (x *KVResponse) GetFound() bool {
+func (x *KVCompareAndSwapRequest) GetOld() []byte {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *KVCompareAndSwapRequest) GetNew() []byte {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+func (x *KVCompareAndSwapRequest) GetOldMissing() bool {
 	if x != nil {
-		return x.Found
+		return x.OldMissing
 	}
 	return false
 }
 
-type KVSetRequest struct {
+type KVCompareAndSwapResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Swapped       bool                   `protobuf:"varint,1,opt,name=swapped,proto3" json:"swapped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KVSetRequest) Reset() {
-	*x = KVSetRequest{}
-	mi := &file_plugin_proto_msgTypes[94]
+func (x *KVCompareAndSwapResponse) Reset() {
+	*x = KVCompareAndSwapResponse{}
+	mi := &file_plugin_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KVSetRequest) String() string {
+func (x *KVCompareAndSwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVSetRequest) ProtoMessage() {}
+func (*KVCompareAndSwapResponse) ProtoMessage() {}
 
-func (x *KVSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[94]
+func (x *KVCompareAndSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6406,23 +11522,16 @@ func (x *KVSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVSetRequest.ProtoReflect.Descriptor instead.
-func (*KVSetRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *KVSetRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use KVCompareAndSwapResponse.ProtoReflect.Descriptor instead.
+func (*KVCompareAndSwapResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *KVSetRequest) GetValue() string {
+func (x *KVCompareAndSwapResponse) GetSwapped() bool {
 	if x != nil {
-		return x.Value
+		return x.Swapped
 	}
-	return ""
+	return false
 }
 
 type QueryDBRequest struct {
@@ -6435,7 +11544,7 @@ type QueryDBRequest struct {
 
 func (x *QueryDBRequest) Reset() {
 	*x = QueryDBRequest{}
-	mi := &file_plugin_proto_msgTypes[95]
+	mi := &file_plugin_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6447,7 +11556,7 @@ func (x *QueryDBRequest) String() string {
 func (*QueryDBRequest) ProtoMessage() {}
 
 func (x *QueryDBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[95]
+	mi := &file_plugin_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6460,7 +11569,7 @@ func (x *QueryDBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDBRequest.ProtoReflect.Descriptor instead.
 func (*QueryDBRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{95}
+	return file_plugin_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *QueryDBRequest) GetQuery() string {
@@ -6486,7 +11595,7 @@ type QueryDBResponse struct {
 
 func (x *QueryDBResponse) Reset() {
 	*x = QueryDBResponse{}
-	mi := &file_plugin_proto_msgTypes[96]
+	mi := &file_plugin_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6498,7 +11607,7 @@ func (x *QueryDBResponse) String() string {
 func (*QueryDBResponse) ProtoMessage() {}
 
 func (x *QueryDBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[96]
+	mi := &file_plugin_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6511,7 +11620,7 @@ func (x *QueryDBResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDBResponse.ProtoReflect.Descriptor instead.
 func (*QueryDBResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{96}
+	return file_plugin_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *QueryDBResponse) GetRows() [][]byte {
@@ -6531,7 +11640,7 @@ type BroadcastEventRequest struct {
 
 func (x *BroadcastEventRequest) Reset() {
 	*x = BroadcastEventRequest{}
-	mi := &file_plugin_proto_msgTypes[97]
+	mi := &file_plugin_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6543,7 +11652,7 @@ func (x *BroadcastEventRequest) String() string {
 func (*BroadcastEventRequest) ProtoMessage() {}
 
 func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[97]
+	mi := &file_plugin_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6556,7 +11665,7 @@ func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastEventRequest.ProtoReflect.Descriptor instead.
 func (*BroadcastEventRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{97}
+	return file_plugin_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *BroadcastEventRequest) GetEventType() string {
@@ -6579,13 +11688,14 @@ type NotificationRequest struct {
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Admins        bool                   `protobuf:"varint,5,opt,name=admins,proto3" json:"admins,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NotificationRequest) Reset() {
 	*x = NotificationRequest{}
-	mi := &file_plugin_proto_msgTypes[98]
+	mi := &file_plugin_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6597,7 +11707,7 @@ func (x *NotificationRequest) String() string {
 func (*NotificationRequest) ProtoMessage() {}
 
 func (x *NotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[98]
+	mi := &file_plugin_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6610,7 +11720,7 @@ func (x *NotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationRequest.ProtoReflect.Descriptor instead.
 func (*NotificationRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{98}
+	return file_plugin_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *NotificationRequest) GetUserId() string {
@@ -6641,6 +11751,73 @@ func (x *NotificationRequest) GetType() string {
 	return ""
 }
 
+func (x *NotificationRequest) GetAdmins() bool {
+	if x != nil {
+		return x.Admins
+	}
+	return false
+}
+
+type SendEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	HtmlBody      string                 `protobuf:"bytes,3,opt,name=html_body,json=htmlBody,proto3" json:"html_body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailRequest) Reset() {
+	*x = SendEmailRequest{}
+	mi := &file_plugin_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailRequest) ProtoMessage() {}
+
+func (x *SendEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendEmailRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *SendEmailRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendEmailRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SendEmailRequest) GetHtmlBody() string {
+	if x != nil {
+		return x.HtmlBody
+	}
+	return ""
+}
+
 // HTTP Client
 type PluginHTTPRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -6655,7 +11832,7 @@ type PluginHTTPRequest struct {
 
 func (x *PluginHTTPRequest) Reset() {
 	*x = PluginHTTPRequest{}
-	mi := &file_plugin_proto_msgTypes[99]
+	mi := &file_plugin_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6667,7 +11844,7 @@ func (x *PluginHTTPRequest) String() string {
 func (*PluginHTTPRequest) ProtoMessage() {}
 
 func (x *PluginHTTPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[99]
+	mi := &file_plugin_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6680,7 +11857,7 @@ func (x *PluginHTTPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginHTTPRequest.ProtoReflect.Descriptor instead.
 func (*PluginHTTPRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{99}
+	return file_plugin_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *PluginHTTPRequest) GetMethod() string {
@@ -6730,7 +11907,7 @@ type PluginHTTPResponse struct {
 
 func (x *PluginHTTPResponse) Reset() {
 	*x = PluginHTTPResponse{}
-	mi := &file_plugin_proto_msgTypes[100]
+	mi := &file_plugin_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6742,7 +11919,7 @@ func (x *PluginHTTPResponse) String() string {
 func (*PluginHTTPResponse) ProtoMessage() {}
 
 func (x *PluginHTTPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[100]
+	mi := &file_plugin_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6755,7 +11932,7 @@ func (x *PluginHTTPResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginHTTPResponse.ProtoReflect.Descriptor instead.
 func (*PluginHTTPResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{100}
+	return file_plugin_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *PluginHTTPResponse) GetStatus() int32 {
@@ -6796,21 +11973,217 @@ type CallPluginRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CallPluginRequest) Reset() {
-	*x = CallPluginRequest{}
-	mi := &file_plugin_proto_msgTypes[101]
+func (x *CallPluginRequest) Reset() {
+	*x = CallPluginRequest{}
+	mi := &file_plugin_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallPluginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallPluginRequest) ProtoMessage() {}
+
+func (x *CallPluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallPluginRequest.ProtoReflect.Descriptor instead.
+func (*CallPluginRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *CallPluginRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *CallPluginRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CallPluginRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CallPluginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallPluginResponse) Reset() {
+	*x = CallPluginResponse{}
+	mi := &file_plugin_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallPluginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallPluginResponse) ProtoMessage() {}
+
+func (x *CallPluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallPluginResponse.ProtoReflect.Descriptor instead.
+func (*CallPluginResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *CallPluginResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CallPluginResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PluginRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Query         map[string]string      `protobuf:"bytes,5,rep,name=query,proto3" json:"query,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          []byte                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginRouteRequest) Reset() {
+	*x = PluginRouteRequest{}
+	mi := &file_plugin_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginRouteRequest) ProtoMessage() {}
+
+func (x *PluginRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginRouteRequest.ProtoReflect.Descriptor instead.
+func (*PluginRouteRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *PluginRouteRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *PluginRouteRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PluginRouteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PluginRouteRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PluginRouteRequest) GetQuery() map[string]string {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *PluginRouteRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type PluginSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginSummary) Reset() {
+	*x = PluginSummary{}
+	mi := &file_plugin_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallPluginRequest) String() string {
+func (x *PluginSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallPluginRequest) ProtoMessage() {}
+func (*PluginSummary) ProtoMessage() {}
 
-func (x *CallPluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[101]
+func (x *PluginSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6821,55 +12194,54 @@ func (x *CallPluginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallPluginRequest.ProtoReflect.Descriptor instead.
-func (*CallPluginRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use PluginSummary.ProtoReflect.Descriptor instead.
+func (*PluginSummary) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *CallPluginRequest) GetPluginId() string {
+func (x *PluginSummary) GetId() string {
 	if x != nil {
-		return x.PluginId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CallPluginRequest) GetMethod() string {
+func (x *PluginSummary) GetName() string {
 	if x != nil {
-		return x.Method
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CallPluginRequest) GetData() []byte {
+func (x *PluginSummary) GetVersion() string {
 	if x != nil {
-		return x.Data
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-type CallPluginResponse struct {
+type ListPluginsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Plugins       []*PluginSummary       `protobuf:"bytes,1,rep,name=plugins,proto3" json:"plugins,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CallPluginResponse) Reset() {
-	*x = CallPluginResponse{}
-	mi := &file_plugin_proto_msgTypes[102]
+func (x *ListPluginsResponse) Reset() {
+	*x = ListPluginsResponse{}
+	mi := &file_plugin_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallPluginResponse) String() string {
+func (x *ListPluginsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallPluginResponse) ProtoMessage() {}
+func (*ListPluginsResponse) ProtoMessage() {}
 
-func (x *CallPluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[102]
+func (x *ListPluginsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6880,25 +12252,18 @@ func (x *CallPluginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallPluginResponse.ProtoReflect.Descriptor instead.
-func (*CallPluginResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use ListPluginsResponse.ProtoReflect.Descriptor instead.
+func (*ListPluginsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *CallPluginResponse) GetData() []byte {
+func (x *ListPluginsResponse) GetPlugins() []*PluginSummary {
 	if x != nil {
-		return x.Data
+		return x.Plugins
 	}
 	return nil
 }
 
-func (x *CallPluginResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
 type AddonTypeInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TypeId        string                 `protobuf:"bytes,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
@@ -6910,7 +12275,7 @@ type AddonTypeInfo struct {
 
 func (x *AddonTypeInfo) Reset() {
 	*x = AddonTypeInfo{}
-	mi := &file_plugin_proto_msgTypes[103]
+	mi := &file_plugin_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6922,7 +12287,7 @@ func (x *AddonTypeInfo) String() string {
 func (*AddonTypeInfo) ProtoMessage() {}
 
 func (x *AddonTypeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[103]
+	mi := &file_plugin_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6935,7 +12300,7 @@ func (x *AddonTypeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddonTypeInfo.ProtoReflect.Descriptor instead.
 func (*AddonTypeInfo) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{103}
+	return file_plugin_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *AddonTypeInfo) GetTypeId() string {
@@ -6975,7 +12340,7 @@ type AddonTypeRequest struct {
 
 func (x *AddonTypeRequest) Reset() {
 	*x = AddonTypeRequest{}
-	mi := &file_plugin_proto_msgTypes[104]
+	mi := &file_plugin_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6987,7 +12352,7 @@ func (x *AddonTypeRequest) String() string {
 func (*AddonTypeRequest) ProtoMessage() {}
 
 func (x *AddonTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[104]
+	mi := &file_plugin_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7000,7 +12365,7 @@ func (x *AddonTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddonTypeRequest.ProtoReflect.Descriptor instead.
 func (*AddonTypeRequest) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{104}
+	return file_plugin_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *AddonTypeRequest) GetTypeId() string {
@@ -7071,7 +12436,7 @@ type AddonTypeResponse struct {
 
 func (x *AddonTypeResponse) Reset() {
 	*x = AddonTypeResponse{}
-	mi := &file_plugin_proto_msgTypes[105]
+	mi := &file_plugin_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7083,7 +12448,7 @@ func (x *AddonTypeResponse) String() string {
 func (*AddonTypeResponse) ProtoMessage() {}
 
 func (x *AddonTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[105]
+	mi := &file_plugin_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7096,7 +12461,7 @@ func (x *AddonTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddonTypeResponse.ProtoReflect.Descriptor instead.
 func (*AddonTypeResponse) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{105}
+	return file_plugin_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *AddonTypeResponse) GetSuccess() bool {
@@ -7137,13 +12502,15 @@ type AddonInstallAction struct {
 	Headers       map[string]string             `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	NodePayload   []byte                        `protobuf:"bytes,7,opt,name=node_payload,json=nodePayload,proto3" json:"node_payload,omitempty"`
 	NodeEndpoint  string                        `protobuf:"bytes,8,opt,name=node_endpoint,json=nodeEndpoint,proto3" json:"node_endpoint,omitempty"`
+	Conditions    []*ActionCondition            `protobuf:"bytes,9,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Streamed      bool                          `protobuf:"varint,10,opt,name=streamed,proto3" json:"streamed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddonInstallAction) Reset() {
 	*x = AddonInstallAction{}
-	mi := &file_plugin_proto_msgTypes[106]
+	mi := &file_plugin_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7155,7 +12522,7 @@ func (x *AddonInstallAction) String() string {
 func (*AddonInstallAction) ProtoMessage() {}
 
 func (x *AddonInstallAction) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[106]
+	mi := &file_plugin_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7168,7 +12535,7 @@ func (x *AddonInstallAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddonInstallAction.ProtoReflect.Descriptor instead.
 func (*AddonInstallAction) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{106}
+	return file_plugin_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *AddonInstallAction) GetType() AddonInstallAction_ActionType {
@@ -7227,25 +12594,236 @@ func (x *AddonInstallAction) GetNodeEndpoint() string {
 	return ""
 }
 
+func (x *AddonInstallAction) GetConditions() []*ActionCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *AddonInstallAction) GetStreamed() bool {
+	if x != nil {
+		return x.Streamed
+	}
+	return false
+}
+
+type ActionContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        int32                  `protobuf:"varint,1,opt,name=action,proto3" json:"action,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Eof           bool                   `protobuf:"varint,3,opt,name=eof,proto3" json:"eof,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionContent) Reset() {
+	*x = ActionContent{}
+	mi := &file_plugin_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionContent) ProtoMessage() {}
+
+func (x *ActionContent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionContent.ProtoReflect.Descriptor instead.
+func (*ActionContent) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ActionContent) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *ActionContent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ActionContent) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
+func (x *ActionContent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ActionCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ActionCondition_Kind   `protobuf:"varint,1,opt,name=kind,proto3,enum=plugins.ActionCondition_Kind" json:"kind,omitempty"`
+	Variable      string                 `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionCondition) Reset() {
+	*x = ActionCondition{}
+	mi := &file_plugin_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionCondition) ProtoMessage() {}
+
+func (x *ActionCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionCondition.ProtoReflect.Descriptor instead.
+func (*ActionCondition) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ActionCondition) GetKind() ActionCondition_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return ActionCondition_KIND_UNSPECIFIED
+}
+
+func (x *ActionCondition) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *ActionCondition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ActionCondition) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UIPush struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UIPush) Reset() {
+	*x = UIPush{}
+	mi := &file_plugin_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UIPush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UIPush) ProtoMessage() {}
+
+func (x *UIPush) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UIPush.ProtoReflect.Descriptor instead.
+func (*UIPush) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *UIPush) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *UIPush) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
 var File_plugin_proto protoreflect.FileDescriptor
 
 const file_plugin_proto_rawDesc = "" +
 	"\n" +
-	"\fplugin.proto\x12\aplugins\"\xb9\x03\n" +
+	"\fplugin.proto\x12\aplugins\"\xa1\a\n" +
 	"\rPluginMessage\x121\n" +
 	"\bregister\x18\x01 \x01(\v2\x13.plugins.PluginInfoH\x00R\bregister\x12?\n" +
 	"\x0eevent_response\x18\x02 \x01(\v2\x16.plugins.EventResponseH\x00R\reventResponse\x12<\n" +
 	"\rhttp_response\x18\x03 \x01(\v2\x15.plugins.HTTPResponseH\x00R\fhttpResponse\x12=\n" +
 	"\x11schedule_response\x18\x04 \x01(\v2\x0e.plugins.EmptyH\x00R\x10scheduleResponse\x12?\n" +
 	"\x0emixin_response\x18\x05 \x01(\v2\x16.plugins.MixinResponseH\x00R\rmixinResponse\x12L\n" +
-	"\x13addon_type_response\x18\x06 \x01(\v2\x1a.plugins.AddonTypeResponseH\x00R\x11addonTypeResponse\x12\x1d\n" +
+	"\x13addon_type_response\x18\x06 \x01(\v2\x1a.plugins.AddonTypeResponseH\x00R\x11addonTypeResponse\x12<\n" +
+	"\rbundle_update\x18\a \x01(\v2\x15.plugins.BundleUpdateH\x00R\fbundleUpdate\x12<\n" +
+	"\rbundle_upload\x18\b \x01(\v2\x15.plugins.BundleUploadH\x00R\fbundleUpload\x12-\n" +
+	"\bapi_call\x18\t \x01(\v2\x10.plugins.ApiCallH\x00R\aapiCall\x12#\n" +
+	"\x04ping\x18\v \x01(\v2\r.plugins.PingH\x00R\x04ping\x12F\n" +
+	"\x13update_registration\x18\f \x01(\v2\x13.plugins.PluginInfoH\x00R\x12updateRegistration\x12/\n" +
+	"\x06health\x18\r \x01(\v2\x15.plugins.HealthReportH\x00R\x06health\x120\n" +
+	"\tapi_batch\x18\x0e \x01(\v2\x11.plugins.ApiBatchH\x00R\bapiBatch\x12?\n" +
+	"\x0eaction_content\x18\x0f \x01(\v2\x16.plugins.ActionContentH\x00R\ractionContent\x12*\n" +
+	"\aui_push\x18\x10 \x01(\v2\x0f.plugins.UIPushH\x00R\x06uiPush\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\n" +
 	" \x01(\tR\trequestIdB\t\n" +
-	"\apayload\"\x8f\x03\n" +
-	"\fPanelMessage\x120\n" +
+	"\apayload\"\xd3\a\n" +
+	"\fPanelMessage\x125\n" +
 	"\n" +
-	"registered\x18\x01 \x01(\v2\x0e.plugins.EmptyH\x00R\n" +
+	"registered\x18\x01 \x01(\v2\x13.plugins.RegisteredH\x00R\n" +
 	"registered\x12&\n" +
 	"\x05event\x18\x02 \x01(\v2\x0e.plugins.EventH\x00R\x05event\x12*\n" +
 	"\x04http\x18\x03 \x01(\v2\x14.plugins.HTTPRequestH\x00R\x04http\x126\n" +
@@ -7253,11 +12831,69 @@ const file_plugin_proto_rawDesc = "" +
 	"\x05mixin\x18\x05 \x01(\v2\x15.plugins.MixinRequestH\x00R\x05mixin\x12,\n" +
 	"\bshutdown\x18\x06 \x01(\v2\x0e.plugins.EmptyH\x00R\bshutdown\x12:\n" +
 	"\n" +
-	"addon_type\x18\a \x01(\v2\x19.plugins.AddonTypeRequestH\x00R\taddonType\x12\x1d\n" +
+	"addon_type\x18\a \x01(\v2\x19.plugins.AddonTypeRequestH\x00R\taddonType\x12?\n" +
+	"\x0ebundle_request\x18\b \x01(\v2\x16.plugins.BundleRequestH\x00R\rbundleRequest\x123\n" +
+	"\n" +
+	"api_result\x18\t \x01(\v2\x12.plugins.ApiResultH\x00R\tapiResult\x12#\n" +
+	"\x04ping\x18\v \x01(\v2\r.plugins.PingH\x00R\x04ping\x12Q\n" +
+	"\x14registration_updated\x18\f \x01(\v2\x1c.plugins.RegistrationUpdatedH\x00R\x13registrationUpdated\x123\n" +
+	"\fhealth_probe\x18\r \x01(\v2\x0e.plugins.EmptyH\x00R\vhealthProbe\x12:\n" +
+	"\rflags_changed\x18\x0e \x01(\v2\x13.plugins.FlagValuesH\x00R\fflagsChanged\x123\n" +
+	"\n" +
+	"body_chunk\x18\x0f \x01(\v2\x12.plugins.BodyChunkH\x00R\tbodyChunk\x12C\n" +
+	"\x10api_batch_result\x18\x11 \x01(\v2\x17.plugins.ApiBatchResultH\x00R\x0eapiBatchResult\x12B\n" +
+	"\x0froute_throttled\x18\x12 \x01(\v2\x17.plugins.RouteThrottledH\x00R\x0erouteThrottled\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\n" +
-	" \x01(\tR\trequestIdB\t\n" +
-	"\apayload\"\a\n" +
+	" \x01(\tR\trequestId\x12 \n" +
+	"\vtraceparent\x18\x10 \x01(\tR\vtraceparentB\t\n" +
+	"\apayload\";\n" +
+	"\aApiCall\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"G\n" +
+	"\tBodyChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x10\n" +
+	"\x03eof\x18\x02 \x01(\bR\x03eof\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"i\n" +
+	"\x13RegistrationUpdated\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12<\n" +
+	"\x0eapplied_routes\x18\x02 \x03(\v2\x15.plugins.AppliedRouteR\rappliedRoutes\"s\n" +
+	"\fAppliedRoute\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x127\n" +
+	"\n" +
+	"rate_limit\x18\x03 \x01(\v2\x18.plugins.RateLimitConfigR\trateLimit\"\xf0\x01\n" +
+	"\x0eRouteThrottled\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x14\n" +
+	"\x05route\x18\x03 \x01(\tR\x05route\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05scope\x18\a \x01(\tR\x05scope\x12$\n" +
+	"\x0eretry_after_ms\x18\b \x01(\x03R\fretryAfterMs\x12\x14\n" +
+	"\x05count\x18\t \x01(\rR\x05count\"\xd5\x01\n" +
+	"\fHealthReport\x121\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x1b.plugins.HealthReport.StateR\x05state\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x0fchecked_unix_ms\x18\x03 \x01(\x03R\rcheckedUnixMs\x12\x1d\n" +
+	"\n" +
+	"init_error\x18\x04 \x01(\tR\tinitError\"1\n" +
+	"\x05State\x12\v\n" +
+	"\aHEALTHY\x10\x00\x12\f\n" +
+	"\bDEGRADED\x10\x01\x12\r\n" +
+	"\tUNHEALTHY\x10\x02\">\n" +
+	"\x04Ping\x12 \n" +
+	"\fsent_unix_ms\x18\x01 \x01(\x03R\n" +
+	"sentUnixMs\x12\x14\n" +
+	"\x05reply\x18\x02 \x01(\bR\x05reply\"=\n" +
+	"\tApiResult\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\fR\x06status\"2\n" +
+	"\bApiBatch\x12&\n" +
+	"\x05calls\x18\x01 \x03(\v2\x10.plugins.ApiCallR\x05calls\">\n" +
+	"\x0eApiBatchResult\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.plugins.ApiResultR\aresults\"\a\n" +
 	"\x05Empty\"\x1b\n" +
 	"\tIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
@@ -7266,7 +12902,7 @@ const file_plugin_proto_rawDesc = "" +
 	"\x0fUsernameRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"#\n" +
 	"\vBoolRequest\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\bR\x05value\"\xcf\x02\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\"\xb8\a\n" +
 	"\n" +
 	"PluginInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -7279,7 +12915,43 @@ const file_plugin_proto_rawDesc = "" +
 	"\vaddon_types\x18\t \x03(\v2\x16.plugins.AddonTypeInfoR\n" +
 	"addonTypes\x12%\n" +
 	"\x02ui\x18\n" +
-	" \x01(\v2\x15.plugins.PluginUIInfoR\x02ui\"\xe8\x01\n" +
+	" \x01(\v2\x15.plugins.PluginUIInfoR\x02ui\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x12)\n" +
+	"\x10long_description\x18\f \x01(\tR\x0flongDescription\x12\x16\n" +
+	"\x06author\x18\r \x01(\tR\x06author\x12\x18\n" +
+	"\awebsite\x18\x0e \x01(\tR\awebsite\x12\x18\n" +
+	"\alicense\x18\x0f \x01(\tR\alicense\x12\x12\n" +
+	"\x04icon\x18\x10 \x01(\tR\x04icon\x124\n" +
+	"\x16required_panel_version\x18\x11 \x01(\tR\x14requiredPanelVersion\x123\n" +
+	"\x15required_capabilities\x18\x12 \x03(\tR\x14requiredCapabilities\x12=\n" +
+	"\fdependencies\x18\x13 \x03(\v2\x19.plugins.PluginDependencyR\fdependencies\x12 \n" +
+	"\vpermissions\x18\x14 \x03(\tR\vpermissions\x12.\n" +
+	"\x05flags\x18\x15 \x03(\v2\x18.plugins.FlagDeclarationR\x05flags\x12(\n" +
+	"\x10resume_event_seq\x18\x16 \x01(\x04R\x0eresumeEventSeq\x12\x1f\n" +
+	"\vinstance_id\x18\x17 \x01(\tR\n" +
+	"instanceId\x12'\n" +
+	"\x0fthrottle_events\x18\x18 \x01(\bR\x0ethrottleEvents\x12\x1f\n" +
+	"\vsdk_version\x18\x19 \x01(\tR\n" +
+	"sdkVersion\x12)\n" +
+	"\x10protocol_version\x18\x1a \x01(\x05R\x0fprotocolVersion\"l\n" +
+	"\x0fFlagDeclaration\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\bR\fdefaultValue\"\x80\x01\n" +
+	"\n" +
+	"FlagValues\x127\n" +
+	"\x06values\x18\x01 \x03(\v2\x1f.plugins.FlagValues.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"e\n" +
+	"\x10PluginDependency\x12\x1b\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
+	"\boptional\x18\x03 \x01(\bR\boptional\"c\n" +
+	"\x10DependencyStatus\x12\x1b\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12\x18\n" +
+	"\apresent\x18\x02 \x01(\bR\apresent\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"\xec\x02\n" +
 	"\fPluginUIInfo\x12\x1d\n" +
 	"\n" +
 	"has_bundle\x18\x01 \x01(\bR\thasBundle\x12+\n" +
@@ -7287,20 +12959,111 @@ const file_plugin_proto_rawDesc = "" +
 	"\x04tabs\x18\x04 \x03(\v2\x14.plugins.PluginUITabR\x04tabs\x12A\n" +
 	"\rsidebar_items\x18\x05 \x03(\v2\x1c.plugins.PluginUISidebarItemR\fsidebarItems\x12\x1f\n" +
 	"\vbundle_data\x18\x06 \x01(\fR\n" +
-	"bundleData\"\x80\x01\n" +
+	"bundleData\x12.\n" +
+	"\x06assets\x18\a \x03(\v2\x16.plugins.PluginUIAssetR\x06assets\x12\x1f\n" +
+	"\vbundle_hash\x18\b \x01(\tR\n" +
+	"bundleHash\x121\n" +
+	"\alocales\x18\t \x03(\v2\x17.plugins.PluginUILocaleR\alocales\"\xa0\x01\n" +
+	"\x0ePluginUILocale\x12\x12\n" +
+	"\x04lang\x18\x01 \x01(\tR\x04lang\x12>\n" +
+	"\astrings\x18\x02 \x03(\v2$.plugins.PluginUILocale.StringsEntryR\astrings\x1a:\n" +
+	"\fStringsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf3\x03\n" +
+	"\n" +
+	"Registered\x12!\n" +
+	"\fbundle_cache\x18\x01 \x01(\bR\vbundleCache\x12#\n" +
+	"\rpanel_version\x18\x02 \x01(\tR\fpanelVersion\x12\"\n" +
+	"\fcapabilities\x18\x03 \x03(\tR\fcapabilities\x12=\n" +
+	"\fdependencies\x18\x04 \x03(\v2\x19.plugins.DependencyStatusR\fdependencies\x12:\n" +
+	"\vpermissions\x18\x05 \x01(\v2\x18.plugins.PermissionGrantR\vpermissions\x12)\n" +
+	"\x05flags\x18\x06 \x01(\v2\x13.plugins.FlagValuesR\x05flags\x12-\n" +
+	"\x06egress\x18\a \x01(\v2\x15.plugins.EgressPolicyR\x06egress\x12<\n" +
+	"\x0eapplied_routes\x18\b \x03(\v2\x15.plugins.AppliedRouteR\rappliedRoutes\x12)\n" +
+	"\x10protocol_version\x18\t \x01(\x05R\x0fprotocolVersion\x12;\n" +
+	"\venvironment\x18\n" +
+	" \x01(\v2\x19.plugins.PanelEnvironmentR\venvironment\"\xf0\x01\n" +
+	"\x10PanelEnvironment\x12\x17\n" +
+	"\aapp_url\x18\x01 \x01(\tR\x06appUrl\x12%\n" +
+	"\x0edefault_locale\x18\x02 \x01(\tR\rdefaultLocale\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12C\n" +
+	"\bfeatures\x18\x04 \x03(\v2'.plugins.PanelEnvironment.FeaturesEntryR\bfeatures\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"F\n" +
+	"\fEgressPolicy\x12\x1b\n" +
+	"\tproxy_url\x18\x01 \x01(\tR\bproxyUrl\x12\x19\n" +
+	"\bno_proxy\x18\x02 \x03(\tR\anoProxy\"H\n" +
+	"\x0fPermissionGrant\x12\x18\n" +
+	"\agranted\x18\x01 \x03(\tR\agranted\x12\x1b\n" +
+	"\tapi_token\x18\x02 \x01(\tR\bapiToken\"#\n" +
+	"\rBundleRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\"\x8f\x01\n" +
+	"\fBundleUpload\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1a\n" +
+	"\bencoding\x18\x02 \x01(\tR\bencoding\x12\x1f\n" +
+	"\vbundle_data\x18\x03 \x01(\fR\n" +
+	"bundleData\x12.\n" +
+	"\x06assets\x18\x04 \x03(\v2\x16.plugins.PluginUIAssetR\x06assets\"\x82\x01\n" +
+	"\vBundleChunk\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x02 \x01(\x03R\ttotalSize\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x10\n" +
+	"\x03eof\x18\x04 \x01(\bR\x03eof\x12\x1a\n" +
+	"\bchecksum\x18\x05 \x01(\tR\bchecksum\"\\\n" +
+	"\x12BundleUploadResult\x12\x16\n" +
+	"\x06stored\x18\x01 \x01(\bR\x06stored\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\bR\askipped\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"_\n" +
+	"\fBundleUpdate\x12\x1f\n" +
+	"\vbundle_data\x18\x01 \x01(\fR\n" +
+	"bundleData\x12.\n" +
+	"\x06assets\x18\x02 \x03(\v2\x16.plugins.PluginUIAssetR\x06assets\"Z\n" +
+	"\rPluginUIAsset\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xab\x01\n" +
 	"\fPluginUIPage\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1c\n" +
 	"\tcomponent\x18\x02 \x01(\tR\tcomponent\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
 	"\x04icon\x18\x04 \x01(\tR\x04icon\x12\x14\n" +
-	"\x05guard\x18\x05 \x01(\tR\x05guard\"\x93\x01\n" +
+	"\x05guard\x18\x05 \x01(\tR\x05guard\x12)\n" +
+	"\x04form\x18\x06 \x01(\v2\x15.plugins.PluginUIFormR\x04form\"\xab\x01\n" +
+	"\fPluginUIForm\x12#\n" +
+	"\rsubmit_method\x18\x01 \x01(\tR\fsubmitMethod\x12\x1f\n" +
+	"\vsubmit_path\x18\x02 \x01(\tR\n" +
+	"submitPath\x12!\n" +
+	"\fsubmit_label\x18\x03 \x01(\tR\vsubmitLabel\x122\n" +
+	"\x06fields\x18\x04 \x03(\v2\x1a.plugins.PluginUIFormFieldR\x06fields\"\xde\x02\n" +
+	"\x11PluginUIFormField\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\bR\x06secret\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x17\n" +
+	"\ahas_min\x18\a \x01(\bR\x06hasMin\x12\x10\n" +
+	"\x03min\x18\b \x01(\x01R\x03min\x12\x17\n" +
+	"\ahas_max\x18\t \x01(\bR\x06hasMax\x12\x10\n" +
+	"\x03max\x18\n" +
+	" \x01(\x01R\x03max\x12#\n" +
+	"\rdefault_value\x18\v \x01(\tR\fdefaultValue\x12 \n" +
+	"\vplaceholder\x18\f \x01(\tR\vplaceholder\x12 \n" +
+	"\vdescription\x18\r \x01(\tR\vdescription\"\x83\x02\n" +
 	"\vPluginUITab\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tcomponent\x18\x02 \x01(\tR\tcomponent\x12\x16\n" +
 	"\x06target\x18\x03 \x01(\tR\x06target\x12\x14\n" +
 	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
 	"\x04icon\x18\x05 \x01(\tR\x04icon\x12\x14\n" +
-	"\x05order\x18\x06 \x01(\x05R\x05order\"\xe4\x01\n" +
+	"\x05order\x18\x06 \x01(\x05R\x05order\x12=\n" +
+	"\fvisible_when\x18\a \x03(\v2\x1a.plugins.PluginUIConditionR\vvisibleWhen\x12/\n" +
+	"\x05badge\x18\b \x01(\v2\x19.plugins.PluginUITabBadgeR\x05badge\">\n" +
+	"\x10PluginUITabBadge\x12\x14\n" +
+	"\x05route\x18\x01 \x01(\tR\x05route\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\"\xc3\x02\n" +
 	"\x13PluginUISidebarItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
@@ -7309,73 +13072,121 @@ const file_plugin_proto_rawDesc = "" +
 	"\asection\x18\x05 \x01(\tR\asection\x12\x14\n" +
 	"\x05order\x18\x06 \x01(\x05R\x05order\x12\x14\n" +
 	"\x05guard\x18\a \x01(\tR\x05guard\x129\n" +
-	"\bchildren\x18\b \x03(\v2\x1d.plugins.PluginUISidebarChildR\bchildren\"@\n" +
+	"\bchildren\x18\b \x03(\v2\x1d.plugins.PluginUISidebarChildR\bchildren\x12=\n" +
+	"\fvisible_when\x18\t \x03(\v2\x1a.plugins.PluginUIConditionR\vvisibleWhen\x12\x1e\n" +
+	"\n" +
+	"permission\x18\n" +
+	" \x01(\tR\n" +
+	"permission\"_\n" +
+	"\x11PluginUICondition\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x0e\n" +
+	"\x02op\x18\x03 \x01(\tR\x02op\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"@\n" +
 	"\x14PluginUISidebarChild\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
 	"\x04href\x18\x02 \x01(\tR\x04href\"?\n" +
 	"\tMixinInfo\x12\x16\n" +
 	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1a\n" +
-	"\bpriority\x18\x02 \x01(\x05R\bpriority\"z\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\"\xab\x01\n" +
 	"\fMixinRequest\x12\x16\n" +
 	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x02 \x01(\tR\trequestId\x12\x14\n" +
 	"\x05input\x18\x03 \x01(\fR\x05input\x12\x1d\n" +
 	"\n" +
-	"chain_data\x18\x04 \x01(\fR\tchainData\"\x83\x02\n" +
+	"chain_data\x18\x04 \x01(\fR\tchainData\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x16\n" +
+	"\x06output\x18\x06 \x01(\fR\x06output\"\x95\x03\n" +
 	"\rMixinResponse\x125\n" +
 	"\x06action\x18\x01 \x01(\x0e2\x1d.plugins.MixinResponse.ActionR\x06action\x12\x16\n" +
 	"\x06output\x18\x02 \x01(\fR\x06output\x12\x14\n" +
 	"\x05error\x18\x03 \x01(\tR\x05error\x12%\n" +
 	"\x0emodified_input\x18\x04 \x01(\fR\rmodifiedInput\x12;\n" +
-	"\rnotifications\x18\x05 \x03(\v2\x15.plugins.NotificationR\rnotifications\")\n" +
+	"\rnotifications\x18\x05 \x03(\v2\x15.plugins.NotificationR\rnotifications\x12?\n" +
+	"\x0fskipped_effects\x18\x06 \x03(\v2\x16.plugins.SkippedEffectR\x0eskippedEffects\x12!\n" +
+	"\foutput_patch\x18\a \x01(\fR\voutputPatch\x12,\n" +
+	"\x12delete_output_keys\x18\b \x03(\tR\x10deleteOutputKeys\")\n" +
 	"\x06Action\x12\b\n" +
 	"\x04NEXT\x10\x00\x12\n" +
 	"\n" +
 	"\x06RETURN\x10\x01\x12\t\n" +
-	"\x05ERROR\x10\x02\"R\n" +
+	"\x05ERROR\x10\x02\"E\n" +
+	"\rSkippedEffect\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"R\n" +
 	"\fNotification\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\"p\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"\xf7\x01\n" +
 	"\tRouteInfo\x12\x16\n" +
 	"\x06method\x18\x01 \x01(\tR\x06method\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x127\n" +
 	"\n" +
-	"rate_limit\x18\x03 \x01(\v2\x18.plugins.RateLimitConfigR\trateLimit\"z\n" +
+	"rate_limit\x18\x03 \x01(\v2\x18.plugins.RateLimitConfigR\trateLimit\x12\x1f\n" +
+	"\vstream_body\x18\x04 \x01(\bR\n" +
+	"streamBody\x12\"\n" +
+	"\rmax_body_size\x18\x05 \x01(\x03R\vmaxBodySize\x12\x12\n" +
+	"\x04cors\x18\x06 \x01(\bR\x04cors\x12,\n" +
+	"\x06schema\x18\a \x01(\v2\x14.plugins.RouteSchemaR\x06schema\"y\n" +
+	"\vRouteSchema\x12!\n" +
+	"\frequest_body\x18\x01 \x01(\fR\vrequestBody\x12\x1a\n" +
+	"\bresponse\x18\x02 \x01(\fR\bresponse\x12+\n" +
+	"\x06params\x18\x03 \x03(\v2\x13.plugins.RouteParamR\x06params\"d\n" +
+	"\n" +
+	"RouteParam\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02in\x18\x02 \x01(\tR\x02in\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x16\n" +
+	"\x06schema\x18\x04 \x01(\fR\x06schema\"\xb3\x01\n" +
 	"\x0fRateLimitConfig\x12\x16\n" +
 	"\x06preset\x18\x01 \x01(\tR\x06preset\x12.\n" +
 	"\x13requests_per_minute\x18\x02 \x01(\x05R\x11requestsPerMinute\x12\x1f\n" +
 	"\vburst_limit\x18\x03 \x01(\x05R\n" +
-	"burstLimit\"2\n" +
+	"burstLimit\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\x12!\n" +
+	"\fsdk_enforced\x18\x05 \x01(\bR\vsdkEnforced\"t\n" +
 	"\fScheduleInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04cron\x18\x02 \x01(\tR\x04cron\"\xb4\x01\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12!\n" +
+	"\froute_method\x18\x03 \x01(\tR\vrouteMethod\x12\x1d\n" +
+	"\n" +
+	"route_path\x18\x04 \x01(\tR\troutePath\"\xe2\x01\n" +
 	"\x05Event\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\tR\ttimestamp\x12,\n" +
 	"\x04data\x18\x03 \x03(\v2\x18.plugins.Event.DataEntryR\x04data\x12\x12\n" +
-	"\x04sync\x18\x04 \x01(\bR\x04sync\x1a7\n" +
+	"\x04sync\x18\x04 \x01(\bR\x04sync\x12\x10\n" +
+	"\x03seq\x18\x05 \x01(\x04R\x03seq\x12\x1a\n" +
+	"\breplayed\x18\x06 \x01(\bR\breplayed\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"?\n" +
 	"\rEventResponse\x12\x14\n" +
 	"\x05allow\x18\x01 \x01(\bR\x05allow\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xd0\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xce\x03\n" +
 	"\vHTTPRequest\x12\x16\n" +
 	"\x06method\x18\x01 \x01(\tR\x06method\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12;\n" +
 	"\aheaders\x18\x03 \x03(\v2!.plugins.HTTPRequest.HeadersEntryR\aheaders\x125\n" +
 	"\x05query\x18\x04 \x03(\v2\x1f.plugins.HTTPRequest.QueryEntryR\x05query\x12\x12\n" +
 	"\x04body\x18\x05 \x01(\fR\x04body\x12\x17\n" +
-	"\auser_id\x18\x06 \x01(\tR\x06userId\x1a:\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12(\n" +
+	"\x10caller_plugin_id\x18\a \x01(\tR\x0ecallerPluginId\x12\x1a\n" +
+	"\bstreamed\x18\b \x01(\bR\bstreamed\x126\n" +
+	"\n" +
+	"rate_limit\x18\t \x01(\v2\x17.plugins.RateLimitStateR\trateLimit\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a8\n" +
 	"\n" +
 	"QueryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb4\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\x0eRateLimitState\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x05R\tremaining\x12\"\n" +
+	"\rreset_unix_ms\x18\x03 \x01(\x03R\vresetUnixMs\"\xb4\x01\n" +
 	"\fHTTPResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12<\n" +
 	"\aheaders\x18\x02 \x03(\v2\".plugins.HTTPResponse.HeadersEntryR\aheaders\x12\x12\n" +
@@ -7385,7 +13196,7 @@ const file_plugin_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"2\n" +
 	"\x0fScheduleRequest\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\tR\n" +
-	"scheduleId\"\xa0\x02\n" +
+	"scheduleId\"\xd7\x02\n" +
 	"\x06Server\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -7399,15 +13210,85 @@ const file_plugin_proto_rawDesc = "" +
 	"\n" +
 	"package_id\x18\n" +
 	" \x01(\tR\tpackageId\x12-\n" +
-	"\x12primary_allocation\x18\v \x01(\tR\x11primaryAllocation\"t\n" +
+	"\x12primary_allocation\x18\v \x01(\tR\x11primaryAllocation\x125\n" +
+	"\vallocations\x18\f \x03(\v2\x13.plugins.AllocationR\vallocations\"\xd8\x01\n" +
+	"\n" +
+	"Allocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x12\x1b\n" +
+	"\tserver_id\x18\x05 \x01(\tR\bserverId\x12\x18\n" +
+	"\aprimary\x18\x06 \x01(\bR\aprimary\x12\x1f\n" +
+	"\vreserved_by\x18\a \x01(\tR\n" +
+	"reservedBy\x12%\n" +
+	"\x0ereserved_until\x18\b \x01(\x03R\rreservedUntil\"\xca\x01\n" +
+	"\x16ListAllocationsRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x1b\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x1e\n" +
+	"\n" +
+	"unassigned\x18\x04 \x01(\bR\n" +
+	"unassigned\x12\x19\n" +
+	"\bport_min\x18\x05 \x01(\x05R\aportMin\x12\x19\n" +
+	"\bport_max\x18\x06 \x01(\x05R\aportMax\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"P\n" +
+	"\x17ListAllocationsResponse\x125\n" +
+	"\vallocations\x18\x01 \x03(\v2\x13.plugins.AllocationR\vallocations\"Y\n" +
+	"\x18CreateAllocationsRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x14\n" +
+	"\x05ports\x18\x03 \x03(\x05R\x05ports\"[\n" +
+	"\x17AssignAllocationRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12#\n" +
+	"\rallocation_id\x18\x02 \x01(\tR\fallocationId\"\x8c\x02\n" +
+	"\x0eServerSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x04 \x01(\tR\x04cron\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12(\n" +
+	"\x10only_when_online\x18\x06 \x01(\bR\x0eonlyWhenOnline\x12+\n" +
+	"\x05tasks\x18\a \x03(\v2\x15.plugins.ScheduleTaskR\x05tasks\x12\x19\n" +
+	"\blast_run\x18\b \x01(\x03R\alastRun\x12\x19\n" +
+	"\bnext_run\x18\t \x01(\x03R\anextRun\"\xe2\x01\n" +
+	"\fScheduleTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x05R\bsequence\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12#\n" +
+	"\rdelay_seconds\x18\x06 \x01(\x05R\fdelaySeconds\x12.\n" +
+	"\x13continue_on_failure\x18\a \x01(\bR\x11continueOnFailure\"T\n" +
+	"\x1bListServerSchedulesResponse\x125\n" +
+	"\tschedules\x18\x01 \x03(\v2\x17.plugins.ServerScheduleR\tschedules\"\xd3\x01\n" +
+	"\x1bCreateServerScheduleRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12(\n" +
+	"\x10only_when_online\x18\x05 \x01(\bR\x0eonlyWhenOnline\x12+\n" +
+	"\x05tasks\x18\x06 \x03(\v2\x15.plugins.ScheduleTaskR\x05tasks\"d\n" +
+	"\x16AddScheduleTaskRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12)\n" +
+	"\x04task\x18\x02 \x01(\v2\x15.plugins.ScheduleTaskR\x04task\"y\n" +
+	"\x18ReserveAllocationRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x19\n" +
+	"\bport_min\x18\x03 \x01(\x05R\aportMin\x12\x19\n" +
+	"\bport_max\x18\x04 \x01(\x05R\aportMax\"\xb3\x01\n" +
 	"\x12ListServersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"V\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12%\n" +
+	"\x0einclude_shared\x18\x06 \x01(\bR\rincludeShared\"V\n" +
 	"\x13ListServersResponse\x12)\n" +
 	"\aservers\x18\x01 \x03(\v2\x0f.plugins.ServerR\aservers\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xb8\x01\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf7\x03\n" +
 	"\x13CreateServerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
@@ -7416,7 +13297,28 @@ const file_plugin_proto_rawDesc = "" +
 	"package_id\x18\x04 \x01(\tR\tpackageId\x12\x16\n" +
 	"\x06memory\x18\x05 \x01(\x05R\x06memory\x12\x10\n" +
 	"\x03cpu\x18\x06 \x01(\x05R\x03cpu\x12\x12\n" +
-	"\x04disk\x18\a \x01(\x05R\x04disk\"\x90\x01\n" +
+	"\x04disk\x18\a \x01(\x05R\x04disk\x123\n" +
+	"\n" +
+	"deployment\x18\b \x01(\v2\x13.plugins.DeploymentR\n" +
+	"deployment\x12\x14\n" +
+	"\x05image\x18\t \x01(\tR\x05image\x12\x18\n" +
+	"\astartup\x18\n" +
+	" \x01(\tR\astartup\x12I\n" +
+	"\tvariables\x18\v \x03(\v2+.plugins.CreateServerRequest.VariablesEntryR\tvariables\x12\x14\n" +
+	"\x05ports\x18\f \x03(\x05R\x05ports\x12%\n" +
+	"\x0eallocation_ids\x18\r \x03(\tR\rallocationIds\x12\x12\n" +
+	"\x04swap\x18\x0e \x01(\x05R\x04swap\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\n" +
+	"Deployment\x12\x19\n" +
+	"\bnode_ids\x18\x01 \x03(\tR\anodeIds\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12/\n" +
+	"\x13exclude_maintenance\x18\x03 \x01(\bR\x12excludeMaintenance\";\n" +
+	"\x13DeleteServerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x90\x01\n" +
 	"\x13UpdateServerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -7466,7 +13368,35 @@ const file_plugin_proto_rawDesc = "" +
 	"\rhistory_lines\x18\x03 \x01(\x05R\fhistoryLines\"?\n" +
 	"\vConsoleLine\x12\x12\n" +
 	"\x04line\x18\x01 \x01(\tR\x04line\x12\x1c\n" +
-	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"?\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"\x8d\x01\n" +
+	"\x12ServerStatusChange\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\x02 \x01(\tR\toldStatus\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x03 \x01(\tR\tnewStatus\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"\xa5\x01\n" +
+	"\x0eServerSettings\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12;\n" +
+	"\x06values\x18\x02 \x03(\v2#.plugins.ServerSettings.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\x01\n" +
+	"\x18SetServerSettingsRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12E\n" +
+	"\x06values\x18\x02 \x03(\v2-.plugins.SetServerSettingsRequest.ValuesEntryR\x06values\x12\x1f\n" +
+	"\vdelete_keys\x18\x03 \x03(\tR\n" +
+	"deleteKeys\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x01\n" +
+	"\x14ServerSettingsChange\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\bR\adeleted\x12%\n" +
+	"\x0eserver_deleted\x18\x05 \x01(\bR\rserverDeleted\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\"?\n" +
 	"\x0fFullLogResponse\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\fR\acontent\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\"\x8c\x01\n" +
@@ -7506,12 +13436,14 @@ const file_plugin_proto_rawDesc = "" +
 	"\x14force_password_reset\x18\n" +
 	" \x01(\bR\x12forcePasswordReset\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAt\"p\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\xa2\x01\n" +
 	"\x10ListUsersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\x12\x16\n" +
-	"\x06filter\x18\x04 \x01(\tR\x06filter\"N\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\"N\n" +
 	"\x11ListUsersResponse\x12#\n" +
 	"\x05users\x18\x01 \x03(\v2\r.plugins.UserR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"a\n" +
@@ -7551,20 +13483,29 @@ const file_plugin_proto_rawDesc = "" +
 	"\x14RemoveSubuserRequest\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
-	"subuser_id\x18\x02 \x01(\tR\tsubuserId\"\x8e\x01\n" +
+	"subuser_id\x18\x02 \x01(\tR\tsubuserId\"a\n" +
+	"\x15SubuserPermissionInfo\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"b\n" +
+	"\x1eListSubuserPermissionsResponse\x12@\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x1e.plugins.SubuserPermissionInfoR\vpermissions\"\xce\x01\n" +
 	"\bDatabase\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x12\n" +
 	"\x04host\x18\x05 \x01(\tR\x04host\x12\x12\n" +
-	"\x04port\x18\x06 \x01(\x05R\x04port\"H\n" +
+	"\x04port\x18\x06 \x01(\x05R\x04port\x12\x1b\n" +
+	"\tserver_id\x18\a \x01(\tR\bserverId\x12!\n" +
+	"\fallowed_host\x18\b \x01(\tR\vallowedHost\"H\n" +
 	"\x15ListDatabasesResponse\x12/\n" +
-	"\tdatabases\x18\x01 \x03(\v2\x11.plugins.DatabaseR\tdatabases\"a\n" +
+	"\tdatabases\x18\x01 \x03(\v2\x11.plugins.DatabaseR\tdatabases\"\x84\x01\n" +
 	"\x15CreateDatabaseRequest\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
 	"\ahost_id\x18\x02 \x01(\tR\x06hostId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"\xc4\x01\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fallowed_host\x18\x04 \x01(\tR\vallowedHost\"\xc4\x01\n" +
 	"\fDatabaseHost\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -7611,13 +13552,17 @@ const file_plugin_proto_rawDesc = "" +
 	"\x0fMoveFileRequest\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
-	"\x02to\x18\x03 \x01(\tR\x02to\"_\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xcb\x01\n" +
 	"\x06Backup\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\"@\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tserver_id\x18\x05 \x01(\tR\bserverId\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12!\n" +
+	"\fcompleted_at\x18\b \x01(\tR\vcompletedAt\"@\n" +
 	"\x13ListBackupsResponse\x12)\n" +
 	"\abackups\x18\x01 \x03(\v2\x0f.plugins.BackupR\abackups\"F\n" +
 	"\x13CreateBackupRequest\x12\x1b\n" +
@@ -7625,14 +13570,42 @@ const file_plugin_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"O\n" +
 	"\x13DeleteBackupRequest\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1b\n" +
-	"\tbackup_id\x18\x02 \x01(\tR\bbackupId\"\x96\x01\n" +
+	"\tbackup_id\x18\x02 \x01(\tR\bbackupId\"I\n" +
+	"\rBackupRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1b\n" +
+	"\tbackup_id\x18\x02 \x01(\tR\bbackupId\"l\n" +
+	"\x14RestoreBackupRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1b\n" +
+	"\tbackup_id\x18\x02 \x01(\tR\bbackupId\x12\x1a\n" +
+	"\btruncate\x18\x03 \x01(\bR\btruncate\"\xb8\x01\n" +
 	"\x04Node\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04fqdn\x18\x03 \x01(\tR\x04fqdn\x12\x12\n" +
 	"\x04port\x18\x04 \x01(\x05R\x04port\x12\x1b\n" +
 	"\tis_online\x18\x05 \x01(\bR\bisOnline\x12%\n" +
-	"\x0elast_heartbeat\x18\x06 \x01(\tR\rlastHeartbeat\"8\n" +
+	"\x0elast_heartbeat\x18\x06 \x01(\tR\rlastHeartbeat\x12 \n" +
+	"\vmaintenance\x18\a \x01(\bR\vmaintenance\"\xc9\x03\n" +
+	"\tNodeStats\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\aservers\x18\x02 \x01(\x05R\aservers\x12+\n" +
+	"\x11allocations_total\x18\x03 \x01(\x05R\x10allocationsTotal\x12)\n" +
+	"\x10allocations_used\x18\x04 \x01(\x05R\x0fallocationsUsed\x12!\n" +
+	"\fmemory_total\x18\x05 \x01(\x03R\vmemoryTotal\x12)\n" +
+	"\x10memory_allocated\x18\x06 \x01(\x03R\x0fmemoryAllocated\x12\x1f\n" +
+	"\vmemory_used\x18\a \x01(\x03R\n" +
+	"memoryUsed\x12\x1d\n" +
+	"\n" +
+	"disk_total\x18\b \x01(\x03R\tdiskTotal\x12%\n" +
+	"\x0edisk_allocated\x18\t \x01(\x03R\rdiskAllocated\x12\x1b\n" +
+	"\tdisk_used\x18\n" +
+	" \x01(\x03R\bdiskUsed\x12\x1f\n" +
+	"\vcpu_percent\x18\v \x01(\x01R\n" +
+	"cpuPercent\x12 \n" +
+	"\vmaintenance\x18\f \x01(\bR\vmaintenance\x12\x1c\n" +
+	"\ttimestamp\x18\r \x01(\x03R\ttimestamp\"A\n" +
+	"\x15ListNodeStatsResponse\x12(\n" +
+	"\x05stats\x18\x01 \x03(\v2\x12.plugins.NodeStatsR\x05stats\"8\n" +
 	"\x11ListNodesResponse\x12#\n" +
 	"\x05nodes\x18\x01 \x03(\v2\r.plugins.NodeR\x05nodes\"O\n" +
 	"\x11CreateNodeRequest\x12\x12\n" +
@@ -7644,7 +13617,7 @@ const file_plugin_proto_rawDesc = "" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"<\n" +
 	"\tNodeToken\x12\x19\n" +
 	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"\xe9\x02\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xdc\x03\n" +
 	"\aPackage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -7658,7 +13631,18 @@ const file_plugin_proto_rawDesc = "" +
 	"defaultCpu\x12!\n" +
 	"\fdefault_disk\x18\n" +
 	" \x01(\x05R\vdefaultDisk\x12\x1b\n" +
-	"\tis_public\x18\v \x01(\bR\bisPublic\"D\n" +
+	"\tis_public\x18\v \x01(\bR\bisPublic\x12#\n" +
+	"\rdocker_images\x18\f \x03(\tR\fdockerImages\x126\n" +
+	"\tvariables\x18\r \x03(\v2\x18.plugins.PackageVariableR\tvariables\x12\x14\n" +
+	"\x05group\x18\x0e \x01(\tR\x05group\"\xe5\x01\n" +
+	"\x0fPackageVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\aenv_key\x18\x02 \x01(\tR\x06envKey\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\x12\x14\n" +
+	"\x05rules\x18\x05 \x01(\tR\x05rules\x12#\n" +
+	"\ruser_editable\x18\x06 \x01(\bR\fuserEditable\x12#\n" +
+	"\ruser_viewable\x18\a \x01(\bR\fuserViewable\"D\n" +
 	"\x14ListPackagesResponse\x12,\n" +
 	"\bpackages\x18\x01 \x03(\v2\x10.plugins.PackageR\bpackages\"\xe6\x02\n" +
 	"\x14CreatePackageRequest\x12\x12\n" +
@@ -7719,20 +13703,64 @@ const file_plugin_proto_rawDesc = "" +
 	"\x06filter\x18\x04 \x01(\tR\x06filter\"Q\n" +
 	"\x0fGetLogsResponse\x12(\n" +
 	"\x04logs\x18\x01 \x03(\v2\x14.plugins.ActivityLogR\x04logs\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"<\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf9\x01\n" +
+	"\n" +
+	"AuditEntry\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1f\n" +
+	"\vtarget_type\x18\x03 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12=\n" +
+	"\bmetadata\x18\x05 \x03(\v2!.plugins.AuditEntry.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"=\n" +
+	"\fAuditRequest\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.plugins.AuditEntryR\aentries\"\xb0\x01\n" +
 	"\n" +
 	"LogRequest\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x1d\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x06fields\x18\x03 \x03(\v2\x1f.plugins.LogRequest.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd1\x01\n" +
+	"\vErrorReport\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05stack\x18\x02 \x01(\tR\x05stack\x12%\n" +
+	"\x0eplugin_version\x18\x03 \x01(\tR\rpluginVersion\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\x12\x14\n" +
+	"\x05panic\x18\x06 \x01(\bR\x05panic\x12\x1e\n" +
+	"\n" +
+	"suppressed\x18\a \x01(\x05R\n" +
+	"suppressed\"\x1d\n" +
 	"\tKVRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\"8\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"L\n" +
 	"\n" +
 	"KVResponse\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
-	"\x05found\x18\x02 \x01(\bR\x05found\"6\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"k\n" +
 	"\fKVSetRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\":\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"'\n" +
+	"\rKVListRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"$\n" +
+	"\x0eKVListResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"p\n" +
+	"\x17KVCompareAndSwapRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x10\n" +
+	"\x03old\x18\x02 \x01(\fR\x03old\x12\x10\n" +
+	"\x03new\x18\x03 \x01(\fR\x03new\x12\x1f\n" +
+	"\vold_missing\x18\x04 \x01(\bR\n" +
+	"oldMissing\"4\n" +
+	"\x18KVCompareAndSwapResponse\x12\x18\n" +
+	"\aswapped\x18\x01 \x01(\bR\aswapped\":\n" +
 	"\x0eQueryDBRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
 	"\x04args\x18\x02 \x03(\tR\x04args\"%\n" +
@@ -7744,12 +13772,17 @@ const file_plugin_proto_rawDesc = "" +
 	"\x04data\x18\x02 \x03(\v2(.plugins.BroadcastEventRequest.DataEntryR\x04data\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"r\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x01\n" +
 	"\x13NotificationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\"\xf9\x01\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06admins\x18\x05 \x01(\bR\x06admins\"b\n" +
+	"\x10SendEmailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1b\n" +
+	"\thtml_body\x18\x03 \x01(\tR\bhtmlBody\"\xf9\x01\n" +
 	"\x11PluginHTTPRequest\x12\x16\n" +
 	"\x06method\x18\x01 \x01(\tR\x06method\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12A\n" +
@@ -7773,7 +13806,27 @@ const file_plugin_proto_rawDesc = "" +
 	"\x04data\x18\x03 \x01(\fR\x04data\">\n" +
 	"\x12CallPluginResponse\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"^\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xe9\x02\n" +
+	"\x12PluginRouteRequest\x12\x1b\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12B\n" +
+	"\aheaders\x18\x04 \x03(\v2(.plugins.PluginRouteRequest.HeadersEntryR\aheaders\x12<\n" +
+	"\x05query\x18\x05 \x03(\v2&.plugins.PluginRouteRequest.QueryEntryR\x05query\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\fR\x04body\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"QueryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"M\n" +
+	"\rPluginSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"G\n" +
+	"\x13ListPluginsResponse\x120\n" +
+	"\aplugins\x18\x01 \x03(\v2\x16.plugins.PluginSummaryR\aplugins\"^\n" +
 	"\rAddonTypeInfo\x12\x17\n" +
 	"\atype_id\x18\x01 \x01(\tR\x06typeId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -7798,7 +13851,7 @@ const file_plugin_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x125\n" +
 	"\aactions\x18\x03 \x03(\v2\x1b.plugins.AddonInstallActionR\aactions\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\x81\x04\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xd7\x04\n" +
 	"\x12AddonInstallAction\x12:\n" +
 	"\x04type\x18\x01 \x01(\x0e2&.plugins.AddonInstallAction.ActionTypeR\x04type\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
@@ -7807,7 +13860,12 @@ const file_plugin_proto_rawDesc = "" +
 	"\acommand\x18\x05 \x01(\tR\acommand\x12B\n" +
 	"\aheaders\x18\x06 \x03(\v2(.plugins.AddonInstallAction.HeadersEntryR\aheaders\x12!\n" +
 	"\fnode_payload\x18\a \x01(\fR\vnodePayload\x12#\n" +
-	"\rnode_endpoint\x18\b \x01(\tR\fnodeEndpoint\x1a:\n" +
+	"\rnode_endpoint\x18\b \x01(\tR\fnodeEndpoint\x128\n" +
+	"\n" +
+	"conditions\x18\t \x03(\v2\x18.plugins.ActionConditionR\n" +
+	"conditions\x12\x1a\n" +
+	"\bstreamed\x18\n" +
+	" \x01(\bR\bstreamed\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8c\x01\n" +
@@ -7820,7 +13878,25 @@ const file_plugin_proto_rawDesc = "" +
 	"\n" +
 	"WRITE_FILE\x10\x04\x12\x0f\n" +
 	"\vRUN_COMMAND\x10\x05\x12\x11\n" +
-	"\rPROXY_TO_NODE\x10\x062\xc7\x02\n" +
+	"\rPROXY_TO_NODE\x10\x06\"c\n" +
+	"\rActionContent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\x05R\x06action\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x10\n" +
+	"\x03eof\x18\x03 \x01(\bR\x03eof\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xe0\x01\n" +
+	"\x0fActionCondition\x121\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1d.plugins.ActionCondition.KindR\x04kind\x12\x1a\n" +
+	"\bvariable\x18\x02 \x01(\tR\bvariable\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\"T\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fVARIABLE_EQUALS\x10\x01\x12\x0f\n" +
+	"\vFILE_EXISTS\x10\x02\x12\x10\n" +
+	"\fFILE_MISSING\x10\x03\"<\n" +
+	"\x06UIPush\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload2\xc7\x02\n" +
 	"\rPluginService\x12.\n" +
 	"\aGetInfo\x12\x0e.plugins.Empty\x1a\x13.plugins.PluginInfo\x121\n" +
 	"\aOnEvent\x12\x0e.plugins.Event\x1a\x16.plugins.EventResponse\x125\n" +
@@ -7828,13 +13904,13 @@ const file_plugin_proto_rawDesc = "" +
 	"\n" +
 	"OnSchedule\x12\x18.plugins.ScheduleRequest\x1a\x0e.plugins.Empty\x128\n" +
 	"\aOnMixin\x12\x15.plugins.MixinRequest\x1a\x16.plugins.MixinResponse\x12*\n" +
-	"\bShutdown\x12\x0e.plugins.Empty\x1a\x0e.plugins.Empty2\xf2)\n" +
+	"\bShutdown\x12\x0e.plugins.Empty\x1a\x0e.plugins.Empty2\xaa8\n" +
 	"\fPanelService\x12<\n" +
 	"\aConnect\x12\x16.plugins.PluginMessage\x1a\x15.plugins.PanelMessage(\x010\x01\x120\n" +
 	"\tGetServer\x12\x12.plugins.IDRequest\x1a\x0f.plugins.Server\x12H\n" +
 	"\vListServers\x12\x1b.plugins.ListServersRequest\x1a\x1c.plugins.ListServersResponse\x12=\n" +
-	"\fCreateServer\x12\x1c.plugins.CreateServerRequest\x1a\x0f.plugins.Server\x122\n" +
-	"\fDeleteServer\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x12=\n" +
+	"\fCreateServer\x12\x1c.plugins.CreateServerRequest\x1a\x0f.plugins.Server\x12<\n" +
+	"\fDeleteServer\x12\x1c.plugins.DeleteServerRequest\x1a\x0e.plugins.Empty\x12=\n" +
 	"\fUpdateServer\x12\x1c.plugins.UpdateServerRequest\x1a\x0f.plugins.Server\x123\n" +
 	"\rSuspendServer\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x125\n" +
 	"\x0fUnsuspendServer\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x121\n" +
@@ -7848,7 +13924,8 @@ const file_plugin_proto_rawDesc = "" +
 	"\x0eTransferServer\x12\x1e.plugins.TransferServerRequest\x1a\x0e.plugins.Empty\x12H\n" +
 	"\rGetConsoleLog\x12\x1a.plugins.ConsoleLogRequest\x1a\x1b.plugins.ConsoleLogResponse\x12:\n" +
 	"\vSendCommand\x12\x1b.plugins.SendCommandRequest\x1a\x0e.plugins.Empty\x12F\n" +
-	"\rStreamConsole\x12\x1d.plugins.StreamConsoleRequest\x1a\x14.plugins.ConsoleLine0\x01\x12:\n" +
+	"\rStreamConsole\x12\x1d.plugins.StreamConsoleRequest\x1a\x14.plugins.ConsoleLine0\x01\x12A\n" +
+	"\fStreamStatus\x12\x12.plugins.IDRequest\x1a\x1b.plugins.ServerStatusChange0\x01\x12:\n" +
 	"\n" +
 	"GetFullLog\x12\x12.plugins.IDRequest\x1a\x18.plugins.FullLogResponse\x12E\n" +
 	"\n" +
@@ -7858,7 +13935,17 @@ const file_plugin_proto_rawDesc = "" +
 	"\x0eGetServerStats\x12\x12.plugins.IDRequest\x1a\x14.plugins.ServerStats\x12;\n" +
 	"\rAddAllocation\x12\x1a.plugins.AllocationRequest\x1a\x0e.plugins.Empty\x12>\n" +
 	"\x10DeleteAllocation\x12\x1a.plugins.AllocationRequest\x1a\x0e.plugins.Empty\x12B\n" +
-	"\x14SetPrimaryAllocation\x12\x1a.plugins.AllocationRequest\x1a\x0e.plugins.Empty\x12H\n" +
+	"\x14SetPrimaryAllocation\x12\x1a.plugins.AllocationRequest\x1a\x0e.plugins.Empty\x12T\n" +
+	"\x0fListAllocations\x12\x1f.plugins.ListAllocationsRequest\x1a .plugins.ListAllocationsResponse\x12F\n" +
+	"\x11CreateAllocations\x12!.plugins.CreateAllocationsRequest\x1a\x0e.plugins.Empty\x12D\n" +
+	"\x10AssignAllocation\x12 .plugins.AssignAllocationRequest\x1a\x0e.plugins.Empty\x127\n" +
+	"\x11ReleaseAllocation\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x12K\n" +
+	"\x11ReserveAllocation\x12!.plugins.ReserveAllocationRequest\x1a\x13.plugins.Allocation\x12O\n" +
+	"\x13ListServerSchedules\x12\x12.plugins.IDRequest\x1a$.plugins.ListServerSchedulesResponse\x12U\n" +
+	"\x14CreateServerSchedule\x12$.plugins.CreateServerScheduleRequest\x1a\x17.plugins.ServerSchedule\x12:\n" +
+	"\x14DeleteServerSchedule\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x12I\n" +
+	"\x0fAddScheduleTask\x12\x1f.plugins.AddScheduleTaskRequest\x1a\x15.plugins.ScheduleTask\x128\n" +
+	"\x12DeleteScheduleTask\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x12H\n" +
 	"\x15UpdateServerVariables\x12\x1f.plugins.UpdateVariablesRequest\x1a\x0e.plugins.Empty\x12,\n" +
 	"\aGetUser\x12\x12.plugins.IDRequest\x1a\r.plugins.User\x126\n" +
 	"\x0eGetUserByEmail\x12\x15.plugins.EmailRequest\x1a\r.plugins.User\x12<\n" +
@@ -7880,7 +13967,8 @@ const file_plugin_proto_rawDesc = "" +
 	"\n" +
 	"AddSubuser\x12\x1a.plugins.AddSubuserRequest\x1a\x10.plugins.Subuser\x12>\n" +
 	"\rUpdateSubuser\x12\x1d.plugins.UpdateSubuserRequest\x1a\x0e.plugins.Empty\x12>\n" +
-	"\rRemoveSubuser\x12\x1d.plugins.RemoveSubuserRequest\x1a\x0e.plugins.Empty\x12C\n" +
+	"\rRemoveSubuser\x12\x1d.plugins.RemoveSubuserRequest\x1a\x0e.plugins.Empty\x12Q\n" +
+	"\x16ListSubuserPermissions\x12\x0e.plugins.Empty\x1a'.plugins.ListSubuserPermissionsResponse\x12C\n" +
 	"\rListDatabases\x12\x12.plugins.IDRequest\x1a\x1e.plugins.ListDatabasesResponse\x12C\n" +
 	"\x0eCreateDatabase\x12\x1e.plugins.CreateDatabaseRequest\x1a\x11.plugins.Database\x124\n" +
 	"\x0eDeleteDatabase\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x12?\n" +
@@ -7899,16 +13987,20 @@ const file_plugin_proto_rawDesc = "" +
 	"\bCopyFile\x12\x18.plugins.MoveFileRequest\x1a\x0e.plugins.Empty\x129\n" +
 	"\rCompressFiles\x12\x18.plugins.CompressRequest\x1a\x0e.plugins.Empty\x12:\n" +
 	"\x0eDecompressFile\x12\x18.plugins.FilePathRequest\x1a\x0e.plugins.Empty\x12?\n" +
-	"\vListBackups\x12\x12.plugins.IDRequest\x1a\x1c.plugins.ListBackupsResponse\x12<\n" +
-	"\fCreateBackup\x12\x1c.plugins.CreateBackupRequest\x1a\x0e.plugins.Empty\x12<\n" +
-	"\fDeleteBackup\x12\x1c.plugins.DeleteBackupRequest\x1a\x0e.plugins.Empty\x127\n" +
+	"\vListBackups\x12\x12.plugins.IDRequest\x1a\x1c.plugins.ListBackupsResponse\x12=\n" +
+	"\fCreateBackup\x12\x1c.plugins.CreateBackupRequest\x1a\x0f.plugins.Backup\x12<\n" +
+	"\fDeleteBackup\x12\x1c.plugins.DeleteBackupRequest\x1a\x0e.plugins.Empty\x124\n" +
+	"\tGetBackup\x12\x16.plugins.BackupRequest\x1a\x0f.plugins.Backup\x12>\n" +
+	"\rRestoreBackup\x12\x1d.plugins.RestoreBackupRequest\x1a\x0e.plugins.Empty\x127\n" +
 	"\tListNodes\x12\x0e.plugins.Empty\x1a\x1a.plugins.ListNodesResponse\x12,\n" +
 	"\aGetNode\x12\x12.plugins.IDRequest\x1a\r.plugins.Node\x12@\n" +
 	"\n" +
 	"CreateNode\x12\x1a.plugins.CreateNodeRequest\x1a\x16.plugins.NodeWithToken\x120\n" +
 	"\n" +
 	"DeleteNode\x12\x12.plugins.IDRequest\x1a\x0e.plugins.Empty\x128\n" +
-	"\x0eResetNodeToken\x12\x12.plugins.IDRequest\x1a\x12.plugins.NodeToken\x12=\n" +
+	"\x0eResetNodeToken\x12\x12.plugins.IDRequest\x1a\x12.plugins.NodeToken\x126\n" +
+	"\fGetNodeStats\x12\x12.plugins.IDRequest\x1a\x12.plugins.NodeStats\x12?\n" +
+	"\rListNodeStats\x12\x0e.plugins.Empty\x1a\x1e.plugins.ListNodeStatsResponse\x12=\n" +
 	"\fListPackages\x12\x0e.plugins.Empty\x1a\x1d.plugins.ListPackagesResponse\x122\n" +
 	"\n" +
 	"GetPackage\x12\x12.plugins.IDRequest\x1a\x10.plugins.Package\x12@\n" +
@@ -7922,17 +14014,29 @@ const file_plugin_proto_rawDesc = "" +
 	"\vGetSettings\x12\x0e.plugins.Empty\x1a\x11.plugins.Settings\x12>\n" +
 	"\x16SetRegistrationEnabled\x12\x14.plugins.BoolRequest\x1a\x0e.plugins.Empty\x12@\n" +
 	"\x18SetServerCreationEnabled\x12\x14.plugins.BoolRequest\x1a\x0e.plugins.Empty\x12D\n" +
-	"\x0fGetActivityLogs\x12\x17.plugins.GetLogsRequest\x1a\x18.plugins.GetLogsResponse\x12*\n" +
+	"\x0fGetActivityLogs\x12\x17.plugins.GetLogsRequest\x1a\x18.plugins.GetLogsResponse\x123\n" +
+	"\n" +
+	"WriteAudit\x12\x15.plugins.AuditRequest\x1a\x0e.plugins.Empty\x12*\n" +
 	"\x03Log\x12\x13.plugins.LogRequest\x1a\x0e.plugins.Empty\x120\n" +
 	"\x05GetKV\x12\x12.plugins.KVRequest\x1a\x13.plugins.KVResponse\x12.\n" +
 	"\x05SetKV\x12\x15.plugins.KVSetRequest\x1a\x0e.plugins.Empty\x12.\n" +
-	"\bDeleteKV\x12\x12.plugins.KVRequest\x1a\x0e.plugins.Empty\x12<\n" +
+	"\bDeleteKV\x12\x12.plugins.KVRequest\x1a\x0e.plugins.Empty\x129\n" +
+	"\x06ListKV\x12\x16.plugins.KVListRequest\x1a\x17.plugins.KVListResponse\x12W\n" +
+	"\x10CompareAndSwapKV\x12 .plugins.KVCompareAndSwapRequest\x1a!.plugins.KVCompareAndSwapResponse\x12@\n" +
+	"\x11GetServerSettings\x12\x12.plugins.IDRequest\x1a\x17.plugins.ServerSettings\x12F\n" +
+	"\x11SetServerSettings\x12!.plugins.SetServerSettingsRequest\x1a\x0e.plugins.Empty\x12J\n" +
+	"\x13WatchServerSettings\x12\x12.plugins.IDRequest\x1a\x1d.plugins.ServerSettingsChange0\x01\x12<\n" +
 	"\aQueryDB\x12\x17.plugins.QueryDBRequest\x1a\x18.plugins.QueryDBResponse\x12@\n" +
 	"\x0eBroadcastEvent\x12\x1e.plugins.BroadcastEventRequest\x1a\x0e.plugins.Empty\x12@\n" +
-	"\x10SendNotification\x12\x1c.plugins.NotificationRequest\x1a\x0e.plugins.Empty\x12F\n" +
+	"\x10SendNotification\x12\x1c.plugins.NotificationRequest\x1a\x0e.plugins.Empty\x123\n" +
+	"\vReportError\x12\x14.plugins.ErrorReport\x1a\x0e.plugins.Empty\x126\n" +
+	"\tSendEmail\x12\x19.plugins.SendEmailRequest\x1a\x0e.plugins.Empty\x12F\n" +
 	"\vHTTPRequest\x12\x1a.plugins.PluginHTTPRequest\x1a\x1b.plugins.PluginHTTPResponse\x12E\n" +
 	"\n" +
-	"CallPlugin\x12\x1a.plugins.CallPluginRequest\x1a\x1b.plugins.CallPluginResponseBG\n" +
+	"CallPlugin\x12\x1a.plugins.CallPluginRequest\x1a\x1b.plugins.CallPluginResponse\x12E\n" +
+	"\x0fCallPluginRoute\x12\x1b.plugins.PluginRouteRequest\x1a\x15.plugins.HTTPResponse\x12;\n" +
+	"\vListPlugins\x12\x0e.plugins.Empty\x1a\x1c.plugins.ListPluginsResponse\x12C\n" +
+	"\fUploadBundle\x12\x14.plugins.BundleChunk\x1a\x1b.plugins.BundleUploadResult(\x01BG\n" +
 	"\x16io.birdactyl.sdk.protoP\x01Z+github.com/Birdactyl/Birdactyl-Go-SDK/protob\x06proto3"
 
 var (
@@ -7947,378 +14051,583 @@ func file_plugin_proto_rawDescGZIP() []byte {
 	return file_plugin_proto_rawDescData
 }
 
-var file_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
+var file_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 199)
 var file_plugin_proto_goTypes = []any{
-	(MixinResponse_Action)(0),          // 0: plugins.MixinResponse.Action
-	(AddonInstallAction_ActionType)(0), // 1: plugins.AddonInstallAction.ActionType
-	(*PluginMessage)(nil),              // 2: plugins.PluginMessage
-	(*PanelMessage)(nil),               // 3: plugins.PanelMessage
-	(*Empty)(nil),                      // 4: plugins.Empty
-	(*IDRequest)(nil),                  // 5: plugins.IDRequest
-	(*EmailRequest)(nil),               // 6: plugins.EmailRequest
-	(*UsernameRequest)(nil),            // 7: plugins.UsernameRequest
-	(*BoolRequest)(nil),                // 8: plugins.BoolRequest
-	(*PluginInfo)(nil),                 // 9: plugins.PluginInfo
-	(*PluginUIInfo)(nil),               // 10: plugins.PluginUIInfo
-	(*PluginUIPage)(nil),               // 11: plugins.PluginUIPage
-	(*PluginUITab)(nil),                // 12: plugins.PluginUITab
-	(*PluginUISidebarItem)(nil),        // 13: plugins.PluginUISidebarItem
-	(*PluginUISidebarChild)(nil),       // 14: plugins.PluginUISidebarChild
-	(*MixinInfo)(nil),                  // 15: plugins.MixinInfo
-	(*MixinRequest)(nil),               // 16: plugins.MixinRequest
-	(*MixinResponse)(nil),              // 17: plugins.MixinResponse
-	(*Notification)(nil),               // 18: plugins.Notification
-	(*RouteInfo)(nil),                  // 19: plugins.RouteInfo
-	(*RateLimitConfig)(nil),            // 20: plugins.RateLimitConfig
-	(*ScheduleInfo)(nil),               // 21: plugins.ScheduleInfo
-	(*Event)(nil),                      // 22: plugins.Event
-	(*EventResponse)(nil),              // 23: plugins.EventResponse
-	(*HTTPRequest)(nil),                // 24: plugins.HTTPRequest
-	(*HTTPResponse)(nil),               // 25: plugins.HTTPResponse
-	(*ScheduleRequest)(nil),            // 26: plugins.ScheduleRequest
-	(*Server)(nil),                     // 27: plugins.Server
-	(*ListServersRequest)(nil),         // 28: plugins.ListServersRequest
-	(*ListServersResponse)(nil),        // 29: plugins.ListServersResponse
-	(*CreateServerRequest)(nil),        // 30: plugins.CreateServerRequest
-	(*UpdateServerRequest)(nil),        // 31: plugins.UpdateServerRequest
-	(*TransferServerRequest)(nil),      // 32: plugins.TransferServerRequest
-	(*ConsoleLogRequest)(nil),          // 33: plugins.ConsoleLogRequest
-	(*ConsoleLogResponse)(nil),         // 34: plugins.ConsoleLogResponse
-	(*SendCommandRequest)(nil),         // 35: plugins.SendCommandRequest
-	(*ServerStats)(nil),                // 36: plugins.ServerStats
-	(*AllocationRequest)(nil),          // 37: plugins.AllocationRequest
-	(*CompressRequest)(nil),            // 38: plugins.CompressRequest
-	(*UpdateVariablesRequest)(nil),     // 39: plugins.UpdateVariablesRequest
-	(*StreamConsoleRequest)(nil),       // 40: plugins.StreamConsoleRequest
-	(*ConsoleLine)(nil),                // 41: plugins.ConsoleLine
-	(*FullLogResponse)(nil),            // 42: plugins.FullLogResponse
-	(*SearchLogsRequest)(nil),          // 43: plugins.SearchLogsRequest
-	(*SearchLogsResponse)(nil),         // 44: plugins.SearchLogsResponse
-	(*LogMatch)(nil),                   // 45: plugins.LogMatch
-	(*LogFilesResponse)(nil),           // 46: plugins.LogFilesResponse
-	(*LogFileInfo)(nil),                // 47: plugins.LogFileInfo
-	(*ReadLogFileRequest)(nil),         // 48: plugins.ReadLogFileRequest
-	(*User)(nil),                       // 49: plugins.User
-	(*ListUsersRequest)(nil),           // 50: plugins.ListUsersRequest
-	(*ListUsersResponse)(nil),          // 51: plugins.ListUsersResponse
-	(*CreateUserRequest)(nil),          // 52: plugins.CreateUserRequest
-	(*UpdateUserRequest)(nil),          // 53: plugins.UpdateUserRequest
-	(*SetUserResourcesRequest)(nil),    // 54: plugins.SetUserResourcesRequest
-	(*Subuser)(nil),                    // 55: plugins.Subuser
-	(*ListSubusersResponse)(nil),       // 56: plugins.ListSubusersResponse
-	(*AddSubuserRequest)(nil),          // 57: plugins.AddSubuserRequest
-	(*UpdateSubuserRequest)(nil),       // 58: plugins.UpdateSubuserRequest
-	(*RemoveSubuserRequest)(nil),       // 59: plugins.RemoveSubuserRequest
-	(*Database)(nil),                   // 60: plugins.Database
-	(*ListDatabasesResponse)(nil),      // 61: plugins.ListDatabasesResponse
-	(*CreateDatabaseRequest)(nil),      // 62: plugins.CreateDatabaseRequest
-	(*DatabaseHost)(nil),               // 63: plugins.DatabaseHost
-	(*ListDatabaseHostsResponse)(nil),  // 64: plugins.ListDatabaseHostsResponse
-	(*CreateDatabaseHostRequest)(nil),  // 65: plugins.CreateDatabaseHostRequest
-	(*UpdateDatabaseHostRequest)(nil),  // 66: plugins.UpdateDatabaseHostRequest
-	(*FileInfo)(nil),                   // 67: plugins.FileInfo
-	(*ListFilesResponse)(nil),          // 68: plugins.ListFilesResponse
-	(*FilePathRequest)(nil),            // 69: plugins.FilePathRequest
-	(*FileContent)(nil),                // 70: plugins.FileContent
-	(*WriteFileRequest)(nil),           // 71: plugins.WriteFileRequest
-	(*MoveFileRequest)(nil),            // 72: plugins.MoveFileRequest
-	(*Backup)(nil),                     // 73: plugins.Backup
-	(*ListBackupsResponse)(nil),        // 74: plugins.ListBackupsResponse
-	(*CreateBackupRequest)(nil),        // 75: plugins.CreateBackupRequest
-	(*DeleteBackupRequest)(nil),        // 76: plugins.DeleteBackupRequest
-	(*Node)(nil),                       // 77: plugins.Node
-	(*ListNodesResponse)(nil),          // 78: plugins.ListNodesResponse
-	(*CreateNodeRequest)(nil),          // 79: plugins.CreateNodeRequest
-	(*NodeWithToken)(nil),              // 80: plugins.NodeWithToken
-	(*NodeToken)(nil),                  // 81: plugins.NodeToken
-	(*Package)(nil),                    // 82: plugins.Package
-	(*ListPackagesResponse)(nil),       // 83: plugins.ListPackagesResponse
-	(*CreatePackageRequest)(nil),       // 84: plugins.CreatePackageRequest
-	(*UpdatePackageRequest)(nil),       // 85: plugins.UpdatePackageRequest
-	(*IPBan)(nil),                      // 86: plugins.IPBan
-	(*ListIPBansResponse)(nil),         // 87: plugins.ListIPBansResponse
-	(*CreateIPBanRequest)(nil),         // 88: plugins.CreateIPBanRequest
-	(*Settings)(nil),                   // 89: plugins.Settings
-	(*ActivityLog)(nil),                // 90: plugins.ActivityLog
-	(*GetLogsRequest)(nil),             // 91: plugins.GetLogsRequest
-	(*GetLogsResponse)(nil),            // 92: plugins.GetLogsResponse
-	(*LogRequest)(nil),                 // 93: plugins.LogRequest
-	(*KVRequest)(nil),                  // 94: plugins.KVRequest
-	(*KVResponse)(nil),                 // 95: plugins.KVResponse
-	(*KVSetRequest)(nil),               // 96: plugins.KVSetRequest
-	(*QueryDBRequest)(nil),             // 97: plugins.QueryDBRequest
-	(*QueryDBResponse)(nil),            // 98: plugins.QueryDBResponse
-	(*BroadcastEventRequest)(nil),      // 99: plugins.BroadcastEventRequest
-	(*NotificationRequest)(nil),        // 100: plugins.NotificationRequest
-	(*PluginHTTPRequest)(nil),          // 101: plugins.PluginHTTPRequest
-	(*PluginHTTPResponse)(nil),         // 102: plugins.PluginHTTPResponse
-	(*CallPluginRequest)(nil),          // 103: plugins.CallPluginRequest
-	(*CallPluginResponse)(nil),         // 104: plugins.CallPluginResponse
-	(*AddonTypeInfo)(nil),              // 105: plugins.AddonTypeInfo
-	(*AddonTypeRequest)(nil),           // 106: plugins.AddonTypeRequest
-	(*AddonTypeResponse)(nil),          // 107: plugins.AddonTypeResponse
-	(*AddonInstallAction)(nil),         // 108: plugins.AddonInstallAction
-	nil,                                // 109: plugins.Event.DataEntry
-	nil,                                // 110: plugins.HTTPRequest.HeadersEntry
-	nil,                                // 111: plugins.HTTPRequest.QueryEntry
-	nil,                                // 112: plugins.HTTPResponse.HeadersEntry
-	nil,                                // 113: plugins.UpdateVariablesRequest.VariablesEntry
-	nil,                                // 114: plugins.BroadcastEventRequest.DataEntry
-	nil,                                // 115: plugins.PluginHTTPRequest.HeadersEntry
-	nil,                                // 116: plugins.PluginHTTPResponse.HeadersEntry
-	nil,                                // 117: plugins.AddonTypeRequest.SourceInfoEntry
-	nil,                                // 118: plugins.AddonTypeRequest.ServerVariablesEntry
-	nil,                                // 119: plugins.AddonInstallAction.HeadersEntry
+	(HealthReport_State)(0),                // 0: plugins.HealthReport.State
+	(MixinResponse_Action)(0),              // 1: plugins.MixinResponse.Action
+	(AddonInstallAction_ActionType)(0),     // 2: plugins.AddonInstallAction.ActionType
+	(ActionCondition_Kind)(0),              // 3: plugins.ActionCondition.Kind
+	(*PluginMessage)(nil),                  // 4: plugins.PluginMessage
+	(*PanelMessage)(nil),                   // 5: plugins.PanelMessage
+	(*ApiCall)(nil),                        // 6: plugins.ApiCall
+	(*BodyChunk)(nil),                      // 7: plugins.BodyChunk
+	(*RegistrationUpdated)(nil),            // 8: plugins.RegistrationUpdated
+	(*AppliedRoute)(nil),                   // 9: plugins.AppliedRoute
+	(*RouteThrottled)(nil),                 // 10: plugins.RouteThrottled
+	(*HealthReport)(nil),                   // 11: plugins.HealthReport
+	(*Ping)(nil),                           // 12: plugins.Ping
+	(*ApiResult)(nil),                      // 13: plugins.ApiResult
+	(*ApiBatch)(nil),                       // 14: plugins.ApiBatch
+	(*ApiBatchResult)(nil),                 // 15: plugins.ApiBatchResult
+	(*Empty)(nil),                          // 16: plugins.Empty
+	(*IDRequest)(nil),                      // 17: plugins.IDRequest
+	(*EmailRequest)(nil),                   // 18: plugins.EmailRequest
+	(*UsernameRequest)(nil),                // 19: plugins.UsernameRequest
+	(*BoolRequest)(nil),                    // 20: plugins.BoolRequest
+	(*PluginInfo)(nil),                     // 21: plugins.PluginInfo
+	(*FlagDeclaration)(nil),                // 22: plugins.FlagDeclaration
+	(*FlagValues)(nil),                     // 23: plugins.FlagValues
+	(*PluginDependency)(nil),               // 24: plugins.PluginDependency
+	(*DependencyStatus)(nil),               // 25: plugins.DependencyStatus
+	(*PluginUIInfo)(nil),                   // 26: plugins.PluginUIInfo
+	(*PluginUILocale)(nil),                 // 27: plugins.PluginUILocale
+	(*Registered)(nil),                     // 28: plugins.Registered
+	(*PanelEnvironment)(nil),               // 29: plugins.PanelEnvironment
+	(*EgressPolicy)(nil),                   // 30: plugins.EgressPolicy
+	(*PermissionGrant)(nil),                // 31: plugins.PermissionGrant
+	(*BundleRequest)(nil),                  // 32: plugins.BundleRequest
+	(*BundleUpload)(nil),                   // 33: plugins.BundleUpload
+	(*BundleChunk)(nil),                    // 34: plugins.BundleChunk
+	(*BundleUploadResult)(nil),             // 35: plugins.BundleUploadResult
+	(*BundleUpdate)(nil),                   // 36: plugins.BundleUpdate
+	(*PluginUIAsset)(nil),                  // 37: plugins.PluginUIAsset
+	(*PluginUIPage)(nil),                   // 38: plugins.PluginUIPage
+	(*PluginUIForm)(nil),                   // 39: plugins.PluginUIForm
+	(*PluginUIFormField)(nil),              // 40: plugins.PluginUIFormField
+	(*PluginUITab)(nil),                    // 41: plugins.PluginUITab
+	(*PluginUITabBadge)(nil),               // 42: plugins.PluginUITabBadge
+	(*PluginUISidebarItem)(nil),            // 43: plugins.PluginUISidebarItem
+	(*PluginUICondition)(nil),              // 44: plugins.PluginUICondition
+	(*PluginUISidebarChild)(nil),           // 45: plugins.PluginUISidebarChild
+	(*MixinInfo)(nil),                      // 46: plugins.MixinInfo
+	(*MixinRequest)(nil),                   // 47: plugins.MixinRequest
+	(*MixinResponse)(nil),                  // 48: plugins.MixinResponse
+	(*SkippedEffect)(nil),                  // 49: plugins.SkippedEffect
+	(*Notification)(nil),                   // 50: plugins.Notification
+	(*RouteInfo)(nil),                      // 51: plugins.RouteInfo
+	(*RouteSchema)(nil),                    // 52: plugins.RouteSchema
+	(*RouteParam)(nil),                     // 53: plugins.RouteParam
+	(*RateLimitConfig)(nil),                // 54: plugins.RateLimitConfig
+	(*ScheduleInfo)(nil),                   // 55: plugins.ScheduleInfo
+	(*Event)(nil),                          // 56: plugins.Event
+	(*EventResponse)(nil),                  // 57: plugins.EventResponse
+	(*HTTPRequest)(nil),                    // 58: plugins.HTTPRequest
+	(*RateLimitState)(nil),                 // 59: plugins.RateLimitState
+	(*HTTPResponse)(nil),                   // 60: plugins.HTTPResponse
+	(*ScheduleRequest)(nil),                // 61: plugins.ScheduleRequest
+	(*Server)(nil),                         // 62: plugins.Server
+	(*Allocation)(nil),                     // 63: plugins.Allocation
+	(*ListAllocationsRequest)(nil),         // 64: plugins.ListAllocationsRequest
+	(*ListAllocationsResponse)(nil),        // 65: plugins.ListAllocationsResponse
+	(*CreateAllocationsRequest)(nil),       // 66: plugins.CreateAllocationsRequest
+	(*AssignAllocationRequest)(nil),        // 67: plugins.AssignAllocationRequest
+	(*ServerSchedule)(nil),                 // 68: plugins.ServerSchedule
+	(*ScheduleTask)(nil),                   // 69: plugins.ScheduleTask
+	(*ListServerSchedulesResponse)(nil),    // 70: plugins.ListServerSchedulesResponse
+	(*CreateServerScheduleRequest)(nil),    // 71: plugins.CreateServerScheduleRequest
+	(*AddScheduleTaskRequest)(nil),         // 72: plugins.AddScheduleTaskRequest
+	(*ReserveAllocationRequest)(nil),       // 73: plugins.ReserveAllocationRequest
+	(*ListServersRequest)(nil),             // 74: plugins.ListServersRequest
+	(*ListServersResponse)(nil),            // 75: plugins.ListServersResponse
+	(*CreateServerRequest)(nil),            // 76: plugins.CreateServerRequest
+	(*Deployment)(nil),                     // 77: plugins.Deployment
+	(*DeleteServerRequest)(nil),            // 78: plugins.DeleteServerRequest
+	(*UpdateServerRequest)(nil),            // 79: plugins.UpdateServerRequest
+	(*TransferServerRequest)(nil),          // 80: plugins.TransferServerRequest
+	(*ConsoleLogRequest)(nil),              // 81: plugins.ConsoleLogRequest
+	(*ConsoleLogResponse)(nil),             // 82: plugins.ConsoleLogResponse
+	(*SendCommandRequest)(nil),             // 83: plugins.SendCommandRequest
+	(*ServerStats)(nil),                    // 84: plugins.ServerStats
+	(*AllocationRequest)(nil),              // 85: plugins.AllocationRequest
+	(*CompressRequest)(nil),                // 86: plugins.CompressRequest
+	(*UpdateVariablesRequest)(nil),         // 87: plugins.UpdateVariablesRequest
+	(*StreamConsoleRequest)(nil),           // 88: plugins.StreamConsoleRequest
+	(*ConsoleLine)(nil),                    // 89: plugins.ConsoleLine
+	(*ServerStatusChange)(nil),             // 90: plugins.ServerStatusChange
+	(*ServerSettings)(nil),                 // 91: plugins.ServerSettings
+	(*SetServerSettingsRequest)(nil),       // 92: plugins.SetServerSettingsRequest
+	(*ServerSettingsChange)(nil),           // 93: plugins.ServerSettingsChange
+	(*FullLogResponse)(nil),                // 94: plugins.FullLogResponse
+	(*SearchLogsRequest)(nil),              // 95: plugins.SearchLogsRequest
+	(*SearchLogsResponse)(nil),             // 96: plugins.SearchLogsResponse
+	(*LogMatch)(nil),                       // 97: plugins.LogMatch
+	(*LogFilesResponse)(nil),               // 98: plugins.LogFilesResponse
+	(*LogFileInfo)(nil),                    // 99: plugins.LogFileInfo
+	(*ReadLogFileRequest)(nil),             // 100: plugins.ReadLogFileRequest
+	(*User)(nil),                           // 101: plugins.User
+	(*ListUsersRequest)(nil),               // 102: plugins.ListUsersRequest
+	(*ListUsersResponse)(nil),              // 103: plugins.ListUsersResponse
+	(*CreateUserRequest)(nil),              // 104: plugins.CreateUserRequest
+	(*UpdateUserRequest)(nil),              // 105: plugins.UpdateUserRequest
+	(*SetUserResourcesRequest)(nil),        // 106: plugins.SetUserResourcesRequest
+	(*Subuser)(nil),                        // 107: plugins.Subuser
+	(*ListSubusersResponse)(nil),           // 108: plugins.ListSubusersResponse
+	(*AddSubuserRequest)(nil),              // 109: plugins.AddSubuserRequest
+	(*UpdateSubuserRequest)(nil),           // 110: plugins.UpdateSubuserRequest
+	(*RemoveSubuserRequest)(nil),           // 111: plugins.RemoveSubuserRequest
+	(*SubuserPermissionInfo)(nil),          // 112: plugins.SubuserPermissionInfo
+	(*ListSubuserPermissionsResponse)(nil), // 113: plugins.ListSubuserPermissionsResponse
+	(*Database)(nil),                       // 114: plugins.Database
+	(*ListDatabasesResponse)(nil),          // 115: plugins.ListDatabasesResponse
+	(*CreateDatabaseRequest)(nil),          // 116: plugins.CreateDatabaseRequest
+	(*DatabaseHost)(nil),                   // 117: plugins.DatabaseHost
+	(*ListDatabaseHostsResponse)(nil),      // 118: plugins.ListDatabaseHostsResponse
+	(*CreateDatabaseHostRequest)(nil),      // 119: plugins.CreateDatabaseHostRequest
+	(*UpdateDatabaseHostRequest)(nil),      // 120: plugins.UpdateDatabaseHostRequest
+	(*FileInfo)(nil),                       // 121: plugins.FileInfo
+	(*ListFilesResponse)(nil),              // 122: plugins.ListFilesResponse
+	(*FilePathRequest)(nil),                // 123: plugins.FilePathRequest
+	(*FileContent)(nil),                    // 124: plugins.FileContent
+	(*WriteFileRequest)(nil),               // 125: plugins.WriteFileRequest
+	(*MoveFileRequest)(nil),                // 126: plugins.MoveFileRequest
+	(*Backup)(nil),                         // 127: plugins.Backup
+	(*ListBackupsResponse)(nil),            // 128: plugins.ListBackupsResponse
+	(*CreateBackupRequest)(nil),            // 129: plugins.CreateBackupRequest
+	(*DeleteBackupRequest)(nil),            // 130: plugins.DeleteBackupRequest
+	(*BackupRequest)(nil),                  // 131: plugins.BackupRequest
+	(*RestoreBackupRequest)(nil),           // 132: plugins.RestoreBackupRequest
+	(*Node)(nil),                           // 133: plugins.Node
+	(*NodeStats)(nil),                      // 134: plugins.NodeStats
+	(*ListNodeStatsResponse)(nil),          // 135: plugins.ListNodeStatsResponse
+	(*ListNodesResponse)(nil),              // 136: plugins.ListNodesResponse
+	(*CreateNodeRequest)(nil),              // 137: plugins.CreateNodeRequest
+	(*NodeWithToken)(nil),                  // 138: plugins.NodeWithToken
+	(*NodeToken)(nil),                      // 139: plugins.NodeToken
+	(*Package)(nil),                        // 140: plugins.Package
+	(*PackageVariable)(nil),                // 141: plugins.PackageVariable
+	(*ListPackagesResponse)(nil),           // 142: plugins.ListPackagesResponse
+	(*CreatePackageRequest)(nil),           // 143: plugins.CreatePackageRequest
+	(*UpdatePackageRequest)(nil),           // 144: plugins.UpdatePackageRequest
+	(*IPBan)(nil),                          // 145: plugins.IPBan
+	(*ListIPBansResponse)(nil),             // 146: plugins.ListIPBansResponse
+	(*CreateIPBanRequest)(nil),             // 147: plugins.CreateIPBanRequest
+	(*Settings)(nil),                       // 148: plugins.Settings
+	(*ActivityLog)(nil),                    // 149: plugins.ActivityLog
+	(*GetLogsRequest)(nil),                 // 150: plugins.GetLogsRequest
+	(*GetLogsResponse)(nil),                // 151: plugins.GetLogsResponse
+	(*AuditEntry)(nil),                     // 152: plugins.AuditEntry
+	(*AuditRequest)(nil),                   // 153: plugins.AuditRequest
+	(*LogRequest)(nil),                     // 154: plugins.LogRequest
+	(*ErrorReport)(nil),                    // 155: plugins.ErrorReport
+	(*KVRequest)(nil),                      // 156: plugins.KVRequest
+	(*KVResponse)(nil),                     // 157: plugins.KVResponse
+	(*KVSetRequest)(nil),                   // 158: plugins.KVSetRequest
+	(*KVListRequest)(nil),                  // 159: plugins.KVListRequest
+	(*KVListResponse)(nil),                 // 160: plugins.KVListResponse
+	(*KVCompareAndSwapRequest)(nil),        // 161: plugins.KVCompareAndSwapRequest
+	(*KVCompareAndSwapResponse)(nil),       // 162: plugins.KVCompareAndSwapResponse
+	(*QueryDBRequest)(nil),                 // 163: plugins.QueryDBRequest
+	(*QueryDBResponse)(nil),                // 164: plugins.QueryDBResponse
+	(*BroadcastEventRequest)(nil),          // 165: plugins.BroadcastEventRequest
+	(*NotificationRequest)(nil),            // 166: plugins.NotificationRequest
+	(*SendEmailRequest)(nil),               // 167: plugins.SendEmailRequest
+	(*PluginHTTPRequest)(nil),              // 168: plugins.PluginHTTPRequest
+	(*PluginHTTPResponse)(nil),             // 169: plugins.PluginHTTPResponse
+	(*CallPluginRequest)(nil),              // 170: plugins.CallPluginRequest
+	(*CallPluginResponse)(nil),             // 171: plugins.CallPluginResponse
+	(*PluginRouteRequest)(nil),             // 172: plugins.PluginRouteRequest
+	(*PluginSummary)(nil),                  // 173: plugins.PluginSummary
+	(*ListPluginsResponse)(nil),            // 174: plugins.ListPluginsResponse
+	(*AddonTypeInfo)(nil),                  // 175: plugins.AddonTypeInfo
+	(*AddonTypeRequest)(nil),               // 176: plugins.AddonTypeRequest
+	(*AddonTypeResponse)(nil),              // 177: plugins.AddonTypeResponse
+	(*AddonInstallAction)(nil),             // 178: plugins.AddonInstallAction
+	(*ActionContent)(nil),                  // 179: plugins.ActionContent
+	(*ActionCondition)(nil),                // 180: plugins.ActionCondition
+	(*UIPush)(nil),                         // 181: plugins.UIPush
+	nil,                                    // 182: plugins.FlagValues.ValuesEntry
+	nil,                                    // 183: plugins.PluginUILocale.StringsEntry
+	nil,                                    // 184: plugins.PanelEnvironment.FeaturesEntry
+	nil,                                    // 185: plugins.Event.DataEntry
+	nil,                                    // 186: plugins.HTTPRequest.HeadersEntry
+	nil,                                    // 187: plugins.HTTPRequest.QueryEntry
+	nil,                                    // 188: plugins.HTTPResponse.HeadersEntry
+	nil,                                    // 189: plugins.CreateServerRequest.VariablesEntry
+	nil,                                    // 190: plugins.UpdateVariablesRequest.VariablesEntry
+	nil,                                    // 191: plugins.ServerSettings.ValuesEntry
+	nil,                                    // 192: plugins.SetServerSettingsRequest.ValuesEntry
+	nil,                                    // 193: plugins.AuditEntry.MetadataEntry
+	nil,                                    // 194: plugins.LogRequest.FieldsEntry
+	nil,                                    // 195: plugins.BroadcastEventRequest.DataEntry
+	nil,                                    // 196: plugins.PluginHTTPRequest.HeadersEntry
+	nil,                                    // 197: plugins.PluginHTTPResponse.HeadersEntry
+	nil,                                    // 198: plugins.PluginRouteRequest.HeadersEntry
+	nil,                                    // 199: plugins.PluginRouteRequest.QueryEntry
+	nil,                                    // 200: plugins.AddonTypeRequest.SourceInfoEntry
+	nil,                                    // 201: plugins.AddonTypeRequest.ServerVariablesEntry
+	nil,                                    // 202: plugins.AddonInstallAction.HeadersEntry
 }
 var file_plugin_proto_depIdxs = []int32{
-	9,   // 0: plugins.PluginMessage.register:type_name -> plugins.PluginInfo
-	23,  // 1: plugins.PluginMessage.event_response:type_name -> plugins.EventResponse
-	25,  // 2: plugins.PluginMessage.http_response:type_name -> plugins.HTTPResponse
-	4,   // 3: plugins.PluginMessage.schedule_response:type_name -> plugins.Empty
-	17,  // 4: plugins.PluginMessage.mixin_response:type_name -> plugins.MixinResponse
-	107, // 5: plugins.PluginMessage.addon_type_response:type_name -> plugins.AddonTypeResponse
-	4,   // 6: plugins.PanelMessage.registered:type_name -> plugins.Empty
-	22,  // 7: plugins.PanelMessage.event:type_name -> plugins.Event
-	24,  // 8: plugins.PanelMessage.http:type_name -> plugins.HTTPRequest
-	26,  // 9: plugins.PanelMessage.schedule:type_name -> plugins.ScheduleRequest
-	16,  // 10: plugins.PanelMessage.mixin:type_name -> plugins.MixinRequest
-	4,   // 11: plugins.PanelMessage.shutdown:type_name -> plugins.Empty
-	106, // 12: plugins.PanelMessage.addon_type:type_name -> plugins.AddonTypeRequest
-	19,  // 13: plugins.PluginInfo.routes:type_name -> plugins.RouteInfo
-	21,  // 14: plugins.PluginInfo.schedules:type_name -> plugins.ScheduleInfo
-	15,  // 15: plugins.PluginInfo.mixins:type_name -> plugins.MixinInfo
-	105, // 16: plugins.PluginInfo.addon_types:type_name -> plugins.AddonTypeInfo
-	10,  // 17: plugins.PluginInfo.ui:type_name -> plugins.PluginUIInfo
-	11,  // 18: plugins.PluginUIInfo.pages:type_name -> plugins.PluginUIPage
-	12,  // 19: plugins.PluginUIInfo.tabs:type_name -> plugins.PluginUITab
-	13,  // 20: plugins.PluginUIInfo.sidebar_items:type_name -> plugins.PluginUISidebarItem
-	14,  // 21: plugins.PluginUISidebarItem.children:type_name -> plugins.PluginUISidebarChild
-	0,   // 22: plugins.MixinResponse.action:type_name -> plugins.MixinResponse.Action
-	18,  // 23: plugins.MixinResponse.notifications:type_name -> plugins.Notification
-	20,  // 24: plugins.RouteInfo.rate_limit:type_name -> plugins.RateLimitConfig
-	109, // 25: plugins.Event.data:type_name -> plugins.Event.DataEntry
-	110, // 26: plugins.HTTPRequest.headers:type_name -> plugins.HTTPRequest.HeadersEntry
-	111, // 27: plugins.HTTPRequest.query:type_name -> plugins.HTTPRequest.QueryEntry
-	112, // 28: plugins.HTTPResponse.headers:type_name -> plugins.HTTPResponse.HeadersEntry
-	27,  // 29: plugins.ListServersResponse.servers:type_name -> plugins.Server
-	113, // 30: plugins.UpdateVariablesRequest.variables:type_name -> plugins.UpdateVariablesRequest.VariablesEntry
-	45,  // 31: plugins.SearchLogsResponse.matches:type_name -> plugins.LogMatch
-	47,  // 32: plugins.LogFilesResponse.files:type_name -> plugins.LogFileInfo
-	49,  // 33: plugins.ListUsersResponse.users:type_name -> plugins.User
-	55,  // 34: plugins.ListSubusersResponse.subusers:type_name -> plugins.Subuser
-	60,  // 35: plugins.ListDatabasesResponse.databases:type_name -> plugins.Database
-	63,  // 36: plugins.ListDatabaseHostsResponse.hosts:type_name -> plugins.DatabaseHost
-	67,  // 37: plugins.ListFilesResponse.files:type_name -> plugins.FileInfo
-	73,  // 38: plugins.ListBackupsResponse.backups:type_name -> plugins.Backup
-	77,  // 39: plugins.ListNodesResponse.nodes:type_name -> plugins.Node
-	77,  // 40: plugins.NodeWithToken.node:type_name -> plugins.Node
-	82,  // 41: plugins.ListPackagesResponse.packages:type_name -> plugins.Package
-	86,  // 42: plugins.ListIPBansResponse.bans:type_name -> plugins.IPBan
-	90,  // 43: plugins.GetLogsResponse.logs:type_name -> plugins.ActivityLog
-	114, // 44: plugins.BroadcastEventRequest.data:type_name -> plugins.BroadcastEventRequest.DataEntry
-	115, // 45: plugins.PluginHTTPRequest.headers:type_name -> plugins.PluginHTTPRequest.HeadersEntry
-	116, // 46: plugins.PluginHTTPResponse.headers:type_name -> plugins.PluginHTTPResponse.HeadersEntry
-	117, // 47: plugins.AddonTypeRequest.source_info:type_name -> plugins.AddonTypeRequest.SourceInfoEntry
-	118, // 48: plugins.AddonTypeRequest.server_variables:type_name -> plugins.AddonTypeRequest.ServerVariablesEntry
-	108, // 49: plugins.AddonTypeResponse.actions:type_name -> plugins.AddonInstallAction
-	1,   // 50: plugins.AddonInstallAction.type:type_name -> plugins.AddonInstallAction.ActionType
-	119, // 51: plugins.AddonInstallAction.headers:type_name -> plugins.AddonInstallAction.HeadersEntry
-	4,   // 52: plugins.PluginService.GetInfo:input_type -> plugins.Empty
-	22,  // 53: plugins.PluginService.OnEvent:input_type -> plugins.Event
-	24,  // 54: plugins.PluginService.OnHTTP:input_type -> plugins.HTTPRequest
-	26,  // 55: plugins.PluginService.OnSchedule:input_type -> plugins.ScheduleRequest
-	16,  // 56: plugins.PluginService.OnMixin:input_type -> plugins.MixinRequest
-	4,   // 57: plugins.PluginService.Shutdown:input_type -> plugins.Empty
-	2,   // 58: plugins.PanelService.Connect:input_type -> plugins.PluginMessage
-	5,   // 59: plugins.PanelService.GetServer:input_type -> plugins.IDRequest
-	28,  // 60: plugins.PanelService.ListServers:input_type -> plugins.ListServersRequest
-	30,  // 61: plugins.PanelService.CreateServer:input_type -> plugins.CreateServerRequest
-	5,   // 62: plugins.PanelService.DeleteServer:input_type -> plugins.IDRequest
-	31,  // 63: plugins.PanelService.UpdateServer:input_type -> plugins.UpdateServerRequest
-	5,   // 64: plugins.PanelService.SuspendServer:input_type -> plugins.IDRequest
-	5,   // 65: plugins.PanelService.UnsuspendServer:input_type -> plugins.IDRequest
-	5,   // 66: plugins.PanelService.StartServer:input_type -> plugins.IDRequest
-	5,   // 67: plugins.PanelService.StopServer:input_type -> plugins.IDRequest
-	5,   // 68: plugins.PanelService.RestartServer:input_type -> plugins.IDRequest
-	5,   // 69: plugins.PanelService.KillServer:input_type -> plugins.IDRequest
-	5,   // 70: plugins.PanelService.ReinstallServer:input_type -> plugins.IDRequest
-	32,  // 71: plugins.PanelService.TransferServer:input_type -> plugins.TransferServerRequest
-	33,  // 72: plugins.PanelService.GetConsoleLog:input_type -> plugins.ConsoleLogRequest
-	35,  // 73: plugins.PanelService.SendCommand:input_type -> plugins.SendCommandRequest
-	40,  // 74: plugins.PanelService.StreamConsole:input_type -> plugins.StreamConsoleRequest
-	5,   // 75: plugins.PanelService.GetFullLog:input_type -> plugins.IDRequest
-	43,  // 76: plugins.PanelService.SearchLogs:input_type -> plugins.SearchLogsRequest
-	5,   // 77: plugins.PanelService.ListLogFiles:input_type -> plugins.IDRequest
-	48,  // 78: plugins.PanelService.ReadLogFile:input_type -> plugins.ReadLogFileRequest
-	5,   // 79: plugins.PanelService.GetServerStats:input_type -> plugins.IDRequest
-	37,  // 80: plugins.PanelService.AddAllocation:input_type -> plugins.AllocationRequest
-	37,  // 81: plugins.PanelService.DeleteAllocation:input_type -> plugins.AllocationRequest
-	37,  // 82: plugins.PanelService.SetPrimaryAllocation:input_type -> plugins.AllocationRequest
-	39,  // 83: plugins.PanelService.UpdateServerVariables:input_type -> plugins.UpdateVariablesRequest
-	5,   // 84: plugins.PanelService.GetUser:input_type -> plugins.IDRequest
-	6,   // 85: plugins.PanelService.GetUserByEmail:input_type -> plugins.EmailRequest
-	7,   // 86: plugins.PanelService.GetUserByUsername:input_type -> plugins.UsernameRequest
-	50,  // 87: plugins.PanelService.ListUsers:input_type -> plugins.ListUsersRequest
-	52,  // 88: plugins.PanelService.CreateUser:input_type -> plugins.CreateUserRequest
-	5,   // 89: plugins.PanelService.DeleteUser:input_type -> plugins.IDRequest
-	53,  // 90: plugins.PanelService.UpdateUser:input_type -> plugins.UpdateUserRequest
-	5,   // 91: plugins.PanelService.BanUser:input_type -> plugins.IDRequest
-	5,   // 92: plugins.PanelService.UnbanUser:input_type -> plugins.IDRequest
-	5,   // 93: plugins.PanelService.SetAdmin:input_type -> plugins.IDRequest
-	5,   // 94: plugins.PanelService.RevokeAdmin:input_type -> plugins.IDRequest
-	54,  // 95: plugins.PanelService.SetUserResources:input_type -> plugins.SetUserResourcesRequest
-	5,   // 96: plugins.PanelService.ForcePasswordReset:input_type -> plugins.IDRequest
-	5,   // 97: plugins.PanelService.ListSubusers:input_type -> plugins.IDRequest
-	57,  // 98: plugins.PanelService.AddSubuser:input_type -> plugins.AddSubuserRequest
-	58,  // 99: plugins.PanelService.UpdateSubuser:input_type -> plugins.UpdateSubuserRequest
-	59,  // 100: plugins.PanelService.RemoveSubuser:input_type -> plugins.RemoveSubuserRequest
-	5,   // 101: plugins.PanelService.ListDatabases:input_type -> plugins.IDRequest
-	62,  // 102: plugins.PanelService.CreateDatabase:input_type -> plugins.CreateDatabaseRequest
-	5,   // 103: plugins.PanelService.DeleteDatabase:input_type -> plugins.IDRequest
-	5,   // 104: plugins.PanelService.RotateDatabasePassword:input_type -> plugins.IDRequest
-	4,   // 105: plugins.PanelService.ListDatabaseHosts:input_type -> plugins.Empty
-	65,  // 106: plugins.PanelService.CreateDatabaseHost:input_type -> plugins.CreateDatabaseHostRequest
-	66,  // 107: plugins.PanelService.UpdateDatabaseHost:input_type -> plugins.UpdateDatabaseHostRequest
-	5,   // 108: plugins.PanelService.DeleteDatabaseHost:input_type -> plugins.IDRequest
-	69,  // 109: plugins.PanelService.ListFiles:input_type -> plugins.FilePathRequest
-	69,  // 110: plugins.PanelService.ReadFile:input_type -> plugins.FilePathRequest
-	71,  // 111: plugins.PanelService.WriteFile:input_type -> plugins.WriteFileRequest
-	69,  // 112: plugins.PanelService.DeleteFile:input_type -> plugins.FilePathRequest
-	69,  // 113: plugins.PanelService.CreateFolder:input_type -> plugins.FilePathRequest
-	72,  // 114: plugins.PanelService.MoveFile:input_type -> plugins.MoveFileRequest
-	72,  // 115: plugins.PanelService.CopyFile:input_type -> plugins.MoveFileRequest
-	38,  // 116: plugins.PanelService.CompressFiles:input_type -> plugins.CompressRequest
-	69,  // 117: plugins.PanelService.DecompressFile:input_type -> plugins.FilePathRequest
-	5,   // 118: plugins.PanelService.ListBackups:input_type -> plugins.IDRequest
-	75,  // 119: plugins.PanelService.CreateBackup:input_type -> plugins.CreateBackupRequest
-	76,  // 120: plugins.PanelService.DeleteBackup:input_type -> plugins.DeleteBackupRequest
-	4,   // 121: plugins.PanelService.ListNodes:input_type -> plugins.Empty
-	5,   // 122: plugins.PanelService.GetNode:input_type -> plugins.IDRequest
-	79,  // 123: plugins.PanelService.CreateNode:input_type -> plugins.CreateNodeRequest
-	5,   // 124: plugins.PanelService.DeleteNode:input_type -> plugins.IDRequest
-	5,   // 125: plugins.PanelService.ResetNodeToken:input_type -> plugins.IDRequest
-	4,   // 126: plugins.PanelService.ListPackages:input_type -> plugins.Empty
-	5,   // 127: plugins.PanelService.GetPackage:input_type -> plugins.IDRequest
-	84,  // 128: plugins.PanelService.CreatePackage:input_type -> plugins.CreatePackageRequest
-	85,  // 129: plugins.PanelService.UpdatePackage:input_type -> plugins.UpdatePackageRequest
-	5,   // 130: plugins.PanelService.DeletePackage:input_type -> plugins.IDRequest
-	4,   // 131: plugins.PanelService.ListIPBans:input_type -> plugins.Empty
-	88,  // 132: plugins.PanelService.CreateIPBan:input_type -> plugins.CreateIPBanRequest
-	5,   // 133: plugins.PanelService.DeleteIPBan:input_type -> plugins.IDRequest
-	4,   // 134: plugins.PanelService.GetSettings:input_type -> plugins.Empty
-	8,   // 135: plugins.PanelService.SetRegistrationEnabled:input_type -> plugins.BoolRequest
-	8,   // 136: plugins.PanelService.SetServerCreationEnabled:input_type -> plugins.BoolRequest
-	91,  // 137: plugins.PanelService.GetActivityLogs:input_type -> plugins.GetLogsRequest
-	93,  // 138: plugins.PanelService.Log:input_type -> plugins.LogRequest
-	94,  // 139: plugins.PanelService.GetKV:input_type -> plugins.KVRequest
-	96,  // 140: plugins.PanelService.SetKV:input_type -> plugins.KVSetRequest
-	94,  // 141: plugins.PanelService.DeleteKV:input_type -> plugins.KVRequest
-	97,  // 142: plugins.PanelService.QueryDB:input_type -> plugins.QueryDBRequest
-	99,  // 143: plugins.PanelService.BroadcastEvent:input_type -> plugins.BroadcastEventRequest
-	100, // 144: plugins.PanelService.SendNotification:input_type -> plugins.NotificationRequest
-	101, // 145: plugins.PanelService.HTTPRequest:input_type -> plugins.PluginHTTPRequest
-	103, // 146: plugins.PanelService.CallPlugin:input_type -> plugins.CallPluginRequest
-	9,   // 147: plugins.PluginService.GetInfo:output_type -> plugins.PluginInfo
-	23,  // 148: plugins.PluginService.OnEvent:output_type -> plugins.EventResponse
-	25,  // 149: plugins.PluginService.OnHTTP:output_type -> plugins.HTTPResponse
-	4,   // 150: plugins.PluginService.OnSchedule:output_type -> plugins.Empty
-	17,  // 151: plugins.PluginService.OnMixin:output_type -> plugins.MixinResponse
-	4,   // 152: plugins.PluginService.Shutdown:output_type -> plugins.Empty
-	3,   // 153: plugins.PanelService.Connect:output_type -> plugins.PanelMessage
-	27,  // 154: plugins.PanelService.GetServer:output_type -> plugins.Server
-	29,  // 155: plugins.PanelService.ListServers:output_type -> plugins.ListServersResponse
-	27,  // 156: plugins.PanelService.CreateServer:output_type -> plugins.Server
-	4,   // 157: plugins.PanelService.DeleteServer:output_type -> plugins.Empty
-	27,  // 158: plugins.PanelService.UpdateServer:output_type -> plugins.Server
-	4,   // 159: plugins.PanelService.SuspendServer:output_type -> plugins.Empty
-	4,   // 160: plugins.PanelService.UnsuspendServer:output_type -> plugins.Empty
-	4,   // 161: plugins.PanelService.StartServer:output_type -> plugins.Empty
-	4,   // 162: plugins.PanelService.StopServer:output_type -> plugins.Empty
-	4,   // 163: plugins.PanelService.RestartServer:output_type -> plugins.Empty
-	4,   // 164: plugins.PanelService.KillServer:output_type -> plugins.Empty
-	4,   // 165: plugins.PanelService.ReinstallServer:output_type -> plugins.Empty
-	4,   // 166: plugins.PanelService.TransferServer:output_type -> plugins.Empty
-	34,  // 167: plugins.PanelService.GetConsoleLog:output_type -> plugins.ConsoleLogResponse
-	4,   // 168: plugins.PanelService.SendCommand:output_type -> plugins.Empty
-	41,  // 169: plugins.PanelService.StreamConsole:output_type -> plugins.ConsoleLine
-	42,  // 170: plugins.PanelService.GetFullLog:output_type -> plugins.FullLogResponse
-	44,  // 171: plugins.PanelService.SearchLogs:output_type -> plugins.SearchLogsResponse
-	46,  // 172: plugins.PanelService.ListLogFiles:output_type -> plugins.LogFilesResponse
-	42,  // 173: plugins.PanelService.ReadLogFile:output_type -> plugins.FullLogResponse
-	36,  // 174: plugins.PanelService.GetServerStats:output_type -> plugins.ServerStats
-	4,   // 175: plugins.PanelService.AddAllocation:output_type -> plugins.Empty
-	4,   // 176: plugins.PanelService.DeleteAllocation:output_type -> plugins.Empty
-	4,   // 177: plugins.PanelService.SetPrimaryAllocation:output_type -> plugins.Empty
-	4,   // 178: plugins.PanelService.UpdateServerVariables:output_type -> plugins.Empty
-	49,  // 179: plugins.PanelService.GetUser:output_type -> plugins.User
-	49,  // 180: plugins.PanelService.GetUserByEmail:output_type -> plugins.User
-	49,  // 181: plugins.PanelService.GetUserByUsername:output_type -> plugins.User
-	51,  // 182: plugins.PanelService.ListUsers:output_type -> plugins.ListUsersResponse
-	49,  // 183: plugins.PanelService.CreateUser:output_type -> plugins.User
-	4,   // 184: plugins.PanelService.DeleteUser:output_type -> plugins.Empty
-	49,  // 185: plugins.PanelService.UpdateUser:output_type -> plugins.User
-	4,   // 186: plugins.PanelService.BanUser:output_type -> plugins.Empty
-	4,   // 187: plugins.PanelService.UnbanUser:output_type -> plugins.Empty
-	4,   // 188: plugins.PanelService.SetAdmin:output_type -> plugins.Empty
-	4,   // 189: plugins.PanelService.RevokeAdmin:output_type -> plugins.Empty
-	4,   // 190: plugins.PanelService.SetUserResources:output_type -> plugins.Empty
-	4,   // 191: plugins.PanelService.ForcePasswordReset:output_type -> plugins.Empty
-	56,  // 192: plugins.PanelService.ListSubusers:output_type -> plugins.ListSubusersResponse
-	55,  // 193: plugins.PanelService.AddSubuser:output_type -> plugins.Subuser
-	4,   // 194: plugins.PanelService.UpdateSubuser:output_type -> plugins.Empty
-	4,   // 195: plugins.PanelService.RemoveSubuser:output_type -> plugins.Empty
-	61,  // 196: plugins.PanelService.ListDatabases:output_type -> plugins.ListDatabasesResponse
-	60,  // 197: plugins.PanelService.CreateDatabase:output_type -> plugins.Database
-	4,   // 198: plugins.PanelService.DeleteDatabase:output_type -> plugins.Empty
-	60,  // 199: plugins.PanelService.RotateDatabasePassword:output_type -> plugins.Database
-	64,  // 200: plugins.PanelService.ListDatabaseHosts:output_type -> plugins.ListDatabaseHostsResponse
-	63,  // 201: plugins.PanelService.CreateDatabaseHost:output_type -> plugins.DatabaseHost
-	4,   // 202: plugins.PanelService.UpdateDatabaseHost:output_type -> plugins.Empty
-	4,   // 203: plugins.PanelService.DeleteDatabaseHost:output_type -> plugins.Empty
-	68,  // 204: plugins.PanelService.ListFiles:output_type -> plugins.ListFilesResponse
-	70,  // 205: plugins.PanelService.ReadFile:output_type -> plugins.FileContent
-	4,   // 206: plugins.PanelService.WriteFile:output_type -> plugins.Empty
-	4,   // 207: plugins.PanelService.DeleteFile:output_type -> plugins.Empty
-	4,   // 208: plugins.PanelService.CreateFolder:output_type -> plugins.Empty
-	4,   // 209: plugins.PanelService.MoveFile:output_type -> plugins.Empty
-	4,   // 210: plugins.PanelService.CopyFile:output_type -> plugins.Empty
-	4,   // 211: plugins.PanelService.CompressFiles:output_type -> plugins.Empty
-	4,   // 212: plugins.PanelService.DecompressFile:output_type -> plugins.Empty
-	74,  // 213: plugins.PanelService.ListBackups:output_type -> plugins.ListBackupsResponse
-	4,   // 214: plugins.PanelService.CreateBackup:output_type -> plugins.Empty
-	4,   // 215: plugins.PanelService.DeleteBackup:output_type -> plugins.Empty
-	78,  // 216: plugins.PanelService.ListNodes:output_type -> plugins.ListNodesResponse
-	77,  // 217: plugins.PanelService.GetNode:output_type -> plugins.Node
-	80,  // 218: plugins.PanelService.CreateNode:output_type -> plugins.NodeWithToken
-	4,   // 219: plugins.PanelService.DeleteNode:output_type -> plugins.Empty
-	81,  // 220: plugins.PanelService.ResetNodeToken:output_type -> plugins.NodeToken
-	83,  // 221: plugins.PanelService.ListPackages:output_type -> plugins.ListPackagesResponse
-	82,  // 222: plugins.PanelService.GetPackage:output_type -> plugins.Package
-	82,  // 223: plugins.PanelService.CreatePackage:output_type -> plugins.Package
-	82,  // 224: plugins.PanelService.UpdatePackage:output_type -> plugins.Package
-	4,   // 225: plugins.PanelService.DeletePackage:output_type -> plugins.Empty
-	87,  // 226: plugins.PanelService.ListIPBans:output_type -> plugins.ListIPBansResponse
-	86,  // 227: plugins.PanelService.CreateIPBan:output_type -> plugins.IPBan
-	4,   // 228: plugins.PanelService.DeleteIPBan:output_type -> plugins.Empty
-	89,  // 229: plugins.PanelService.GetSettings:output_type -> plugins.Settings
-	4,   // 230: plugins.PanelService.SetRegistrationEnabled:output_type -> plugins.Empty
-	4,   // 231: plugins.PanelService.SetServerCreationEnabled:output_type -> plugins.Empty
-	92,  // 232: plugins.PanelService.GetActivityLogs:output_type -> plugins.GetLogsResponse
-	4,   // 233: plugins.PanelService.Log:output_type -> plugins.Empty
-	95,  // 234: plugins.PanelService.GetKV:output_type -> plugins.KVResponse
-	4,   // 235: plugins.PanelService.SetKV:output_type -> plugins.Empty
-	4,   // 236: plugins.PanelService.DeleteKV:output_type -> plugins.Empty
-	98,  // 237: plugins.PanelService.QueryDB:output_type -> plugins.QueryDBResponse
-	4,   // 238: plugins.PanelService.BroadcastEvent:output_type -> plugins.Empty
-	4,   // 239: plugins.PanelService.SendNotification:output_type -> plugins.Empty
-	102, // 240: plugins.PanelService.HTTPRequest:output_type -> plugins.PluginHTTPResponse
-	104, // 241: plugins.PanelService.CallPlugin:output_type -> plugins.CallPluginResponse
-	147, // [147:242] is the sub-list for method output_type
-	52,  // [52:147] is the sub-list for method input_type
-	52,  // [52:52] is the sub-list for extension type_name
-	52,  // [52:52] is the sub-list for extension extendee
-	0,   // [0:52] is the sub-list for field type_name
+	21,  // 0: plugins.PluginMessage.register:type_name -> plugins.PluginInfo
+	57,  // 1: plugins.PluginMessage.event_response:type_name -> plugins.EventResponse
+	60,  // 2: plugins.PluginMessage.http_response:type_name -> plugins.HTTPResponse
+	16,  // 3: plugins.PluginMessage.schedule_response:type_name -> plugins.Empty
+	48,  // 4: plugins.PluginMessage.mixin_response:type_name -> plugins.MixinResponse
+	177, // 5: plugins.PluginMessage.addon_type_response:type_name -> plugins.AddonTypeResponse
+	36,  // 6: plugins.PluginMessage.bundle_update:type_name -> plugins.BundleUpdate
+	33,  // 7: plugins.PluginMessage.bundle_upload:type_name -> plugins.BundleUpload
+	6,   // 8: plugins.PluginMessage.api_call:type_name -> plugins.ApiCall
+	12,  // 9: plugins.PluginMessage.ping:type_name -> plugins.Ping
+	21,  // 10: plugins.PluginMessage.update_registration:type_name -> plugins.PluginInfo
+	11,  // 11: plugins.PluginMessage.health:type_name -> plugins.HealthReport
+	14,  // 12: plugins.PluginMessage.api_batch:type_name -> plugins.ApiBatch
+	179, // 13: plugins.PluginMessage.action_content:type_name -> plugins.ActionContent
+	181, // 14: plugins.PluginMessage.ui_push:type_name -> plugins.UIPush
+	28,  // 15: plugins.PanelMessage.registered:type_name -> plugins.Registered
+	56,  // 16: plugins.PanelMessage.event:type_name -> plugins.Event
+	58,  // 17: plugins.PanelMessage.http:type_name -> plugins.HTTPRequest
+	61,  // 18: plugins.PanelMessage.schedule:type_name -> plugins.ScheduleRequest
+	47,  // 19: plugins.PanelMessage.mixin:type_name -> plugins.MixinRequest
+	16,  // 20: plugins.PanelMessage.shutdown:type_name -> plugins.Empty
+	176, // 21: plugins.PanelMessage.addon_type:type_name -> plugins.AddonTypeRequest
+	32,  // 22: plugins.PanelMessage.bundle_request:type_name -> plugins.BundleRequest
+	13,  // 23: plugins.PanelMessage.api_result:type_name -> plugins.ApiResult
+	12,  // 24: plugins.PanelMessage.ping:type_name -> plugins.Ping
+	8,   // 25: plugins.PanelMessage.registration_updated:type_name -> plugins.RegistrationUpdated
+	16,  // 26: plugins.PanelMessage.health_probe:type_name -> plugins.Empty
+	23,  // 27: plugins.PanelMessage.flags_changed:type_name -> plugins.FlagValues
+	7,   // 28: plugins.PanelMessage.body_chunk:type_name -> plugins.BodyChunk
+	15,  // 29: plugins.PanelMessage.api_batch_result:type_name -> plugins.ApiBatchResult
+	10,  // 30: plugins.PanelMessage.route_throttled:type_name -> plugins.RouteThrottled
+	9,   // 31: plugins.RegistrationUpdated.applied_routes:type_name -> plugins.AppliedRoute
+	54,  // 32: plugins.AppliedRoute.rate_limit:type_name -> plugins.RateLimitConfig
+	0,   // 33: plugins.HealthReport.state:type_name -> plugins.HealthReport.State
+	6,   // 34: plugins.ApiBatch.calls:type_name -> plugins.ApiCall
+	13,  // 35: plugins.ApiBatchResult.results:type_name -> plugins.ApiResult
+	51,  // 36: plugins.PluginInfo.routes:type_name -> plugins.RouteInfo
+	55,  // 37: plugins.PluginInfo.schedules:type_name -> plugins.ScheduleInfo
+	46,  // 38: plugins.PluginInfo.mixins:type_name -> plugins.MixinInfo
+	175, // 39: plugins.PluginInfo.addon_types:type_name -> plugins.AddonTypeInfo
+	26,  // 40: plugins.PluginInfo.ui:type_name -> plugins.PluginUIInfo
+	24,  // 41: plugins.PluginInfo.dependencies:type_name -> plugins.PluginDependency
+	22,  // 42: plugins.PluginInfo.flags:type_name -> plugins.FlagDeclaration
+	182, // 43: plugins.FlagValues.values:type_name -> plugins.FlagValues.ValuesEntry
+	38,  // 44: plugins.PluginUIInfo.pages:type_name -> plugins.PluginUIPage
+	41,  // 45: plugins.PluginUIInfo.tabs:type_name -> plugins.PluginUITab
+	43,  // 46: plugins.PluginUIInfo.sidebar_items:type_name -> plugins.PluginUISidebarItem
+	37,  // 47: plugins.PluginUIInfo.assets:type_name -> plugins.PluginUIAsset
+	27,  // 48: plugins.PluginUIInfo.locales:type_name -> plugins.PluginUILocale
+	183, // 49: plugins.PluginUILocale.strings:type_name -> plugins.PluginUILocale.StringsEntry
+	25,  // 50: plugins.Registered.dependencies:type_name -> plugins.DependencyStatus
+	31,  // 51: plugins.Registered.permissions:type_name -> plugins.PermissionGrant
+	23,  // 52: plugins.Registered.flags:type_name -> plugins.FlagValues
+	30,  // 53: plugins.Registered.egress:type_name -> plugins.EgressPolicy
+	9,   // 54: plugins.Registered.applied_routes:type_name -> plugins.AppliedRoute
+	29,  // 55: plugins.Registered.environment:type_name -> plugins.PanelEnvironment
+	184, // 56: plugins.PanelEnvironment.features:type_name -> plugins.PanelEnvironment.FeaturesEntry
+	37,  // 57: plugins.BundleUpload.assets:type_name -> plugins.PluginUIAsset
+	37,  // 58: plugins.BundleUpdate.assets:type_name -> plugins.PluginUIAsset
+	39,  // 59: plugins.PluginUIPage.form:type_name -> plugins.PluginUIForm
+	40,  // 60: plugins.PluginUIForm.fields:type_name -> plugins.PluginUIFormField
+	44,  // 61: plugins.PluginUITab.visible_when:type_name -> plugins.PluginUICondition
+	42,  // 62: plugins.PluginUITab.badge:type_name -> plugins.PluginUITabBadge
+	45,  // 63: plugins.PluginUISidebarItem.children:type_name -> plugins.PluginUISidebarChild
+	44,  // 64: plugins.PluginUISidebarItem.visible_when:type_name -> plugins.PluginUICondition
+	1,   // 65: plugins.MixinResponse.action:type_name -> plugins.MixinResponse.Action
+	50,  // 66: plugins.MixinResponse.notifications:type_name -> plugins.Notification
+	49,  // 67: plugins.MixinResponse.skipped_effects:type_name -> plugins.SkippedEffect
+	54,  // 68: plugins.RouteInfo.rate_limit:type_name -> plugins.RateLimitConfig
+	52,  // 69: plugins.RouteInfo.schema:type_name -> plugins.RouteSchema
+	53,  // 70: plugins.RouteSchema.params:type_name -> plugins.RouteParam
+	185, // 71: plugins.Event.data:type_name -> plugins.Event.DataEntry
+	186, // 72: plugins.HTTPRequest.headers:type_name -> plugins.HTTPRequest.HeadersEntry
+	187, // 73: plugins.HTTPRequest.query:type_name -> plugins.HTTPRequest.QueryEntry
+	59,  // 74: plugins.HTTPRequest.rate_limit:type_name -> plugins.RateLimitState
+	188, // 75: plugins.HTTPResponse.headers:type_name -> plugins.HTTPResponse.HeadersEntry
+	63,  // 76: plugins.Server.allocations:type_name -> plugins.Allocation
+	63,  // 77: plugins.ListAllocationsResponse.allocations:type_name -> plugins.Allocation
+	69,  // 78: plugins.ServerSchedule.tasks:type_name -> plugins.ScheduleTask
+	68,  // 79: plugins.ListServerSchedulesResponse.schedules:type_name -> plugins.ServerSchedule
+	69,  // 80: plugins.CreateServerScheduleRequest.tasks:type_name -> plugins.ScheduleTask
+	69,  // 81: plugins.AddScheduleTaskRequest.task:type_name -> plugins.ScheduleTask
+	62,  // 82: plugins.ListServersResponse.servers:type_name -> plugins.Server
+	77,  // 83: plugins.CreateServerRequest.deployment:type_name -> plugins.Deployment
+	189, // 84: plugins.CreateServerRequest.variables:type_name -> plugins.CreateServerRequest.VariablesEntry
+	190, // 85: plugins.UpdateVariablesRequest.variables:type_name -> plugins.UpdateVariablesRequest.VariablesEntry
+	191, // 86: plugins.ServerSettings.values:type_name -> plugins.ServerSettings.ValuesEntry
+	192, // 87: plugins.SetServerSettingsRequest.values:type_name -> plugins.SetServerSettingsRequest.ValuesEntry
+	97,  // 88: plugins.SearchLogsResponse.matches:type_name -> plugins.LogMatch
+	99,  // 89: plugins.LogFilesResponse.files:type_name -> plugins.LogFileInfo
+	101, // 90: plugins.ListUsersResponse.users:type_name -> plugins.User
+	107, // 91: plugins.ListSubusersResponse.subusers:type_name -> plugins.Subuser
+	112, // 92: plugins.ListSubuserPermissionsResponse.permissions:type_name -> plugins.SubuserPermissionInfo
+	114, // 93: plugins.ListDatabasesResponse.databases:type_name -> plugins.Database
+	117, // 94: plugins.ListDatabaseHostsResponse.hosts:type_name -> plugins.DatabaseHost
+	121, // 95: plugins.ListFilesResponse.files:type_name -> plugins.FileInfo
+	127, // 96: plugins.ListBackupsResponse.backups:type_name -> plugins.Backup
+	134, // 97: plugins.ListNodeStatsResponse.stats:type_name -> plugins.NodeStats
+	133, // 98: plugins.ListNodesResponse.nodes:type_name -> plugins.Node
+	133, // 99: plugins.NodeWithToken.node:type_name -> plugins.Node
+	141, // 100: plugins.Package.variables:type_name -> plugins.PackageVariable
+	140, // 101: plugins.ListPackagesResponse.packages:type_name -> plugins.Package
+	145, // 102: plugins.ListIPBansResponse.bans:type_name -> plugins.IPBan
+	149, // 103: plugins.GetLogsResponse.logs:type_name -> plugins.ActivityLog
+	193, // 104: plugins.AuditEntry.metadata:type_name -> plugins.AuditEntry.MetadataEntry
+	152, // 105: plugins.AuditRequest.entries:type_name -> plugins.AuditEntry
+	194, // 106: plugins.LogRequest.fields:type_name -> plugins.LogRequest.FieldsEntry
+	195, // 107: plugins.BroadcastEventRequest.data:type_name -> plugins.BroadcastEventRequest.DataEntry
+	196, // 108: plugins.PluginHTTPRequest.headers:type_name -> plugins.PluginHTTPRequest.HeadersEntry
+	197, // 109: plugins.PluginHTTPResponse.headers:type_name -> plugins.PluginHTTPResponse.HeadersEntry
+	198, // 110: plugins.PluginRouteRequest.headers:type_name -> plugins.PluginRouteRequest.HeadersEntry
+	199, // 111: plugins.PluginRouteRequest.query:type_name -> plugins.PluginRouteRequest.QueryEntry
+	173, // 112: plugins.ListPluginsResponse.plugins:type_name -> plugins.PluginSummary
+	200, // 113: plugins.AddonTypeRequest.source_info:type_name -> plugins.AddonTypeRequest.SourceInfoEntry
+	201, // 114: plugins.AddonTypeRequest.server_variables:type_name -> plugins.AddonTypeRequest.ServerVariablesEntry
+	178, // 115: plugins.AddonTypeResponse.actions:type_name -> plugins.AddonInstallAction
+	2,   // 116: plugins.AddonInstallAction.type:type_name -> plugins.AddonInstallAction.ActionType
+	202, // 117: plugins.AddonInstallAction.headers:type_name -> plugins.AddonInstallAction.HeadersEntry
+	180, // 118: plugins.AddonInstallAction.conditions:type_name -> plugins.ActionCondition
+	3,   // 119: plugins.ActionCondition.kind:type_name -> plugins.ActionCondition.Kind
+	16,  // 120: plugins.PluginService.GetInfo:input_type -> plugins.Empty
+	56,  // 121: plugins.PluginService.OnEvent:input_type -> plugins.Event
+	58,  // 122: plugins.PluginService.OnHTTP:input_type -> plugins.HTTPRequest
+	61,  // 123: plugins.PluginService.OnSchedule:input_type -> plugins.ScheduleRequest
+	47,  // 124: plugins.PluginService.OnMixin:input_type -> plugins.MixinRequest
+	16,  // 125: plugins.PluginService.Shutdown:input_type -> plugins.Empty
+	4,   // 126: plugins.PanelService.Connect:input_type -> plugins.PluginMessage
+	17,  // 127: plugins.PanelService.GetServer:input_type -> plugins.IDRequest
+	74,  // 128: plugins.PanelService.ListServers:input_type -> plugins.ListServersRequest
+	76,  // 129: plugins.PanelService.CreateServer:input_type -> plugins.CreateServerRequest
+	78,  // 130: plugins.PanelService.DeleteServer:input_type -> plugins.DeleteServerRequest
+	79,  // 131: plugins.PanelService.UpdateServer:input_type -> plugins.UpdateServerRequest
+	17,  // 132: plugins.PanelService.SuspendServer:input_type -> plugins.IDRequest
+	17,  // 133: plugins.PanelService.UnsuspendServer:input_type -> plugins.IDRequest
+	17,  // 134: plugins.PanelService.StartServer:input_type -> plugins.IDRequest
+	17,  // 135: plugins.PanelService.StopServer:input_type -> plugins.IDRequest
+	17,  // 136: plugins.PanelService.RestartServer:input_type -> plugins.IDRequest
+	17,  // 137: plugins.PanelService.KillServer:input_type -> plugins.IDRequest
+	17,  // 138: plugins.PanelService.ReinstallServer:input_type -> plugins.IDRequest
+	80,  // 139: plugins.PanelService.TransferServer:input_type -> plugins.TransferServerRequest
+	81,  // 140: plugins.PanelService.GetConsoleLog:input_type -> plugins.ConsoleLogRequest
+	83,  // 141: plugins.PanelService.SendCommand:input_type -> plugins.SendCommandRequest
+	88,  // 142: plugins.PanelService.StreamConsole:input_type -> plugins.StreamConsoleRequest
+	17,  // 143: plugins.PanelService.StreamStatus:input_type -> plugins.IDRequest
+	17,  // 144: plugins.PanelService.GetFullLog:input_type -> plugins.IDRequest
+	95,  // 145: plugins.PanelService.SearchLogs:input_type -> plugins.SearchLogsRequest
+	17,  // 146: plugins.PanelService.ListLogFiles:input_type -> plugins.IDRequest
+	100, // 147: plugins.PanelService.ReadLogFile:input_type -> plugins.ReadLogFileRequest
+	17,  // 148: plugins.PanelService.GetServerStats:input_type -> plugins.IDRequest
+	85,  // 149: plugins.PanelService.AddAllocation:input_type -> plugins.AllocationRequest
+	85,  // 150: plugins.PanelService.DeleteAllocation:input_type -> plugins.AllocationRequest
+	85,  // 151: plugins.PanelService.SetPrimaryAllocation:input_type -> plugins.AllocationRequest
+	64,  // 152: plugins.PanelService.ListAllocations:input_type -> plugins.ListAllocationsRequest
+	66,  // 153: plugins.PanelService.CreateAllocations:input_type -> plugins.CreateAllocationsRequest
+	67,  // 154: plugins.PanelService.AssignAllocation:input_type -> plugins.AssignAllocationRequest
+	17,  // 155: plugins.PanelService.ReleaseAllocation:input_type -> plugins.IDRequest
+	73,  // 156: plugins.PanelService.ReserveAllocation:input_type -> plugins.ReserveAllocationRequest
+	17,  // 157: plugins.PanelService.ListServerSchedules:input_type -> plugins.IDRequest
+	71,  // 158: plugins.PanelService.CreateServerSchedule:input_type -> plugins.CreateServerScheduleRequest
+	17,  // 159: plugins.PanelService.DeleteServerSchedule:input_type -> plugins.IDRequest
+	72,  // 160: plugins.PanelService.AddScheduleTask:input_type -> plugins.AddScheduleTaskRequest
+	17,  // 161: plugins.PanelService.DeleteScheduleTask:input_type -> plugins.IDRequest
+	87,  // 162: plugins.PanelService.UpdateServerVariables:input_type -> plugins.UpdateVariablesRequest
+	17,  // 163: plugins.PanelService.GetUser:input_type -> plugins.IDRequest
+	18,  // 164: plugins.PanelService.GetUserByEmail:input_type -> plugins.EmailRequest
+	19,  // 165: plugins.PanelService.GetUserByUsername:input_type -> plugins.UsernameRequest
+	102, // 166: plugins.PanelService.ListUsers:input_type -> plugins.ListUsersRequest
+	104, // 167: plugins.PanelService.CreateUser:input_type -> plugins.CreateUserRequest
+	17,  // 168: plugins.PanelService.DeleteUser:input_type -> plugins.IDRequest
+	105, // 169: plugins.PanelService.UpdateUser:input_type -> plugins.UpdateUserRequest
+	17,  // 170: plugins.PanelService.BanUser:input_type -> plugins.IDRequest
+	17,  // 171: plugins.PanelService.UnbanUser:input_type -> plugins.IDRequest
+	17,  // 172: plugins.PanelService.SetAdmin:input_type -> plugins.IDRequest
+	17,  // 173: plugins.PanelService.RevokeAdmin:input_type -> plugins.IDRequest
+	106, // 174: plugins.PanelService.SetUserResources:input_type -> plugins.SetUserResourcesRequest
+	17,  // 175: plugins.PanelService.ForcePasswordReset:input_type -> plugins.IDRequest
+	17,  // 176: plugins.PanelService.ListSubusers:input_type -> plugins.IDRequest
+	109, // 177: plugins.PanelService.AddSubuser:input_type -> plugins.AddSubuserRequest
+	110, // 178: plugins.PanelService.UpdateSubuser:input_type -> plugins.UpdateSubuserRequest
+	111, // 179: plugins.PanelService.RemoveSubuser:input_type -> plugins.RemoveSubuserRequest
+	16,  // 180: plugins.PanelService.ListSubuserPermissions:input_type -> plugins.Empty
+	17,  // 181: plugins.PanelService.ListDatabases:input_type -> plugins.IDRequest
+	116, // 182: plugins.PanelService.CreateDatabase:input_type -> plugins.CreateDatabaseRequest
+	17,  // 183: plugins.PanelService.DeleteDatabase:input_type -> plugins.IDRequest
+	17,  // 184: plugins.PanelService.RotateDatabasePassword:input_type -> plugins.IDRequest
+	16,  // 185: plugins.PanelService.ListDatabaseHosts:input_type -> plugins.Empty
+	119, // 186: plugins.PanelService.CreateDatabaseHost:input_type -> plugins.CreateDatabaseHostRequest
+	120, // 187: plugins.PanelService.UpdateDatabaseHost:input_type -> plugins.UpdateDatabaseHostRequest
+	17,  // 188: plugins.PanelService.DeleteDatabaseHost:input_type -> plugins.IDRequest
+	123, // 189: plugins.PanelService.ListFiles:input_type -> plugins.FilePathRequest
+	123, // 190: plugins.PanelService.ReadFile:input_type -> plugins.FilePathRequest
+	125, // 191: plugins.PanelService.WriteFile:input_type -> plugins.WriteFileRequest
+	123, // 192: plugins.PanelService.DeleteFile:input_type -> plugins.FilePathRequest
+	123, // 193: plugins.PanelService.CreateFolder:input_type -> plugins.FilePathRequest
+	126, // 194: plugins.PanelService.MoveFile:input_type -> plugins.MoveFileRequest
+	126, // 195: plugins.PanelService.CopyFile:input_type -> plugins.MoveFileRequest
+	86,  // 196: plugins.PanelService.CompressFiles:input_type -> plugins.CompressRequest
+	123, // 197: plugins.PanelService.DecompressFile:input_type -> plugins.FilePathRequest
+	17,  // 198: plugins.PanelService.ListBackups:input_type -> plugins.IDRequest
+	129, // 199: plugins.PanelService.CreateBackup:input_type -> plugins.CreateBackupRequest
+	130, // 200: plugins.PanelService.DeleteBackup:input_type -> plugins.DeleteBackupRequest
+	131, // 201: plugins.PanelService.GetBackup:input_type -> plugins.BackupRequest
+	132, // 202: plugins.PanelService.RestoreBackup:input_type -> plugins.RestoreBackupRequest
+	16,  // 203: plugins.PanelService.ListNodes:input_type -> plugins.Empty
+	17,  // 204: plugins.PanelService.GetNode:input_type -> plugins.IDRequest
+	137, // 205: plugins.PanelService.CreateNode:input_type -> plugins.CreateNodeRequest
+	17,  // 206: plugins.PanelService.DeleteNode:input_type -> plugins.IDRequest
+	17,  // 207: plugins.PanelService.ResetNodeToken:input_type -> plugins.IDRequest
+	17,  // 208: plugins.PanelService.GetNodeStats:input_type -> plugins.IDRequest
+	16,  // 209: plugins.PanelService.ListNodeStats:input_type -> plugins.Empty
+	16,  // 210: plugins.PanelService.ListPackages:input_type -> plugins.Empty
+	17,  // 211: plugins.PanelService.GetPackage:input_type -> plugins.IDRequest
+	143, // 212: plugins.PanelService.CreatePackage:input_type -> plugins.CreatePackageRequest
+	144, // 213: plugins.PanelService.UpdatePackage:input_type -> plugins.UpdatePackageRequest
+	17,  // 214: plugins.PanelService.DeletePackage:input_type -> plugins.IDRequest
+	16,  // 215: plugins.PanelService.ListIPBans:input_type -> plugins.Empty
+	147, // 216: plugins.PanelService.CreateIPBan:input_type -> plugins.CreateIPBanRequest
+	17,  // 217: plugins.PanelService.DeleteIPBan:input_type -> plugins.IDRequest
+	16,  // 218: plugins.PanelService.GetSettings:input_type -> plugins.Empty
+	20,  // 219: plugins.PanelService.SetRegistrationEnabled:input_type -> plugins.BoolRequest
+	20,  // 220: plugins.PanelService.SetServerCreationEnabled:input_type -> plugins.BoolRequest
+	150, // 221: plugins.PanelService.GetActivityLogs:input_type -> plugins.GetLogsRequest
+	153, // 222: plugins.PanelService.WriteAudit:input_type -> plugins.AuditRequest
+	154, // 223: plugins.PanelService.Log:input_type -> plugins.LogRequest
+	156, // 224: plugins.PanelService.GetKV:input_type -> plugins.KVRequest
+	158, // 225: plugins.PanelService.SetKV:input_type -> plugins.KVSetRequest
+	156, // 226: plugins.PanelService.DeleteKV:input_type -> plugins.KVRequest
+	159, // 227: plugins.PanelService.ListKV:input_type -> plugins.KVListRequest
+	161, // 228: plugins.PanelService.CompareAndSwapKV:input_type -> plugins.KVCompareAndSwapRequest
+	17,  // 229: plugins.PanelService.GetServerSettings:input_type -> plugins.IDRequest
+	92,  // 230: plugins.PanelService.SetServerSettings:input_type -> plugins.SetServerSettingsRequest
+	17,  // 231: plugins.PanelService.WatchServerSettings:input_type -> plugins.IDRequest
+	163, // 232: plugins.PanelService.QueryDB:input_type -> plugins.QueryDBRequest
+	165, // 233: plugins.PanelService.BroadcastEvent:input_type -> plugins.BroadcastEventRequest
+	166, // 234: plugins.PanelService.SendNotification:input_type -> plugins.NotificationRequest
+	155, // 235: plugins.PanelService.ReportError:input_type -> plugins.ErrorReport
+	167, // 236: plugins.PanelService.SendEmail:input_type -> plugins.SendEmailRequest
+	168, // 237: plugins.PanelService.HTTPRequest:input_type -> plugins.PluginHTTPRequest
+	170, // 238: plugins.PanelService.CallPlugin:input_type -> plugins.CallPluginRequest
+	172, // 239: plugins.PanelService.CallPluginRoute:input_type -> plugins.PluginRouteRequest
+	16,  // 240: plugins.PanelService.ListPlugins:input_type -> plugins.Empty
+	34,  // 241: plugins.PanelService.UploadBundle:input_type -> plugins.BundleChunk
+	21,  // 242: plugins.PluginService.GetInfo:output_type -> plugins.PluginInfo
+	57,  // 243: plugins.PluginService.OnEvent:output_type -> plugins.EventResponse
+	60,  // 244: plugins.PluginService.OnHTTP:output_type -> plugins.HTTPResponse
+	16,  // 245: plugins.PluginService.OnSchedule:output_type -> plugins.Empty
+	48,  // 246: plugins.PluginService.OnMixin:output_type -> plugins.MixinResponse
+	16,  // 247: plugins.PluginService.Shutdown:output_type -> plugins.Empty
+	5,   // 248: plugins.PanelService.Connect:output_type -> plugins.PanelMessage
+	62,  // 249: plugins.PanelService.GetServer:output_type -> plugins.Server
+	75,  // 250: plugins.PanelService.ListServers:output_type -> plugins.ListServersResponse
+	62,  // 251: plugins.PanelService.CreateServer:output_type -> plugins.Server
+	16,  // 252: plugins.PanelService.DeleteServer:output_type -> plugins.Empty
+	62,  // 253: plugins.PanelService.UpdateServer:output_type -> plugins.Server
+	16,  // 254: plugins.PanelService.SuspendServer:output_type -> plugins.Empty
+	16,  // 255: plugins.PanelService.UnsuspendServer:output_type -> plugins.Empty
+	16,  // 256: plugins.PanelService.StartServer:output_type -> plugins.Empty
+	16,  // 257: plugins.PanelService.StopServer:output_type -> plugins.Empty
+	16,  // 258: plugins.PanelService.RestartServer:output_type -> plugins.Empty
+	16,  // 259: plugins.PanelService.KillServer:output_type -> plugins.Empty
+	16,  // 260: plugins.PanelService.ReinstallServer:output_type -> plugins.Empty
+	16,  // 261: plugins.PanelService.TransferServer:output_type -> plugins.Empty
+	82,  // 262: plugins.PanelService.GetConsoleLog:output_type -> plugins.ConsoleLogResponse
+	16,  // 263: plugins.PanelService.SendCommand:output_type -> plugins.Empty
+	89,  // 264: plugins.PanelService.StreamConsole:output_type -> plugins.ConsoleLine
+	90,  // 265: plugins.PanelService.StreamStatus:output_type -> plugins.ServerStatusChange
+	94,  // 266: plugins.PanelService.GetFullLog:output_type -> plugins.FullLogResponse
+	96,  // 267: plugins.PanelService.SearchLogs:output_type -> plugins.SearchLogsResponse
+	98,  // 268: plugins.PanelService.ListLogFiles:output_type -> plugins.LogFilesResponse
+	94,  // 269: plugins.PanelService.ReadLogFile:output_type -> plugins.FullLogResponse
+	84,  // 270: plugins.PanelService.GetServerStats:output_type -> plugins.ServerStats
+	16,  // 271: plugins.PanelService.AddAllocation:output_type -> plugins.Empty
+	16,  // 272: plugins.PanelService.DeleteAllocation:output_type -> plugins.Empty
+	16,  // 273: plugins.PanelService.SetPrimaryAllocation:output_type -> plugins.Empty
+	65,  // 274: plugins.PanelService.ListAllocations:output_type -> plugins.ListAllocationsResponse
+	16,  // 275: plugins.PanelService.CreateAllocations:output_type -> plugins.Empty
+	16,  // 276: plugins.PanelService.AssignAllocation:output_type -> plugins.Empty
+	16,  // 277: plugins.PanelService.ReleaseAllocation:output_type -> plugins.Empty
+	63,  // 278: plugins.PanelService.ReserveAllocation:output_type -> plugins.Allocation
+	70,  // 279: plugins.PanelService.ListServerSchedules:output_type -> plugins.ListServerSchedulesResponse
+	68,  // 280: plugins.PanelService.CreateServerSchedule:output_type -> plugins.ServerSchedule
+	16,  // 281: plugins.PanelService.DeleteServerSchedule:output_type -> plugins.Empty
+	69,  // 282: plugins.PanelService.AddScheduleTask:output_type -> plugins.ScheduleTask
+	16,  // 283: plugins.PanelService.DeleteScheduleTask:output_type -> plugins.Empty
+	16,  // 284: plugins.PanelService.UpdateServerVariables:output_type -> plugins.Empty
+	101, // 285: plugins.PanelService.GetUser:output_type -> plugins.User
+	101, // 286: plugins.PanelService.GetUserByEmail:output_type -> plugins.User
+	101, // 287: plugins.PanelService.GetUserByUsername:output_type -> plugins.User
+	103, // 288: plugins.PanelService.ListUsers:output_type -> plugins.ListUsersResponse
+	101, // 289: plugins.PanelService.CreateUser:output_type -> plugins.User
+	16,  // 290: plugins.PanelService.DeleteUser:output_type -> plugins.Empty
+	101, // 291: plugins.PanelService.UpdateUser:output_type -> plugins.User
+	16,  // 292: plugins.PanelService.BanUser:output_type -> plugins.Empty
+	16,  // 293: plugins.PanelService.UnbanUser:output_type -> plugins.Empty
+	16,  // 294: plugins.PanelService.SetAdmin:output_type -> plugins.Empty
+	16,  // 295: plugins.PanelService.RevokeAdmin:output_type -> plugins.Empty
+	16,  // 296: plugins.PanelService.SetUserResources:output_type -> plugins.Empty
+	16,  // 297: plugins.PanelService.ForcePasswordReset:output_type -> plugins.Empty
+	108, // 298: plugins.PanelService.ListSubusers:output_type -> plugins.ListSubusersResponse
+	107, // 299: plugins.PanelService.AddSubuser:output_type -> plugins.Subuser
+	16,  // 300: plugins.PanelService.UpdateSubuser:output_type -> plugins.Empty
+	16,  // 301: plugins.PanelService.RemoveSubuser:output_type -> plugins.Empty
+	113, // 302: plugins.PanelService.ListSubuserPermissions:output_type -> plugins.ListSubuserPermissionsResponse
+	115, // 303: plugins.PanelService.ListDatabases:output_type -> plugins.ListDatabasesResponse
+	114, // 304: plugins.PanelService.CreateDatabase:output_type -> plugins.Database
+	16,  // 305: plugins.PanelService.DeleteDatabase:output_type -> plugins.Empty
+	114, // 306: plugins.PanelService.RotateDatabasePassword:output_type -> plugins.Database
+	118, // 307: plugins.PanelService.ListDatabaseHosts:output_type -> plugins.ListDatabaseHostsResponse
+	117, // 308: plugins.PanelService.CreateDatabaseHost:output_type -> plugins.DatabaseHost
+	16,  // 309: plugins.PanelService.UpdateDatabaseHost:output_type -> plugins.Empty
+	16,  // 310: plugins.PanelService.DeleteDatabaseHost:output_type -> plugins.Empty
+	122, // 311: plugins.PanelService.ListFiles:output_type -> plugins.ListFilesResponse
+	124, // 312: plugins.PanelService.ReadFile:output_type -> plugins.FileContent
+	16,  // 313: plugins.PanelService.WriteFile:output_type -> plugins.Empty
+	16,  // 314: plugins.PanelService.DeleteFile:output_type -> plugins.Empty
+	16,  // 315: plugins.PanelService.CreateFolder:output_type -> plugins.Empty
+	16,  // 316: plugins.PanelService.MoveFile:output_type -> plugins.Empty
+	16,  // 317: plugins.PanelService.CopyFile:output_type -> plugins.Empty
+	16,  // 318: plugins.PanelService.CompressFiles:output_type -> plugins.Empty
+	16,  // 319: plugins.PanelService.DecompressFile:output_type -> plugins.Empty
+	128, // 320: plugins.PanelService.ListBackups:output_type -> plugins.ListBackupsResponse
+	127, // 321: plugins.PanelService.CreateBackup:output_type -> plugins.Backup
+	16,  // 322: plugins.PanelService.DeleteBackup:output_type -> plugins.Empty
+	127, // 323: plugins.PanelService.GetBackup:output_type -> plugins.Backup
+	16,  // 324: plugins.PanelService.RestoreBackup:output_type -> plugins.Empty
+	136, // 325: plugins.PanelService.ListNodes:output_type -> plugins.ListNodesResponse
+	133, // 326: plugins.PanelService.GetNode:output_type -> plugins.Node
+	138, // 327: plugins.PanelService.CreateNode:output_type -> plugins.NodeWithToken
+	16,  // 328: plugins.PanelService.DeleteNode:output_type -> plugins.Empty
+	139, // 329: plugins.PanelService.ResetNodeToken:output_type -> plugins.NodeToken
+	134, // 330: plugins.PanelService.GetNodeStats:output_type -> plugins.NodeStats
+	135, // 331: plugins.PanelService.ListNodeStats:output_type -> plugins.ListNodeStatsResponse
+	142, // 332: plugins.PanelService.ListPackages:output_type -> plugins.ListPackagesResponse
+	140, // 333: plugins.PanelService.GetPackage:output_type -> plugins.Package
+	140, // 334: plugins.PanelService.CreatePackage:output_type -> plugins.Package
+	140, // 335: plugins.PanelService.UpdatePackage:output_type -> plugins.Package
+	16,  // 336: plugins.PanelService.DeletePackage:output_type -> plugins.Empty
+	146, // 337: plugins.PanelService.ListIPBans:output_type -> plugins.ListIPBansResponse
+	145, // 338: plugins.PanelService.CreateIPBan:output_type -> plugins.IPBan
+	16,  // 339: plugins.PanelService.DeleteIPBan:output_type -> plugins.Empty
+	148, // 340: plugins.PanelService.GetSettings:output_type -> plugins.Settings
+	16,  // 341: plugins.PanelService.SetRegistrationEnabled:output_type -> plugins.Empty
+	16,  // 342: plugins.PanelService.SetServerCreationEnabled:output_type -> plugins.Empty
+	151, // 343: plugins.PanelService.GetActivityLogs:output_type -> plugins.GetLogsResponse
+	16,  // 344: plugins.PanelService.WriteAudit:output_type -> plugins.Empty
+	16,  // 345: plugins.PanelService.Log:output_type -> plugins.Empty
+	157, // 346: plugins.PanelService.GetKV:output_type -> plugins.KVResponse
+	16,  // 347: plugins.PanelService.SetKV:output_type -> plugins.Empty
+	16,  // 348: plugins.PanelService.DeleteKV:output_type -> plugins.Empty
+	160, // 349: plugins.PanelService.ListKV:output_type -> plugins.KVListResponse
+	162, // 350: plugins.PanelService.CompareAndSwapKV:output_type -> plugins.KVCompareAndSwapResponse
+	91,  // 351: plugins.PanelService.GetServerSettings:output_type -> plugins.ServerSettings
+	16,  // 352: plugins.PanelService.SetServerSettings:output_type -> plugins.Empty
+	93,  // 353: plugins.PanelService.WatchServerSettings:output_type -> plugins.ServerSettingsChange
+	164, // 354: plugins.PanelService.QueryDB:output_type -> plugins.QueryDBResponse
+	16,  // 355: plugins.PanelService.BroadcastEvent:output_type -> plugins.Empty
+	16,  // 356: plugins.PanelService.SendNotification:output_type -> plugins.Empty
+	16,  // 357: plugins.PanelService.ReportError:output_type -> plugins.Empty
+	16,  // 358: plugins.PanelService.SendEmail:output_type -> plugins.Empty
+	169, // 359: plugins.PanelService.HTTPRequest:output_type -> plugins.PluginHTTPResponse
+	171, // 360: plugins.PanelService.CallPlugin:output_type -> plugins.CallPluginResponse
+	60,  // 361: plugins.PanelService.CallPluginRoute:output_type -> plugins.HTTPResponse
+	174, // 362: plugins.PanelService.ListPlugins:output_type -> plugins.ListPluginsResponse
+	35,  // 363: plugins.PanelService.UploadBundle:output_type -> plugins.BundleUploadResult
+	242, // [242:364] is the sub-list for method output_type
+	120, // [120:242] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_plugin_proto_init() }
@@ -8333,6 +14642,15 @@ func file_plugin_proto_init() {
 		(*PluginMessage_ScheduleResponse)(nil),
 		(*PluginMessage_MixinResponse)(nil),
 		(*PluginMessage_AddonTypeResponse)(nil),
+		(*PluginMessage_BundleUpdate)(nil),
+		(*PluginMessage_BundleUpload)(nil),
+		(*PluginMessage_ApiCall)(nil),
+		(*PluginMessage_Ping)(nil),
+		(*PluginMessage_UpdateRegistration)(nil),
+		(*PluginMessage_Health)(nil),
+		(*PluginMessage_ApiBatch)(nil),
+		(*PluginMessage_ActionContent)(nil),
+		(*PluginMessage_UiPush)(nil),
 	}
 	file_plugin_proto_msgTypes[1].OneofWrappers = []any{
 		(*PanelMessage_Registered)(nil),
@@ -8342,14 +14660,23 @@ func file_plugin_proto_init() {
 		(*PanelMessage_Mixin)(nil),
 		(*PanelMessage_Shutdown)(nil),
 		(*PanelMessage_AddonType)(nil),
+		(*PanelMessage_BundleRequest)(nil),
+		(*PanelMessage_ApiResult)(nil),
+		(*PanelMessage_Ping)(nil),
+		(*PanelMessage_RegistrationUpdated)(nil),
+		(*PanelMessage_HealthProbe)(nil),
+		(*PanelMessage_FlagsChanged)(nil),
+		(*PanelMessage_BodyChunk)(nil),
+		(*PanelMessage_ApiBatchResult)(nil),
+		(*PanelMessage_RouteThrottled)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugin_proto_rawDesc), len(file_plugin_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   118,
+			NumEnums:      4,
+			NumMessages:   199,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
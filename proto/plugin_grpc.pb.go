@@ -328,6 +328,7 @@ const (
 	PanelService_GetConsoleLog_FullMethodName            = "/plugins.PanelService/GetConsoleLog"
 	PanelService_SendCommand_FullMethodName              = "/plugins.PanelService/SendCommand"
 	PanelService_StreamConsole_FullMethodName            = "/plugins.PanelService/StreamConsole"
+	PanelService_StreamStatus_FullMethodName             = "/plugins.PanelService/StreamStatus"
 	PanelService_GetFullLog_FullMethodName               = "/plugins.PanelService/GetFullLog"
 	PanelService_SearchLogs_FullMethodName               = "/plugins.PanelService/SearchLogs"
 	PanelService_ListLogFiles_FullMethodName             = "/plugins.PanelService/ListLogFiles"
@@ -336,6 +337,16 @@ const (
 	PanelService_AddAllocation_FullMethodName            = "/plugins.PanelService/AddAllocation"
 	PanelService_DeleteAllocation_FullMethodName         = "/plugins.PanelService/DeleteAllocation"
 	PanelService_SetPrimaryAllocation_FullMethodName     = "/plugins.PanelService/SetPrimaryAllocation"
+	PanelService_ListAllocations_FullMethodName          = "/plugins.PanelService/ListAllocations"
+	PanelService_CreateAllocations_FullMethodName        = "/plugins.PanelService/CreateAllocations"
+	PanelService_AssignAllocation_FullMethodName         = "/plugins.PanelService/AssignAllocation"
+	PanelService_ReleaseAllocation_FullMethodName        = "/plugins.PanelService/ReleaseAllocation"
+	PanelService_ReserveAllocation_FullMethodName        = "/plugins.PanelService/ReserveAllocation"
+	PanelService_ListServerSchedules_FullMethodName      = "/plugins.PanelService/ListServerSchedules"
+	PanelService_CreateServerSchedule_FullMethodName     = "/plugins.PanelService/CreateServerSchedule"
+	PanelService_DeleteServerSchedule_FullMethodName     = "/plugins.PanelService/DeleteServerSchedule"
+	PanelService_AddScheduleTask_FullMethodName          = "/plugins.PanelService/AddScheduleTask"
+	PanelService_DeleteScheduleTask_FullMethodName       = "/plugins.PanelService/DeleteScheduleTask"
 	PanelService_UpdateServerVariables_FullMethodName    = "/plugins.PanelService/UpdateServerVariables"
 	PanelService_GetUser_FullMethodName                  = "/plugins.PanelService/GetUser"
 	PanelService_GetUserByEmail_FullMethodName           = "/plugins.PanelService/GetUserByEmail"
@@ -354,6 +365,7 @@ const (
 	PanelService_AddSubuser_FullMethodName               = "/plugins.PanelService/AddSubuser"
 	PanelService_UpdateSubuser_FullMethodName            = "/plugins.PanelService/UpdateSubuser"
 	PanelService_RemoveSubuser_FullMethodName            = "/plugins.PanelService/RemoveSubuser"
+	PanelService_ListSubuserPermissions_FullMethodName   = "/plugins.PanelService/ListSubuserPermissions"
 	PanelService_ListDatabases_FullMethodName            = "/plugins.PanelService/ListDatabases"
 	PanelService_CreateDatabase_FullMethodName           = "/plugins.PanelService/CreateDatabase"
 	PanelService_DeleteDatabase_FullMethodName           = "/plugins.PanelService/DeleteDatabase"
@@ -374,11 +386,15 @@ const (
 	PanelService_ListBackups_FullMethodName              = "/plugins.PanelService/ListBackups"
 	PanelService_CreateBackup_FullMethodName             = "/plugins.PanelService/CreateBackup"
 	PanelService_DeleteBackup_FullMethodName             = "/plugins.PanelService/DeleteBackup"
+	PanelService_GetBackup_FullMethodName                = "/plugins.PanelService/GetBackup"
+	PanelService_RestoreBackup_FullMethodName            = "/plugins.PanelService/RestoreBackup"
 	PanelService_ListNodes_FullMethodName                = "/plugins.PanelService/ListNodes"
 	PanelService_GetNode_FullMethodName                  = "/plugins.PanelService/GetNode"
 	PanelService_CreateNode_FullMethodName               = "/plugins.PanelService/CreateNode"
 	PanelService_DeleteNode_FullMethodName               = "/plugins.PanelService/DeleteNode"
 	PanelService_ResetNodeToken_FullMethodName           = "/plugins.PanelService/ResetNodeToken"
+	PanelService_GetNodeStats_FullMethodName             = "/plugins.PanelService/GetNodeStats"
+	PanelService_ListNodeStats_FullMethodName            = "/plugins.PanelService/ListNodeStats"
 	PanelService_ListPackages_FullMethodName             = "/plugins.PanelService/ListPackages"
 	PanelService_GetPackage_FullMethodName               = "/plugins.PanelService/GetPackage"
 	PanelService_CreatePackage_FullMethodName            = "/plugins.PanelService/CreatePackage"
@@ -391,15 +407,26 @@ const (
 	PanelService_SetRegistrationEnabled_FullMethodName   = "/plugins.PanelService/SetRegistrationEnabled"
 	PanelService_SetServerCreationEnabled_FullMethodName = "/plugins.PanelService/SetServerCreationEnabled"
 	PanelService_GetActivityLogs_FullMethodName          = "/plugins.PanelService/GetActivityLogs"
+	PanelService_WriteAudit_FullMethodName               = "/plugins.PanelService/WriteAudit"
 	PanelService_Log_FullMethodName                      = "/plugins.PanelService/Log"
 	PanelService_GetKV_FullMethodName                    = "/plugins.PanelService/GetKV"
 	PanelService_SetKV_FullMethodName                    = "/plugins.PanelService/SetKV"
 	PanelService_DeleteKV_FullMethodName                 = "/plugins.PanelService/DeleteKV"
+	PanelService_ListKV_FullMethodName                   = "/plugins.PanelService/ListKV"
+	PanelService_CompareAndSwapKV_FullMethodName         = "/plugins.PanelService/CompareAndSwapKV"
+	PanelService_GetServerSettings_FullMethodName        = "/plugins.PanelService/GetServerSettings"
+	PanelService_SetServerSettings_FullMethodName        = "/plugins.PanelService/SetServerSettings"
+	PanelService_WatchServerSettings_FullMethodName      = "/plugins.PanelService/WatchServerSettings"
 	PanelService_QueryDB_FullMethodName                  = "/plugins.PanelService/QueryDB"
 	PanelService_BroadcastEvent_FullMethodName           = "/plugins.PanelService/BroadcastEvent"
 	PanelService_SendNotification_FullMethodName         = "/plugins.PanelService/SendNotification"
+	PanelService_ReportError_FullMethodName              = "/plugins.PanelService/ReportError"
+	PanelService_SendEmail_FullMethodName                = "/plugins.PanelService/SendEmail"
 	PanelService_HTTPRequest_FullMethodName              = "/plugins.PanelService/HTTPRequest"
 	PanelService_CallPlugin_FullMethodName               = "/plugins.PanelService/CallPlugin"
+	PanelService_CallPluginRoute_FullMethodName          = "/plugins.PanelService/CallPluginRoute"
+	PanelService_ListPlugins_FullMethodName              = "/plugins.PanelService/ListPlugins"
+	PanelService_UploadBundle_FullMethodName             = "/plugins.PanelService/UploadBundle"
 )
 
 // PanelServiceClient is the client API for PanelService service.
@@ -411,7 +438,7 @@ type PanelServiceClient interface {
 	GetServer(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Server, error)
 	ListServers(ctx context.Context, in *ListServersRequest, opts ...grpc.CallOption) (*ListServersResponse, error)
 	CreateServer(ctx context.Context, in *CreateServerRequest, opts ...grpc.CallOption) (*Server, error)
-	DeleteServer(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteServer(ctx context.Context, in *DeleteServerRequest, opts ...grpc.CallOption) (*Empty, error)
 	UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*Server, error)
 	SuspendServer(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error)
 	UnsuspendServer(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error)
@@ -425,6 +452,7 @@ type PanelServiceClient interface {
 	GetConsoleLog(ctx context.Context, in *ConsoleLogRequest, opts ...grpc.CallOption) (*ConsoleLogResponse, error)
 	SendCommand(ctx context.Context, in *SendCommandRequest, opts ...grpc.CallOption) (*Empty, error)
 	StreamConsole(ctx context.Context, in *StreamConsoleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConsoleLine], error)
+	StreamStatus(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServerStatusChange], error)
 	GetFullLog(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*FullLogResponse, error)
 	SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (*SearchLogsResponse, error)
 	ListLogFiles(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*LogFilesResponse, error)
@@ -435,6 +463,18 @@ type PanelServiceClient interface {
 	AddAllocation(ctx context.Context, in *AllocationRequest, opts ...grpc.CallOption) (*Empty, error)
 	DeleteAllocation(ctx context.Context, in *AllocationRequest, opts ...grpc.CallOption) (*Empty, error)
 	SetPrimaryAllocation(ctx context.Context, in *AllocationRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Node Allocations
+	ListAllocations(ctx context.Context, in *ListAllocationsRequest, opts ...grpc.CallOption) (*ListAllocationsResponse, error)
+	CreateAllocations(ctx context.Context, in *CreateAllocationsRequest, opts ...grpc.CallOption) (*Empty, error)
+	AssignAllocation(ctx context.Context, in *AssignAllocationRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReleaseAllocation(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReserveAllocation(ctx context.Context, in *ReserveAllocationRequest, opts ...grpc.CallOption) (*Allocation, error)
+	// Server Schedules
+	ListServerSchedules(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ListServerSchedulesResponse, error)
+	CreateServerSchedule(ctx context.Context, in *CreateServerScheduleRequest, opts ...grpc.CallOption) (*ServerSchedule, error)
+	DeleteServerSchedule(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error)
+	AddScheduleTask(ctx context.Context, in *AddScheduleTaskRequest, opts ...grpc.CallOption) (*ScheduleTask, error)
+	DeleteScheduleTask(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Server Variables
 	UpdateServerVariables(ctx context.Context, in *UpdateVariablesRequest, opts ...grpc.CallOption) (*Empty, error)
 	// User
@@ -456,6 +496,7 @@ type PanelServiceClient interface {
 	AddSubuser(ctx context.Context, in *AddSubuserRequest, opts ...grpc.CallOption) (*Subuser, error)
 	UpdateSubuser(ctx context.Context, in *UpdateSubuserRequest, opts ...grpc.CallOption) (*Empty, error)
 	RemoveSubuser(ctx context.Context, in *RemoveSubuserRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListSubuserPermissions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSubuserPermissionsResponse, error)
 	// Database
 	ListDatabases(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
 	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*Database, error)
@@ -478,14 +519,18 @@ type PanelServiceClient interface {
 	DecompressFile(ctx context.Context, in *FilePathRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Backups
 	ListBackups(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
-	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Backup, error)
 	DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Backup, error)
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Nodes
 	ListNodes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListNodesResponse, error)
 	GetNode(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Node, error)
 	CreateNode(ctx context.Context, in *CreateNodeRequest, opts ...grpc.CallOption) (*NodeWithToken, error)
 	DeleteNode(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error)
 	ResetNodeToken(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*NodeToken, error)
+	GetNodeStats(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*NodeStats, error)
+	ListNodeStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListNodeStatsResponse, error)
 	// Packages
 	ListPackages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPackagesResponse, error)
 	GetPackage(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Package, error)
@@ -502,18 +547,30 @@ type PanelServiceClient interface {
 	SetServerCreationEnabled(ctx context.Context, in *BoolRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Activity Logs
 	GetActivityLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	WriteAudit(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Utility
 	Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetKV(ctx context.Context, in *KVRequest, opts ...grpc.CallOption) (*KVResponse, error)
 	SetKV(ctx context.Context, in *KVSetRequest, opts ...grpc.CallOption) (*Empty, error)
 	DeleteKV(ctx context.Context, in *KVRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListKV(ctx context.Context, in *KVListRequest, opts ...grpc.CallOption) (*KVListResponse, error)
+	CompareAndSwapKV(ctx context.Context, in *KVCompareAndSwapRequest, opts ...grpc.CallOption) (*KVCompareAndSwapResponse, error)
+	GetServerSettings(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ServerSettings, error)
+	SetServerSettings(ctx context.Context, in *SetServerSettingsRequest, opts ...grpc.CallOption) (*Empty, error)
+	WatchServerSettings(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServerSettingsChange], error)
 	QueryDB(ctx context.Context, in *QueryDBRequest, opts ...grpc.CallOption) (*QueryDBResponse, error)
 	BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*Empty, error)
 	SendNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReportError(ctx context.Context, in *ErrorReport, opts ...grpc.CallOption) (*Empty, error)
+	SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*Empty, error)
 	// HTTP Client (for external APIs)
 	HTTPRequest(ctx context.Context, in *PluginHTTPRequest, opts ...grpc.CallOption) (*PluginHTTPResponse, error)
 	// Inter-plugin communication
 	CallPlugin(ctx context.Context, in *CallPluginRequest, opts ...grpc.CallOption) (*CallPluginResponse, error)
+	CallPluginRoute(ctx context.Context, in *PluginRouteRequest, opts ...grpc.CallOption) (*HTTPResponse, error)
+	ListPlugins(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPluginsResponse, error)
+	// UI bundles
+	UploadBundle(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BundleChunk, BundleUploadResult], error)
 }
 
 type panelServiceClient struct {
@@ -567,7 +624,7 @@ func (c *panelServiceClient) CreateServer(ctx context.Context, in *CreateServerR
 	return out, nil
 }
 
-func (c *panelServiceClient) DeleteServer(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *panelServiceClient) DeleteServer(ctx context.Context, in *DeleteServerRequest, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, PanelService_DeleteServer_FullMethodName, in, out, cOpts...)
@@ -706,6 +763,25 @@ func (c *panelServiceClient) StreamConsole(ctx context.Context, in *StreamConsol
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type PanelService_StreamConsoleClient = grpc.ServerStreamingClient[ConsoleLine]
 
+func (c *panelServiceClient) StreamStatus(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServerStatusChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PanelService_ServiceDesc.Streams[2], PanelService_StreamStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IDRequest, ServerStatusChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PanelService_StreamStatusClient = grpc.ServerStreamingClient[ServerStatusChange]
+
 func (c *panelServiceClient) GetFullLog(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*FullLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FullLogResponse)
@@ -786,6 +862,106 @@ func (c *panelServiceClient) SetPrimaryAllocation(ctx context.Context, in *Alloc
 	return out, nil
 }
 
+func (c *panelServiceClient) ListAllocations(ctx context.Context, in *ListAllocationsRequest, opts ...grpc.CallOption) (*ListAllocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllocationsResponse)
+	err := c.cc.Invoke(ctx, PanelService_ListAllocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) CreateAllocations(ctx context.Context, in *CreateAllocationsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_CreateAllocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) AssignAllocation(ctx context.Context, in *AssignAllocationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_AssignAllocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) ReleaseAllocation(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_ReleaseAllocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) ReserveAllocation(ctx context.Context, in *ReserveAllocationRequest, opts ...grpc.CallOption) (*Allocation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Allocation)
+	err := c.cc.Invoke(ctx, PanelService_ReserveAllocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) ListServerSchedules(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ListServerSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServerSchedulesResponse)
+	err := c.cc.Invoke(ctx, PanelService_ListServerSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) CreateServerSchedule(ctx context.Context, in *CreateServerScheduleRequest, opts ...grpc.CallOption) (*ServerSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerSchedule)
+	err := c.cc.Invoke(ctx, PanelService_CreateServerSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) DeleteServerSchedule(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_DeleteServerSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) AddScheduleTask(ctx context.Context, in *AddScheduleTaskRequest, opts ...grpc.CallOption) (*ScheduleTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleTask)
+	err := c.cc.Invoke(ctx, PanelService_AddScheduleTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) DeleteScheduleTask(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_DeleteScheduleTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *panelServiceClient) UpdateServerVariables(ctx context.Context, in *UpdateVariablesRequest, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
@@ -966,6 +1142,16 @@ func (c *panelServiceClient) RemoveSubuser(ctx context.Context, in *RemoveSubuse
 	return out, nil
 }
 
+func (c *panelServiceClient) ListSubuserPermissions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSubuserPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubuserPermissionsResponse)
+	err := c.cc.Invoke(ctx, PanelService_ListSubuserPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *panelServiceClient) ListDatabases(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListDatabasesResponse)
@@ -1146,9 +1332,9 @@ func (c *panelServiceClient) ListBackups(ctx context.Context, in *IDRequest, opt
 	return out, nil
 }
 
-func (c *panelServiceClient) CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *panelServiceClient) CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Backup, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Empty)
+	out := new(Backup)
 	err := c.cc.Invoke(ctx, PanelService_CreateBackup_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -1166,6 +1352,26 @@ func (c *panelServiceClient) DeleteBackup(ctx context.Context, in *DeleteBackupR
 	return out, nil
 }
 
+func (c *panelServiceClient) GetBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Backup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backup)
+	err := c.cc.Invoke(ctx, PanelService_GetBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_RestoreBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *panelServiceClient) ListNodes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListNodesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListNodesResponse)
@@ -1216,6 +1422,26 @@ func (c *panelServiceClient) ResetNodeToken(ctx context.Context, in *IDRequest,
 	return out, nil
 }
 
+func (c *panelServiceClient) GetNodeStats(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*NodeStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeStats)
+	err := c.cc.Invoke(ctx, PanelService_GetNodeStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) ListNodeStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListNodeStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodeStatsResponse)
+	err := c.cc.Invoke(ctx, PanelService_ListNodeStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *panelServiceClient) ListPackages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPackagesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPackagesResponse)
@@ -1336,6 +1562,16 @@ func (c *panelServiceClient) GetActivityLogs(ctx context.Context, in *GetLogsReq
 	return out, nil
 }
 
+func (c *panelServiceClient) WriteAudit(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_WriteAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *panelServiceClient) Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
@@ -1376,6 +1612,65 @@ func (c *panelServiceClient) DeleteKV(ctx context.Context, in *KVRequest, opts .
 	return out, nil
 }
 
+func (c *panelServiceClient) ListKV(ctx context.Context, in *KVListRequest, opts ...grpc.CallOption) (*KVListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVListResponse)
+	err := c.cc.Invoke(ctx, PanelService_ListKV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) CompareAndSwapKV(ctx context.Context, in *KVCompareAndSwapRequest, opts ...grpc.CallOption) (*KVCompareAndSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KVCompareAndSwapResponse)
+	err := c.cc.Invoke(ctx, PanelService_CompareAndSwapKV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) GetServerSettings(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ServerSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerSettings)
+	err := c.cc.Invoke(ctx, PanelService_GetServerSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) SetServerSettings(ctx context.Context, in *SetServerSettingsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_SetServerSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) WatchServerSettings(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServerSettingsChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PanelService_ServiceDesc.Streams[3], PanelService_WatchServerSettings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IDRequest, ServerSettingsChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PanelService_WatchServerSettingsClient = grpc.ServerStreamingClient[ServerSettingsChange]
+
 func (c *panelServiceClient) QueryDB(ctx context.Context, in *QueryDBRequest, opts ...grpc.CallOption) (*QueryDBResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryDBResponse)
@@ -1406,6 +1701,26 @@ func (c *panelServiceClient) SendNotification(ctx context.Context, in *Notificat
 	return out, nil
 }
 
+func (c *panelServiceClient) ReportError(ctx context.Context, in *ErrorReport, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_ReportError_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PanelService_SendEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *panelServiceClient) HTTPRequest(ctx context.Context, in *PluginHTTPRequest, opts ...grpc.CallOption) (*PluginHTTPResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PluginHTTPResponse)
@@ -1426,6 +1741,39 @@ func (c *panelServiceClient) CallPlugin(ctx context.Context, in *CallPluginReque
 	return out, nil
 }
 
+func (c *panelServiceClient) CallPluginRoute(ctx context.Context, in *PluginRouteRequest, opts ...grpc.CallOption) (*HTTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HTTPResponse)
+	err := c.cc.Invoke(ctx, PanelService_CallPluginRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) ListPlugins(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPluginsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPluginsResponse)
+	err := c.cc.Invoke(ctx, PanelService_ListPlugins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) UploadBundle(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BundleChunk, BundleUploadResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PanelService_ServiceDesc.Streams[4], PanelService_UploadBundle_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BundleChunk, BundleUploadResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PanelService_UploadBundleClient = grpc.ClientStreamingClient[BundleChunk, BundleUploadResult]
+
 // PanelServiceServer is the server API for PanelService service.
 // All implementations must embed UnimplementedPanelServiceServer
 // for forward compatibility.
@@ -1435,7 +1783,7 @@ type PanelServiceServer interface {
 	GetServer(context.Context, *IDRequest) (*Server, error)
 	ListServers(context.Context, *ListServersRequest) (*ListServersResponse, error)
 	CreateServer(context.Context, *CreateServerRequest) (*Server, error)
-	DeleteServer(context.Context, *IDRequest) (*Empty, error)
+	DeleteServer(context.Context, *DeleteServerRequest) (*Empty, error)
 	UpdateServer(context.Context, *UpdateServerRequest) (*Server, error)
 	SuspendServer(context.Context, *IDRequest) (*Empty, error)
 	UnsuspendServer(context.Context, *IDRequest) (*Empty, error)
@@ -1449,6 +1797,7 @@ type PanelServiceServer interface {
 	GetConsoleLog(context.Context, *ConsoleLogRequest) (*ConsoleLogResponse, error)
 	SendCommand(context.Context, *SendCommandRequest) (*Empty, error)
 	StreamConsole(*StreamConsoleRequest, grpc.ServerStreamingServer[ConsoleLine]) error
+	StreamStatus(*IDRequest, grpc.ServerStreamingServer[ServerStatusChange]) error
 	GetFullLog(context.Context, *IDRequest) (*FullLogResponse, error)
 	SearchLogs(context.Context, *SearchLogsRequest) (*SearchLogsResponse, error)
 	ListLogFiles(context.Context, *IDRequest) (*LogFilesResponse, error)
@@ -1459,6 +1808,18 @@ type PanelServiceServer interface {
 	AddAllocation(context.Context, *AllocationRequest) (*Empty, error)
 	DeleteAllocation(context.Context, *AllocationRequest) (*Empty, error)
 	SetPrimaryAllocation(context.Context, *AllocationRequest) (*Empty, error)
+	// Node Allocations
+	ListAllocations(context.Context, *ListAllocationsRequest) (*ListAllocationsResponse, error)
+	CreateAllocations(context.Context, *CreateAllocationsRequest) (*Empty, error)
+	AssignAllocation(context.Context, *AssignAllocationRequest) (*Empty, error)
+	ReleaseAllocation(context.Context, *IDRequest) (*Empty, error)
+	ReserveAllocation(context.Context, *ReserveAllocationRequest) (*Allocation, error)
+	// Server Schedules
+	ListServerSchedules(context.Context, *IDRequest) (*ListServerSchedulesResponse, error)
+	CreateServerSchedule(context.Context, *CreateServerScheduleRequest) (*ServerSchedule, error)
+	DeleteServerSchedule(context.Context, *IDRequest) (*Empty, error)
+	AddScheduleTask(context.Context, *AddScheduleTaskRequest) (*ScheduleTask, error)
+	DeleteScheduleTask(context.Context, *IDRequest) (*Empty, error)
 	// Server Variables
 	UpdateServerVariables(context.Context, *UpdateVariablesRequest) (*Empty, error)
 	// User
@@ -1480,6 +1841,7 @@ type PanelServiceServer interface {
 	AddSubuser(context.Context, *AddSubuserRequest) (*Subuser, error)
 	UpdateSubuser(context.Context, *UpdateSubuserRequest) (*Empty, error)
 	RemoveSubuser(context.Context, *RemoveSubuserRequest) (*Empty, error)
+	ListSubuserPermissions(context.Context, *Empty) (*ListSubuserPermissionsResponse, error)
 	// Database
 	ListDatabases(context.Context, *IDRequest) (*ListDatabasesResponse, error)
 	CreateDatabase(context.Context, *CreateDatabaseRequest) (*Database, error)
@@ -1502,14 +1864,18 @@ type PanelServiceServer interface {
 	DecompressFile(context.Context, *FilePathRequest) (*Empty, error)
 	// Backups
 	ListBackups(context.Context, *IDRequest) (*ListBackupsResponse, error)
-	CreateBackup(context.Context, *CreateBackupRequest) (*Empty, error)
+	CreateBackup(context.Context, *CreateBackupRequest) (*Backup, error)
 	DeleteBackup(context.Context, *DeleteBackupRequest) (*Empty, error)
+	GetBackup(context.Context, *BackupRequest) (*Backup, error)
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*Empty, error)
 	// Nodes
 	ListNodes(context.Context, *Empty) (*ListNodesResponse, error)
 	GetNode(context.Context, *IDRequest) (*Node, error)
 	CreateNode(context.Context, *CreateNodeRequest) (*NodeWithToken, error)
 	DeleteNode(context.Context, *IDRequest) (*Empty, error)
 	ResetNodeToken(context.Context, *IDRequest) (*NodeToken, error)
+	GetNodeStats(context.Context, *IDRequest) (*NodeStats, error)
+	ListNodeStats(context.Context, *Empty) (*ListNodeStatsResponse, error)
 	// Packages
 	ListPackages(context.Context, *Empty) (*ListPackagesResponse, error)
 	GetPackage(context.Context, *IDRequest) (*Package, error)
@@ -1526,18 +1892,30 @@ type PanelServiceServer interface {
 	SetServerCreationEnabled(context.Context, *BoolRequest) (*Empty, error)
 	// Activity Logs
 	GetActivityLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	WriteAudit(context.Context, *AuditRequest) (*Empty, error)
 	// Utility
 	Log(context.Context, *LogRequest) (*Empty, error)
 	GetKV(context.Context, *KVRequest) (*KVResponse, error)
 	SetKV(context.Context, *KVSetRequest) (*Empty, error)
 	DeleteKV(context.Context, *KVRequest) (*Empty, error)
+	ListKV(context.Context, *KVListRequest) (*KVListResponse, error)
+	CompareAndSwapKV(context.Context, *KVCompareAndSwapRequest) (*KVCompareAndSwapResponse, error)
+	GetServerSettings(context.Context, *IDRequest) (*ServerSettings, error)
+	SetServerSettings(context.Context, *SetServerSettingsRequest) (*Empty, error)
+	WatchServerSettings(*IDRequest, grpc.ServerStreamingServer[ServerSettingsChange]) error
 	QueryDB(context.Context, *QueryDBRequest) (*QueryDBResponse, error)
 	BroadcastEvent(context.Context, *BroadcastEventRequest) (*Empty, error)
 	SendNotification(context.Context, *NotificationRequest) (*Empty, error)
+	ReportError(context.Context, *ErrorReport) (*Empty, error)
+	SendEmail(context.Context, *SendEmailRequest) (*Empty, error)
 	// HTTP Client (for external APIs)
 	HTTPRequest(context.Context, *PluginHTTPRequest) (*PluginHTTPResponse, error)
 	// Inter-plugin communication
 	CallPlugin(context.Context, *CallPluginRequest) (*CallPluginResponse, error)
+	CallPluginRoute(context.Context, *PluginRouteRequest) (*HTTPResponse, error)
+	ListPlugins(context.Context, *Empty) (*ListPluginsResponse, error)
+	// UI bundles
+	UploadBundle(grpc.ClientStreamingServer[BundleChunk, BundleUploadResult]) error
 	mustEmbedUnimplementedPanelServiceServer()
 }
 
@@ -1560,7 +1938,7 @@ func (UnimplementedPanelServiceServer) ListServers(context.Context, *ListServers
 func (UnimplementedPanelServiceServer) CreateServer(context.Context, *CreateServerRequest) (*Server, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateServer not implemented")
 }
-func (UnimplementedPanelServiceServer) DeleteServer(context.Context, *IDRequest) (*Empty, error) {
+func (UnimplementedPanelServiceServer) DeleteServer(context.Context, *DeleteServerRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteServer not implemented")
 }
 func (UnimplementedPanelServiceServer) UpdateServer(context.Context, *UpdateServerRequest) (*Server, error) {
@@ -1599,6 +1977,9 @@ func (UnimplementedPanelServiceServer) SendCommand(context.Context, *SendCommand
 func (UnimplementedPanelServiceServer) StreamConsole(*StreamConsoleRequest, grpc.ServerStreamingServer[ConsoleLine]) error {
 	return status.Error(codes.Unimplemented, "method StreamConsole not implemented")
 }
+func (UnimplementedPanelServiceServer) StreamStatus(*IDRequest, grpc.ServerStreamingServer[ServerStatusChange]) error {
+	return status.Error(codes.Unimplemented, "method StreamStatus not implemented")
+}
 func (UnimplementedPanelServiceServer) GetFullLog(context.Context, *IDRequest) (*FullLogResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFullLog not implemented")
 }
@@ -1623,6 +2004,36 @@ func (UnimplementedPanelServiceServer) DeleteAllocation(context.Context, *Alloca
 func (UnimplementedPanelServiceServer) SetPrimaryAllocation(context.Context, *AllocationRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetPrimaryAllocation not implemented")
 }
+func (UnimplementedPanelServiceServer) ListAllocations(context.Context, *ListAllocationsRequest) (*ListAllocationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAllocations not implemented")
+}
+func (UnimplementedPanelServiceServer) CreateAllocations(context.Context, *CreateAllocationsRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAllocations not implemented")
+}
+func (UnimplementedPanelServiceServer) AssignAllocation(context.Context, *AssignAllocationRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignAllocation not implemented")
+}
+func (UnimplementedPanelServiceServer) ReleaseAllocation(context.Context, *IDRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseAllocation not implemented")
+}
+func (UnimplementedPanelServiceServer) ReserveAllocation(context.Context, *ReserveAllocationRequest) (*Allocation, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveAllocation not implemented")
+}
+func (UnimplementedPanelServiceServer) ListServerSchedules(context.Context, *IDRequest) (*ListServerSchedulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListServerSchedules not implemented")
+}
+func (UnimplementedPanelServiceServer) CreateServerSchedule(context.Context, *CreateServerScheduleRequest) (*ServerSchedule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateServerSchedule not implemented")
+}
+func (UnimplementedPanelServiceServer) DeleteServerSchedule(context.Context, *IDRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteServerSchedule not implemented")
+}
+func (UnimplementedPanelServiceServer) AddScheduleTask(context.Context, *AddScheduleTaskRequest) (*ScheduleTask, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddScheduleTask not implemented")
+}
+func (UnimplementedPanelServiceServer) DeleteScheduleTask(context.Context, *IDRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteScheduleTask not implemented")
+}
 func (UnimplementedPanelServiceServer) UpdateServerVariables(context.Context, *UpdateVariablesRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateServerVariables not implemented")
 }
@@ -1677,6 +2088,9 @@ func (UnimplementedPanelServiceServer) UpdateSubuser(context.Context, *UpdateSub
 func (UnimplementedPanelServiceServer) RemoveSubuser(context.Context, *RemoveSubuserRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveSubuser not implemented")
 }
+func (UnimplementedPanelServiceServer) ListSubuserPermissions(context.Context, *Empty) (*ListSubuserPermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSubuserPermissions not implemented")
+}
 func (UnimplementedPanelServiceServer) ListDatabases(context.Context, *IDRequest) (*ListDatabasesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListDatabases not implemented")
 }
@@ -1731,12 +2145,18 @@ func (UnimplementedPanelServiceServer) DecompressFile(context.Context, *FilePath
 func (UnimplementedPanelServiceServer) ListBackups(context.Context, *IDRequest) (*ListBackupsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListBackups not implemented")
 }
-func (UnimplementedPanelServiceServer) CreateBackup(context.Context, *CreateBackupRequest) (*Empty, error) {
+func (UnimplementedPanelServiceServer) CreateBackup(context.Context, *CreateBackupRequest) (*Backup, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateBackup not implemented")
 }
 func (UnimplementedPanelServiceServer) DeleteBackup(context.Context, *DeleteBackupRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteBackup not implemented")
 }
+func (UnimplementedPanelServiceServer) GetBackup(context.Context, *BackupRequest) (*Backup, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBackup not implemented")
+}
+func (UnimplementedPanelServiceServer) RestoreBackup(context.Context, *RestoreBackupRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreBackup not implemented")
+}
 func (UnimplementedPanelServiceServer) ListNodes(context.Context, *Empty) (*ListNodesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListNodes not implemented")
 }
@@ -1752,6 +2172,12 @@ func (UnimplementedPanelServiceServer) DeleteNode(context.Context, *IDRequest) (
 func (UnimplementedPanelServiceServer) ResetNodeToken(context.Context, *IDRequest) (*NodeToken, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetNodeToken not implemented")
 }
+func (UnimplementedPanelServiceServer) GetNodeStats(context.Context, *IDRequest) (*NodeStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNodeStats not implemented")
+}
+func (UnimplementedPanelServiceServer) ListNodeStats(context.Context, *Empty) (*ListNodeStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNodeStats not implemented")
+}
 func (UnimplementedPanelServiceServer) ListPackages(context.Context, *Empty) (*ListPackagesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPackages not implemented")
 }
@@ -1788,6 +2214,9 @@ func (UnimplementedPanelServiceServer) SetServerCreationEnabled(context.Context,
 func (UnimplementedPanelServiceServer) GetActivityLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetActivityLogs not implemented")
 }
+func (UnimplementedPanelServiceServer) WriteAudit(context.Context, *AuditRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method WriteAudit not implemented")
+}
 func (UnimplementedPanelServiceServer) Log(context.Context, *LogRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Log not implemented")
 }
@@ -1800,6 +2229,21 @@ func (UnimplementedPanelServiceServer) SetKV(context.Context, *KVSetRequest) (*E
 func (UnimplementedPanelServiceServer) DeleteKV(context.Context, *KVRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteKV not implemented")
 }
+func (UnimplementedPanelServiceServer) ListKV(context.Context, *KVListRequest) (*KVListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListKV not implemented")
+}
+func (UnimplementedPanelServiceServer) CompareAndSwapKV(context.Context, *KVCompareAndSwapRequest) (*KVCompareAndSwapResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareAndSwapKV not implemented")
+}
+func (UnimplementedPanelServiceServer) GetServerSettings(context.Context, *IDRequest) (*ServerSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServerSettings not implemented")
+}
+func (UnimplementedPanelServiceServer) SetServerSettings(context.Context, *SetServerSettingsRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetServerSettings not implemented")
+}
+func (UnimplementedPanelServiceServer) WatchServerSettings(*IDRequest, grpc.ServerStreamingServer[ServerSettingsChange]) error {
+	return status.Error(codes.Unimplemented, "method WatchServerSettings not implemented")
+}
 func (UnimplementedPanelServiceServer) QueryDB(context.Context, *QueryDBRequest) (*QueryDBResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryDB not implemented")
 }
@@ -1809,12 +2253,27 @@ func (UnimplementedPanelServiceServer) BroadcastEvent(context.Context, *Broadcas
 func (UnimplementedPanelServiceServer) SendNotification(context.Context, *NotificationRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendNotification not implemented")
 }
+func (UnimplementedPanelServiceServer) ReportError(context.Context, *ErrorReport) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportError not implemented")
+}
+func (UnimplementedPanelServiceServer) SendEmail(context.Context, *SendEmailRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendEmail not implemented")
+}
 func (UnimplementedPanelServiceServer) HTTPRequest(context.Context, *PluginHTTPRequest) (*PluginHTTPResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method HTTPRequest not implemented")
 }
 func (UnimplementedPanelServiceServer) CallPlugin(context.Context, *CallPluginRequest) (*CallPluginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CallPlugin not implemented")
 }
+func (UnimplementedPanelServiceServer) CallPluginRoute(context.Context, *PluginRouteRequest) (*HTTPResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CallPluginRoute not implemented")
+}
+func (UnimplementedPanelServiceServer) ListPlugins(context.Context, *Empty) (*ListPluginsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlugins not implemented")
+}
+func (UnimplementedPanelServiceServer) UploadBundle(grpc.ClientStreamingServer[BundleChunk, BundleUploadResult]) error {
+	return status.Error(codes.Unimplemented, "method UploadBundle not implemented")
+}
 func (UnimplementedPanelServiceServer) mustEmbedUnimplementedPanelServiceServer() {}
 func (UnimplementedPanelServiceServer) testEmbeddedByValue()                      {}
 
@@ -1898,7 +2357,7 @@ func _PanelService_CreateServer_Handler(srv interface{}, ctx context.Context, de
 }
 
 func _PanelService_DeleteServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDRequest)
+	in := new(DeleteServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1910,7 +2369,7 @@ func _PanelService_DeleteServer_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: PanelService_DeleteServer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PanelServiceServer).DeleteServer(ctx, req.(*IDRequest))
+		return srv.(PanelServiceServer).DeleteServer(ctx, req.(*DeleteServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2124,6 +2583,17 @@ func _PanelService_StreamConsole_Handler(srv interface{}, stream grpc.ServerStre
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type PanelService_StreamConsoleServer = grpc.ServerStreamingServer[ConsoleLine]
 
+func _PanelService_StreamStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IDRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PanelServiceServer).StreamStatus(m, &grpc.GenericServerStream[IDRequest, ServerStatusChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PanelService_StreamStatusServer = grpc.ServerStreamingServer[ServerStatusChange]
+
 func _PanelService_GetFullLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IDRequest)
 	if err := dec(in); err != nil {
@@ -2268,8 +2738,188 @@ func _PanelService_SetPrimaryAllocation_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PanelService_UpdateServerVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVariablesRequest)
+func _PanelService_ListAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ListAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ListAllocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ListAllocations(ctx, req.(*ListAllocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_CreateAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAllocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).CreateAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_CreateAllocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).CreateAllocations(ctx, req.(*CreateAllocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_AssignAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).AssignAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_AssignAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).AssignAllocation(ctx, req.(*AssignAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_ReleaseAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ReleaseAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ReleaseAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ReleaseAllocation(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_ReserveAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ReserveAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ReserveAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ReserveAllocation(ctx, req.(*ReserveAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_ListServerSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ListServerSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ListServerSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ListServerSchedules(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_CreateServerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).CreateServerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_CreateServerSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).CreateServerSchedule(ctx, req.(*CreateServerScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_DeleteServerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).DeleteServerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_DeleteServerSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).DeleteServerSchedule(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_AddScheduleTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScheduleTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).AddScheduleTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_AddScheduleTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).AddScheduleTask(ctx, req.(*AddScheduleTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_DeleteScheduleTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).DeleteScheduleTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_DeleteScheduleTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).DeleteScheduleTask(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_UpdateServerVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariablesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2592,6 +3242,24 @@ func _PanelService_RemoveSubuser_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_ListSubuserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ListSubuserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ListSubuserPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ListSubuserPermissions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PanelService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IDRequest)
 	if err := dec(in); err != nil {
@@ -2952,6 +3620,42 @@ func _PanelService_DeleteBackup_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_GetBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).GetBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_GetBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).GetBackup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_RestoreBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).RestoreBackup(ctx, req.(*RestoreBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PanelService_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -3042,6 +3746,42 @@ func _PanelService_ResetNodeToken_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_GetNodeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).GetNodeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_GetNodeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).GetNodeStats(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_ListNodeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ListNodeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ListNodeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ListNodeStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PanelService_ListPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -3258,6 +3998,24 @@ func _PanelService_GetActivityLogs_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_WriteAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).WriteAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_WriteAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).WriteAudit(ctx, req.(*AuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PanelService_Log_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogRequest)
 	if err := dec(in); err != nil {
@@ -3330,6 +4088,89 @@ func _PanelService_DeleteKV_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_ListKV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ListKV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ListKV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ListKV(ctx, req.(*KVListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_CompareAndSwapKV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVCompareAndSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).CompareAndSwapKV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_CompareAndSwapKV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).CompareAndSwapKV(ctx, req.(*KVCompareAndSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_GetServerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).GetServerSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_GetServerSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).GetServerSettings(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_SetServerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServerSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).SetServerSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_SetServerSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).SetServerSettings(ctx, req.(*SetServerSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_WatchServerSettings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IDRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PanelServiceServer).WatchServerSettings(m, &grpc.GenericServerStream[IDRequest, ServerSettingsChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PanelService_WatchServerSettingsServer = grpc.ServerStreamingServer[ServerSettingsChange]
+
 func _PanelService_QueryDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDBRequest)
 	if err := dec(in); err != nil {
@@ -3384,6 +4225,42 @@ func _PanelService_SendNotification_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_ReportError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ErrorReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ReportError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ReportError_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ReportError(ctx, req.(*ErrorReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_SendEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).SendEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_SendEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).SendEmail(ctx, req.(*SendEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PanelService_HTTPRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PluginHTTPRequest)
 	if err := dec(in); err != nil {
@@ -3420,6 +4297,49 @@ func _PanelService_CallPlugin_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_CallPluginRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).CallPluginRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_CallPluginRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).CallPluginRoute(ctx, req.(*PluginRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_ListPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).ListPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_ListPlugins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).ListPlugins(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_UploadBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PanelServiceServer).UploadBundle(&grpc.GenericServerStream[BundleChunk, BundleUploadResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PanelService_UploadBundleServer = grpc.ClientStreamingServer[BundleChunk, BundleUploadResult]
+
 // PanelService_ServiceDesc is the grpc.ServiceDesc for PanelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3519,6 +4439,46 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPrimaryAllocation",
 			Handler:    _PanelService_SetPrimaryAllocation_Handler,
 		},
+		{
+			MethodName: "ListAllocations",
+			Handler:    _PanelService_ListAllocations_Handler,
+		},
+		{
+			MethodName: "CreateAllocations",
+			Handler:    _PanelService_CreateAllocations_Handler,
+		},
+		{
+			MethodName: "AssignAllocation",
+			Handler:    _PanelService_AssignAllocation_Handler,
+		},
+		{
+			MethodName: "ReleaseAllocation",
+			Handler:    _PanelService_ReleaseAllocation_Handler,
+		},
+		{
+			MethodName: "ReserveAllocation",
+			Handler:    _PanelService_ReserveAllocation_Handler,
+		},
+		{
+			MethodName: "ListServerSchedules",
+			Handler:    _PanelService_ListServerSchedules_Handler,
+		},
+		{
+			MethodName: "CreateServerSchedule",
+			Handler:    _PanelService_CreateServerSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteServerSchedule",
+			Handler:    _PanelService_DeleteServerSchedule_Handler,
+		},
+		{
+			MethodName: "AddScheduleTask",
+			Handler:    _PanelService_AddScheduleTask_Handler,
+		},
+		{
+			MethodName: "DeleteScheduleTask",
+			Handler:    _PanelService_DeleteScheduleTask_Handler,
+		},
 		{
 			MethodName: "UpdateServerVariables",
 			Handler:    _PanelService_UpdateServerVariables_Handler,
@@ -3591,6 +4551,10 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveSubuser",
 			Handler:    _PanelService_RemoveSubuser_Handler,
 		},
+		{
+			MethodName: "ListSubuserPermissions",
+			Handler:    _PanelService_ListSubuserPermissions_Handler,
+		},
 		{
 			MethodName: "ListDatabases",
 			Handler:    _PanelService_ListDatabases_Handler,
@@ -3671,6 +4635,14 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBackup",
 			Handler:    _PanelService_DeleteBackup_Handler,
 		},
+		{
+			MethodName: "GetBackup",
+			Handler:    _PanelService_GetBackup_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _PanelService_RestoreBackup_Handler,
+		},
 		{
 			MethodName: "ListNodes",
 			Handler:    _PanelService_ListNodes_Handler,
@@ -3691,6 +4663,14 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetNodeToken",
 			Handler:    _PanelService_ResetNodeToken_Handler,
 		},
+		{
+			MethodName: "GetNodeStats",
+			Handler:    _PanelService_GetNodeStats_Handler,
+		},
+		{
+			MethodName: "ListNodeStats",
+			Handler:    _PanelService_ListNodeStats_Handler,
+		},
 		{
 			MethodName: "ListPackages",
 			Handler:    _PanelService_ListPackages_Handler,
@@ -3739,6 +4719,10 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetActivityLogs",
 			Handler:    _PanelService_GetActivityLogs_Handler,
 		},
+		{
+			MethodName: "WriteAudit",
+			Handler:    _PanelService_WriteAudit_Handler,
+		},
 		{
 			MethodName: "Log",
 			Handler:    _PanelService_Log_Handler,
@@ -3755,6 +4739,22 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteKV",
 			Handler:    _PanelService_DeleteKV_Handler,
 		},
+		{
+			MethodName: "ListKV",
+			Handler:    _PanelService_ListKV_Handler,
+		},
+		{
+			MethodName: "CompareAndSwapKV",
+			Handler:    _PanelService_CompareAndSwapKV_Handler,
+		},
+		{
+			MethodName: "GetServerSettings",
+			Handler:    _PanelService_GetServerSettings_Handler,
+		},
+		{
+			MethodName: "SetServerSettings",
+			Handler:    _PanelService_SetServerSettings_Handler,
+		},
 		{
 			MethodName: "QueryDB",
 			Handler:    _PanelService_QueryDB_Handler,
@@ -3767,6 +4767,14 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendNotification",
 			Handler:    _PanelService_SendNotification_Handler,
 		},
+		{
+			MethodName: "ReportError",
+			Handler:    _PanelService_ReportError_Handler,
+		},
+		{
+			MethodName: "SendEmail",
+			Handler:    _PanelService_SendEmail_Handler,
+		},
 		{
 			MethodName: "HTTPRequest",
 			Handler:    _PanelService_HTTPRequest_Handler,
@@ -3775,6 +4783,14 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CallPlugin",
 			Handler:    _PanelService_CallPlugin_Handler,
 		},
+		{
+			MethodName: "CallPluginRoute",
+			Handler:    _PanelService_CallPluginRoute_Handler,
+		},
+		{
+			MethodName: "ListPlugins",
+			Handler:    _PanelService_ListPlugins_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3788,6 +4804,21 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _PanelService_StreamConsole_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamStatus",
+			Handler:       _PanelService_StreamStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchServerSettings",
+			Handler:       _PanelService_WatchServerSettings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadBundle",
+			Handler:       _PanelService_UploadBundle_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "plugin.proto",
 }
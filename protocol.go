@@ -0,0 +1,83 @@
+package birdactyl
+
+import (
+	"runtime/debug"
+	"sync"
+)
+
+const (
+	ProtocolVersion = 4
+
+	sdkModule = "github.com/Birdactyl/Birdactyl-Go-SDK"
+)
+
+var buildVersion = "dev"
+
+var protocolFeatures = map[string]int{
+	capStreamedContent: 2,
+	capAPIBatch:        3,
+	capBundleUpload:    4,
+}
+
+var sdkVersion = sync.OnceValue(func() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return buildVersion
+	}
+	version := ""
+	if info.Main.Path == sdkModule {
+		version = info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != sdkModule {
+			continue
+		}
+		version = dep.Version
+		if dep.Replace != nil {
+			version = dep.Replace.Version
+		}
+	}
+	if version == "" || version == "(devel)" {
+		return buildVersion
+	}
+	return version
+})
+
+func SDKVersion() string {
+	return sdkVersion()
+}
+
+func (p *Plugin) ProtocolVersion() int {
+	p.panelMu.RLock()
+	defer p.panelMu.RUnlock()
+	return p.negotiatedProtocol()
+}
+
+func (p *Plugin) negotiatedProtocol() int {
+	if p.panelProtocol <= 0 {
+		return 0
+	}
+	return min(p.panelProtocol, ProtocolVersion)
+}
+
+func (p *Plugin) protocolAllows(capability string) bool {
+	need, ok := protocolFeatures[capability]
+	if !ok || p.panelProtocol <= 0 {
+		return true
+	}
+	return p.negotiatedProtocol() >= need
+}
+
+func (p *Plugin) checkProtocol() {
+	p.panelMu.RLock()
+	panel := p.panelProtocol
+	p.panelMu.RUnlock()
+	switch {
+	case panel <= 0:
+		p.printf(LevelDebug, "panel did not report a protocol version, relying on capabilities")
+	case panel < ProtocolVersion:
+		p.printf(LevelWarn, "panel speaks protocol %d, sdk %s speaks %d: features newer than protocol %d are disabled", panel, sdkVersion(), ProtocolVersion, panel)
+	case panel > ProtocolVersion:
+		p.printf(LevelWarn, "panel speaks protocol %d, sdk %s only speaks %d: update the sdk to use newer panel features", panel, sdkVersion(), ProtocolVersion)
+	}
+}
@@ -0,0 +1,227 @@
+package birdactyl
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	DefaultWorkers      = 8
+	DefaultQueueSize    = 256
+	DefaultDrainTimeout = 10 * time.Second
+)
+
+type queueStats struct {
+	rejected      atomic.Uint64
+	droppedEvents atomic.Uint64
+}
+
+type workerPool struct {
+	queue    chan queuedMsg
+	wg       sync.WaitGroup
+	mu       sync.RWMutex
+	closed   bool
+	inflight map[string]struct{}
+	lanes    map[string][]*pb.PanelMessage
+	backlog  int
+	gen      uint64
+}
+
+type queuedMsg struct {
+	msg  *pb.PanelMessage
+	lane string
+}
+
+func WithWorkers(n int) Option {
+	return func(p *Plugin) {
+		p.workers = n
+	}
+}
+
+func WithQueueSize(n int) Option {
+	return func(p *Plugin) {
+		p.queueSize = n
+	}
+}
+
+func WithDrainTimeout(d time.Duration) Option {
+	return func(p *Plugin) {
+		p.drainTimeout = d
+	}
+}
+
+func (p *Plugin) startWorkers(gen uint64) *workerPool {
+	workers, size := p.workers, p.queueSize
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	if size <= 0 {
+		size = DefaultQueueSize
+	}
+	pool := &workerPool{queue: make(chan queuedMsg, size), inflight: make(map[string]struct{}), lanes: make(map[string][]*pb.PanelMessage), gen: gen}
+	pool.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer pool.wg.Done()
+			for item := range pool.queue {
+				for msg := item.msg; msg != nil; msg = pool.next(item.lane) {
+					p.handleMessage(pool.gen, msg)
+					pool.done(msg.RequestId)
+				}
+			}
+		}()
+	}
+	p.poolMu.Lock()
+	p.pool = pool
+	p.poolMu.Unlock()
+	return pool
+}
+
+func (w *workerPool) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+	}
+}
+
+func (w *workerPool) drain(clock Clock, timeout time.Duration) []string {
+	w.close()
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	timer := clock.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return nil
+	case <-timer.C():
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	ids := make([]string, 0, len(w.inflight))
+	for id := range w.inflight {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (w *workerPool) offer(msg *pb.PanelMessage, lane string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return false
+	}
+	if backlog, busy := w.lanes[lane]; busy && lane != "" {
+		if len(w.queue)+w.backlog >= cap(w.queue) {
+			return false
+		}
+		w.lanes[lane] = append(backlog, msg)
+		w.backlog++
+		w.inflight[msg.RequestId] = struct{}{}
+		return true
+	}
+	select {
+	case w.queue <- queuedMsg{msg: msg, lane: lane}:
+		w.inflight[msg.RequestId] = struct{}{}
+		if lane != "" {
+			w.lanes[lane] = nil
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func (w *workerPool) next(lane string) *pb.PanelMessage {
+	if lane == "" {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	backlog := w.lanes[lane]
+	if len(backlog) == 0 {
+		delete(w.lanes, lane)
+		return nil
+	}
+	w.lanes[lane] = backlog[1:]
+	w.backlog--
+	return backlog[0]
+}
+
+func (w *workerPool) done(requestID string) {
+	w.mu.Lock()
+	delete(w.inflight, requestID)
+	w.mu.Unlock()
+}
+
+func (p *Plugin) enqueue(pool *workerPool, msg *pb.PanelMessage) {
+	if _, ok := msg.Payload.(*pb.PanelMessage_BundleRequest); ok {
+		p.handleMessage(pool.gen, msg)
+		return
+	}
+	if !pool.offer(msg, p.laneKey(msg)) {
+		p.reject(pool.gen, msg)
+	}
+}
+
+func (p *Plugin) reject(gen uint64, msg *pb.PanelMessage) {
+	p.queueStats.rejected.Add(1)
+	var resp *pb.PluginMessage
+	switch payload := msg.Payload.(type) {
+	case *pb.PanelMessage_Http:
+		p.closeBody(msg.RequestId)
+		reason := "plugin overloaded"
+		if p.ConnectionState() == StateDraining {
+			reason = "plugin shutting down"
+		}
+		resp = &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: errorResponse(503, reason)}}
+	case *pb.PanelMessage_Event:
+		if !payload.Event.Sync {
+			p.queueStats.droppedEvents.Add(1)
+			return
+		}
+		p.printf(LevelWarn, "queue full, allowing sync event %s", payload.Event.Type)
+		resp = &pb.PluginMessage{Payload: &pb.PluginMessage_EventResponse{EventResponse: &pb.EventResponse{Allow: true}}}
+	case *pb.PanelMessage_Mixin:
+		p.printf(LevelWarn, "queue full, skipping mixin %s", payload.Mixin.Target)
+		resp = &pb.PluginMessage{Payload: &pb.PluginMessage_MixinResponse{MixinResponse: &pb.MixinResponse{Action: pb.MixinResponse_NEXT}}}
+	case *pb.PanelMessage_Schedule:
+		p.printf(LevelWarn, "queue full, skipping schedule %s", payload.Schedule.ScheduleId)
+		resp = &pb.PluginMessage{Payload: &pb.PluginMessage_ScheduleResponse{ScheduleResponse: &pb.Empty{}}}
+	case *pb.PanelMessage_AddonType:
+		resp = &pb.PluginMessage{Payload: &pb.PluginMessage_AddonTypeResponse{AddonTypeResponse: &pb.AddonTypeResponse{Success: false, Error: "plugin overloaded"}}}
+	default:
+		return
+	}
+	resp.RequestId = msg.RequestId
+	p.respond(gen, msg, resp)
+}
+
+func (p *Plugin) queueMetrics() QueueMetrics {
+	m := QueueMetrics{
+		Rejected:      p.queueStats.rejected.Load(),
+		DroppedEvents: p.queueStats.droppedEvents.Load(),
+	}
+	p.poolMu.Lock()
+	if p.pool != nil {
+		p.pool.mu.RLock()
+		m.Depth = len(p.pool.queue) + p.pool.backlog
+		m.Lanes = len(p.pool.lanes)
+		p.pool.mu.RUnlock()
+		m.Capacity = cap(p.pool.queue)
+	}
+	p.poolMu.Unlock()
+	return m
+}
@@ -0,0 +1,192 @@
+package birdactyl
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type RateLimitScope string
+
+const (
+	ScopeGlobal RateLimitScope = "global"
+	ScopeUser   RateLimitScope = "user"
+	ScopeIP     RateLimitScope = "ip"
+)
+
+const capRateLimitScopes = "ratelimit.scopes"
+
+const limiterIdle = 10 * time.Minute
+
+const resetEpochThreshold = 1_000_000_000
+
+type presetLimit struct {
+	rpm   int
+	burst int
+}
+
+var presetLimits = map[string]presetLimit{
+	PresetRead:   {rpm: 120, burst: 30},
+	PresetWrite:  {rpm: 30, burst: 10},
+	PresetStrict: {rpm: 10, burst: 5},
+}
+
+type routeLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type rateLimitState struct {
+	known     bool
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func (r Request) RoutePattern() string {
+	return r.route
+}
+
+func (r Request) RateLimitLimit() (int, bool) {
+	return r.rateLimit.limit, r.rateLimit.known && r.rateLimit.limit > 0
+}
+
+func (r Request) RateLimitRemaining() (int, bool) {
+	return r.rateLimit.remaining, r.rateLimit.known
+}
+
+func (r Request) RateLimitReset() (time.Time, bool) {
+	return r.rateLimit.reset, r.rateLimit.known && !r.rateLimit.reset.IsZero()
+}
+
+func panelRateLimit(req *pb.HTTPRequest, now time.Time) rateLimitState {
+	if rl := req.GetRateLimit(); rl != nil {
+		s := rateLimitState{known: true, limit: int(rl.Limit), remaining: int(rl.Remaining)}
+		if rl.ResetUnixMs > 0 {
+			s.reset = time.UnixMilli(rl.ResetUnixMs)
+		}
+		return s
+	}
+	var s rateLimitState
+	for k, v := range req.Headers {
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch strings.ToLower(k) {
+		case "x-ratelimit-limit":
+			s.limit = int(n)
+		case "x-ratelimit-remaining":
+			s.remaining, s.known = int(n), true
+		case "x-ratelimit-reset":
+			if n > resetEpochThreshold {
+				s.reset = time.Unix(n, 0)
+			} else {
+				s.reset = now.Add(time.Duration(n) * time.Second)
+			}
+		}
+	}
+	return s
+}
+
+func (p *Plugin) allowRequest(cfg *RouteConfig, r Request) (rateLimitState, time.Duration, bool) {
+	if cfg.RateLimitKey == nil && (cfg.RateLimitScope == "" || p.PanelHasCapability(capRateLimitScopes)) {
+		return rateLimitState{}, 0, true
+	}
+	rpm, burst := cfg.RateLimitRPM, cfg.RateLimitBurst
+	if rpm <= 0 {
+		l, ok := presetLimits[cfg.RateLimitPreset]
+		if !ok {
+			return rateLimitState{}, 0, true
+		}
+		rpm, burst = l.rpm, l.burst
+	}
+	if burst <= 0 {
+		burst = 1
+	}
+
+	p.regMu.Lock()
+	if cfg.limiter == nil {
+		cfg.limiter = &routeLimiter{rate: float64(rpm) / 60, burst: float64(burst), buckets: make(map[string]*bucket)}
+	}
+	l := cfg.limiter
+	p.regMu.Unlock()
+
+	now := p.clock().Now()
+	wait, ok, tokens := l.takeTokens(rateLimitKey(cfg, r), now)
+	refill := time.Duration((l.burst - tokens) / l.rate * float64(time.Second))
+	return rateLimitState{known: true, limit: burst, remaining: int(tokens), reset: now.Add(refill)}, wait, ok
+}
+
+func rateLimitKey(cfg *RouteConfig, r Request) string {
+	if cfg.RateLimitKey != nil {
+		return cfg.RateLimitKey(r)
+	}
+	switch cfg.RateLimitScope {
+	case ScopeUser:
+		if r.UserID != "" {
+			return "user:" + r.UserID
+		}
+		return "ip:" + clientIP(r)
+	case ScopeIP:
+		return "ip:" + clientIP(r)
+	}
+	return ""
+}
+
+func clientIP(r Request) string {
+	var forwarded string
+	for k, v := range r.Headers {
+		switch strings.ToLower(k) {
+		case "x-real-ip":
+			return strings.TrimSpace(v)
+		case "x-forwarded-for":
+			forwarded, _, _ = strings.Cut(v, ",")
+		}
+	}
+	return strings.TrimSpace(forwarded)
+}
+
+func (l *routeLimiter) take(key string, now time.Time) (time.Duration, bool) {
+	wait, ok, _ := l.takeTokens(key, now)
+	return wait, ok
+}
+
+func (l *routeLimiter) takeTokens(key string, now time.Time) (time.Duration, bool, float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.swept) > limiterIdle {
+		for k, b := range l.buckets {
+			if now.Sub(b.last) > limiterIdle {
+				delete(l.buckets, k)
+			}
+		}
+		l.swept = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true, b.tokens
+	}
+	return time.Duration((1 - b.tokens) / l.rate * float64(time.Second)), false, b.tokens
+}
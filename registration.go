@@ -0,0 +1,82 @@
+package birdactyl
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+func (p *Plugin) AddRoute(method, path string, handler RouteHandler) *RouteBuilder {
+	rb := p.Route(method, path, handler)
+	p.markDirty()
+	return rb
+}
+
+func (p *Plugin) RemoveRoute(method, path string) *Plugin {
+	p.regMu.Lock()
+	delete(p.routes, method+":"+path)
+	p.regMu.Unlock()
+	p.markDirty()
+	return p
+}
+
+func (p *Plugin) RegistrationDirty() bool {
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+	return p.regDirty
+}
+
+func (p *Plugin) UpdateRegistration() error {
+	if errs := p.Validate(); len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if stream, _ := p.connSnapshot(); stream == nil {
+		return ErrUnavailable
+	}
+
+	routes := p.routeSnapshot()
+	info := p.buildInfo()
+	id, call, err := p.addPending()
+	if err != nil {
+		return err
+	}
+	defer p.removePending(id)
+	if err := p.send(&pb.PluginMessage{RequestId: id, Payload: &pb.PluginMessage_UpdateRegistration{UpdateRegistration: info}}); err != nil {
+		return err
+	}
+
+	msg, ok := <-call.ch
+	if !ok {
+		return call.err
+	}
+	if e := msg.GetRegistrationUpdated().GetError(); e != "" {
+		return fmt.Errorf("birdactyl: registration update rejected: %s", e)
+	}
+	p.setAppliedRoutes(msg.GetRegistrationUpdated().GetAppliedRoutes())
+	p.activateRoutes(routes)
+	return nil
+}
+
+func (p *Plugin) markDirty() {
+	p.regMu.Lock()
+	p.regDirty = true
+	p.regMu.Unlock()
+}
+
+func (p *Plugin) routeSnapshot() map[string]*RouteConfig {
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+	out := make(map[string]*RouteConfig, len(p.routes))
+	for k, v := range p.routes {
+		out[k] = v
+	}
+	return out
+}
+
+func (p *Plugin) activateRoutes(routes map[string]*RouteConfig) {
+	p.regMu.Lock()
+	p.liveRoutes = routes
+	p.regDirty = false
+	p.regMu.Unlock()
+}
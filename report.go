@@ -0,0 +1,101 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	reportWindow  = time.Minute
+	reportTimeout = 5 * time.Second
+)
+
+type errorReporter struct {
+	mu         sync.Mutex
+	lastSent   map[string]time.Time
+	suppressed map[string]int32
+}
+
+func (p *Plugin) ReportError(err error) {
+	if err == nil {
+		return
+	}
+	p.report(&pb.ErrorReport{Message: err.Error(), Stack: string(debug.Stack())})
+}
+
+func (p *Plugin) reportPanic(r interface{}, source, requestID string) {
+	msg := fmt.Sprint(r)
+	stack := string(debug.Stack())
+	p.printf(LevelError, "panic in %s: %s\n%s", source, msg, stack)
+	p.report(&pb.ErrorReport{Message: msg, Stack: stack, Source: source, RequestId: requestID, Panic: true})
+}
+
+func (p *Plugin) report(rep *pb.ErrorReport) {
+	rep.PluginVersion = p.version
+	if !p.reporter.allow(rep, p.clock().Now()) || p.panel == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(pluginContext(context.Background(), p.id, p.instance), reportTimeout)
+	defer cancel()
+	p.panel.ReportError(ctx, rep)
+}
+
+func (r *errorReporter) allow(rep *pb.ErrorReport, now time.Time) bool {
+	key := rep.Source + "\x00" + rep.Message
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lastSent == nil {
+		r.lastSent = make(map[string]time.Time)
+		r.suppressed = make(map[string]int32)
+	}
+	if last, ok := r.lastSent[key]; ok && now.Sub(last) < reportWindow {
+		r.suppressed[key]++
+		return false
+	}
+	for k, t := range r.lastSent {
+		if now.Sub(t) >= reportWindow && r.suppressed[k] == 0 {
+			delete(r.lastSent, k)
+		}
+	}
+	rep.Suppressed = r.suppressed[key]
+	delete(r.suppressed, key)
+	r.lastSent[key] = now
+	return true
+}
+
+func messageSource(msg *pb.PanelMessage) string {
+	switch payload := msg.Payload.(type) {
+	case *pb.PanelMessage_Event:
+		return "event:" + payload.Event.Type
+	case *pb.PanelMessage_Http:
+		return "route:" + payload.Http.Method + " " + payload.Http.Path
+	case *pb.PanelMessage_Schedule:
+		return "schedule:" + payload.Schedule.ScheduleId
+	case *pb.PanelMessage_Mixin:
+		return "mixin:" + payload.Mixin.Target
+	case *pb.PanelMessage_AddonType:
+		return "addon_type:" + payload.AddonType.TypeId
+	}
+	return "unknown"
+}
+
+func panicResponse(msg *pb.PanelMessage) *pb.PluginMessage {
+	switch msg.Payload.(type) {
+	case *pb.PanelMessage_Event:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_EventResponse{EventResponse: &pb.EventResponse{Allow: true}}}
+	case *pb.PanelMessage_Http:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: errorResponse(500, "internal error")}}
+	case *pb.PanelMessage_Schedule:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_ScheduleResponse{ScheduleResponse: &pb.Empty{}}}
+	case *pb.PanelMessage_Mixin:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_MixinResponse{MixinResponse: &pb.MixinResponse{Action: pb.MixinResponse_NEXT}}}
+	case *pb.PanelMessage_AddonType:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_AddonTypeResponse{AddonTypeResponse: &pb.AddonTypeResponse{Success: false, Error: "addon type handler panicked"}}}
+	}
+	return nil
+}
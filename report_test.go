@@ -0,0 +1,35 @@
+package birdactyl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+)
+
+type reportPanel struct {
+	pb.PanelServiceClient
+	deadline time.Time
+	ok       bool
+}
+
+func (r *reportPanel) ReportError(ctx context.Context, req *pb.ErrorReport, opts ...grpc.CallOption) (*pb.Empty, error) {
+	r.deadline, r.ok = ctx.Deadline()
+	return &pb.Empty{}, nil
+}
+
+func TestReportErrorHasDeadline(t *testing.T) {
+	panel := &reportPanel{}
+	p := New("report", "1.0.0")
+	p.panel = panel
+	p.ReportError(errors.New("boom"))
+	if !panel.ok {
+		t.Fatal("ReportError called without a deadline")
+	}
+	if d := time.Until(panel.deadline); d > reportTimeout {
+		t.Fatalf("deadline %s away, want at most %s", d, reportTimeout)
+	}
+}
@@ -0,0 +1,186 @@
+package birdactyl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type RequirementError struct {
+	Missing []string
+}
+
+func (e *RequirementError) Error() string {
+	return "birdactyl: panel does not meet plugin requirements: " + strings.Join(e.Missing, "; ")
+}
+
+func (p *Plugin) RequirePanelVersion(constraint string) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if _, err := parseConstraint(constraint); err != nil {
+		p.regErrs = append(p.regErrs, err)
+	}
+	p.requiredVersion = constraint
+	return p
+}
+
+func (p *Plugin) RequireCapability(name string) *Plugin {
+	p.requiredCaps = append(p.requiredCaps, name)
+	return p
+}
+
+func (p *Plugin) PanelVersion() string {
+	p.panelMu.RLock()
+	defer p.panelMu.RUnlock()
+	return p.panelVersion
+}
+
+func (p *Plugin) PanelHasCapability(name string) bool {
+	p.panelMu.RLock()
+	defer p.panelMu.RUnlock()
+	return p.panelCaps[name] && p.protocolAllows(name)
+}
+
+func (p *Plugin) setPanelInfo(reg *pb.Registered) {
+	caps := make(map[string]bool, len(reg.Capabilities))
+	for _, c := range reg.Capabilities {
+		caps[c] = true
+	}
+	p.panelMu.Lock()
+	p.panelVersion = reg.PanelVersion
+	p.panelProtocol = int(reg.ProtocolVersion)
+	p.panelCaps = caps
+	p.panelMu.Unlock()
+	p.setPanelEnvironment(reg)
+	p.setDependencies(reg.Dependencies)
+	p.perms.grant(reg.Permissions)
+	p.flags.apply(reg.Flags)
+	p.setEgress(reg.Egress)
+	p.setAppliedRoutes(reg.AppliedRoutes)
+}
+
+func (p *Plugin) checkRequirements() error {
+	var missing []string
+	if p.requiredVersion != "" {
+		version := p.PanelVersion()
+		switch ok, err := versionSatisfies(version, p.requiredVersion); {
+		case version == "":
+			missing = append(missing, fmt.Sprintf("panel version %s required, panel did not report a version", p.requiredVersion))
+		case err != nil:
+			missing = append(missing, fmt.Sprintf("panel version %q: %v", version, err))
+		case !ok:
+			missing = append(missing, fmt.Sprintf("panel version %s required, panel is %s", p.requiredVersion, version))
+		}
+	}
+	for _, c := range p.requiredCaps {
+		if !p.PanelHasCapability(c) {
+			missing = append(missing, fmt.Sprintf("capability %q not supported", c))
+		}
+	}
+	if p.instance != "" && !p.PanelHasCapability(capInstances) {
+		missing = append(missing, fmt.Sprintf("capability %q not supported, required for instance %q", capInstances, p.instance))
+	}
+	missing = append(missing, p.missingDependencies()...)
+	if len(missing) > 0 {
+		return &RequirementError{Missing: missing}
+	}
+	return nil
+}
+
+type versionConstraint struct {
+	op      string
+	version [3]int
+}
+
+func parseConstraint(s string) ([]versionConstraint, error) {
+	var out []versionConstraint
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		op := "="
+		for _, candidate := range []string{">=", "<=", "==", ">", "<", "=", "^", "~"} {
+			if strings.HasPrefix(part, candidate) {
+				op = candidate
+				part = strings.TrimSpace(part[len(candidate):])
+				break
+			}
+		}
+		if op == "==" {
+			op = "="
+		}
+		v, err := parseVersion(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %v", s, err)
+		}
+		out = append(out, versionConstraint{op: op, version: v})
+	}
+	return out, nil
+}
+
+func parseVersion(s string) ([3]int, error) {
+	var v [3]int
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	parts := strings.Split(s, ".")
+	if s == "" || len(parts) > 3 {
+		return v, fmt.Errorf("malformed version %q", s)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return v, fmt.Errorf("malformed version %q", s)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionSatisfies(version, constraint string) (bool, error) {
+	v, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	constraints, err := parseConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range constraints {
+		cmp := compareVersions(v, c.version)
+		var ok bool
+		switch c.op {
+		case ">=":
+			ok = cmp >= 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case "<":
+			ok = cmp < 0
+		case "^":
+			ok = cmp >= 0 && v[0] == c.version[0]
+		case "~":
+			ok = cmp >= 0 && v[0] == c.version[0] && v[1] == c.version[1]
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,61 @@
+package birdactyl
+
+import "sync"
+
+type requestValues struct {
+	mu sync.RWMutex
+	m  map[string]interface{}
+}
+
+func (r *Request) Set(key string, value interface{}) {
+	if r.values == nil {
+		r.values = &requestValues{}
+	}
+	r.values.mu.Lock()
+	defer r.values.mu.Unlock()
+	if r.values.m == nil {
+		r.values.m = make(map[string]interface{})
+	}
+	r.values.m[key] = value
+}
+
+func (r Request) Get(key string) (interface{}, bool) {
+	if r.values == nil {
+		return nil, false
+	}
+	r.values.mu.RLock()
+	defer r.values.mu.RUnlock()
+	v, ok := r.values.m[key]
+	return v, ok
+}
+
+func (r Request) GetString(key string) (string, bool) {
+	v, _ := r.Get(key)
+	s, ok := v.(string)
+	return s, ok
+}
+
+func (r Request) GetInt(key string) (int, bool) {
+	v, _ := r.Get(key)
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case uint:
+		return int(n), true
+	case uint32:
+		return int(n), true
+	case uint64:
+		return int(n), true
+	}
+	return 0, false
+}
+
+func (r Request) GetBool(key string) (bool, bool) {
+	v, _ := r.Get(key)
+	b, ok := v.(bool)
+	return b, ok
+}
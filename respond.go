@@ -0,0 +1,61 @@
+package birdactyl
+
+import (
+	"errors"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type streamGenKey struct{}
+
+func (p *Plugin) respond(gen uint64, msg *pb.PanelMessage, resp *pb.PluginMessage) {
+	err := p.sendGen(gen, resp)
+	if err == nil {
+		return
+	}
+	if errors.Is(err, errStaleStream) {
+		p.printf(LevelDebug, "dropping %s for request %s from a previous stream", payloadName(resp), msg.RequestId)
+		return
+	}
+	p.sendFailures.Add(1)
+	p.printf(LevelError, "failed to send %s for request %s: %v", payloadName(resp), msg.RequestId, err)
+
+	if status.Code(err) == codes.ResourceExhausted {
+		if fallback := oversizeResponse(msg); fallback != nil {
+			fallback.RequestId = msg.RequestId
+			if err := p.sendGen(gen, fallback); err == nil {
+				return
+			}
+			p.sendFailures.Add(1)
+		}
+		return
+	}
+
+	p.sendMu.Lock()
+	var drop func()
+	if gen == 0 || gen == p.streamGen {
+		drop = p.dropStream
+	}
+	p.sendMu.Unlock()
+	if drop != nil {
+		p.printf(LevelWarn, "dropping broken stream to reconnect")
+		drop()
+	}
+}
+
+func oversizeResponse(msg *pb.PanelMessage) *pb.PluginMessage {
+	const reason = "response too large"
+	switch msg.Payload.(type) {
+	case *pb.PanelMessage_Event:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_EventResponse{EventResponse: &pb.EventResponse{Allow: true}}}
+	case *pb.PanelMessage_Http:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_HttpResponse{HttpResponse: errorResponse(500, reason)}}
+	case *pb.PanelMessage_Mixin:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_MixinResponse{MixinResponse: &pb.MixinResponse{Action: pb.MixinResponse_ERROR, Error: reason}}}
+	case *pb.PanelMessage_AddonType:
+		return &pb.PluginMessage{Payload: &pb.PluginMessage_AddonTypeResponse{AddonTypeResponse: &pb.AddonTypeResponse{Success: false, Error: reason}}}
+	}
+	return nil
+}
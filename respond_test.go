@@ -0,0 +1,40 @@
+package birdactyl
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type brokenStream struct {
+	pb.PanelService_ConnectClient
+	sends int
+}
+
+func (s *brokenStream) Send(*pb.PluginMessage) error {
+	s.sends++
+	return errors.New("transport is closing")
+}
+
+func TestRespondIgnoresStaleStream(t *testing.T) {
+	p := New("respond", "1.0.0")
+	stream := &brokenStream{}
+	dropped := 0
+	p.stream = stream
+	p.streamGen = 2
+	p.dropStream = func() { dropped++ }
+
+	msg := &pb.PanelMessage{RequestId: "r1", Payload: &pb.PanelMessage_Event{Event: &pb.Event{}}}
+	resp := &pb.PluginMessage{RequestId: "r1", Payload: &pb.PluginMessage_EventResponse{EventResponse: &pb.EventResponse{Allow: true}}}
+
+	p.respond(1, msg, resp)
+	if stream.sends != 0 || dropped != 0 || p.sendFailures.Load() != 0 {
+		t.Fatalf("stale reply: sends = %d, dropped = %d, failures = %d, want all 0", stream.sends, dropped, p.sendFailures.Load())
+	}
+
+	p.respond(2, msg, resp)
+	if stream.sends != 1 || dropped != 1 {
+		t.Fatalf("current reply: sends = %d, dropped = %d, want 1 and 1", stream.sends, dropped)
+	}
+}
@@ -0,0 +1,131 @@
+package birdactyl
+
+import (
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type RateLimit struct {
+	Preset      string         `json:"preset,omitempty"`
+	RPM         int            `json:"rpm"`
+	Burst       int            `json:"burst"`
+	Scope       RateLimitScope `json:"scope,omitempty"`
+	SDKEnforced bool           `json:"sdk_enforced,omitempty"`
+}
+
+type RouteInfo struct {
+	Method    string     `json:"method"`
+	Path      string     `json:"path"`
+	Requested *RateLimit `json:"requested,omitempty"`
+	Applied   *RateLimit `json:"applied,omitempty"`
+}
+
+type RouteThrottleInfo struct {
+	Method      string
+	Path        string
+	Route       string
+	UserID      string
+	ClientIP    string
+	Limit       int
+	Scope       RateLimitScope
+	RetryAfter  time.Duration
+	Count       int
+	SDKEnforced bool
+}
+
+func (p *Plugin) OnRouteThrottled(fn func(RouteThrottleInfo)) *Plugin {
+	p.regMu.Lock()
+	p.onThrottled = append(p.onThrottled, fn)
+	p.regMu.Unlock()
+	return p
+}
+
+func (p *Plugin) Routes() []RouteInfo {
+	routes := p.routeSnapshot()
+	p.panelMu.RLock()
+	applied := p.appliedRoutes
+	p.panelMu.RUnlock()
+	out := make([]RouteInfo, 0, len(routes))
+	for _, key := range sortedKeys(routes) {
+		cfg := routes[key]
+		out = append(out, RouteInfo{
+			Method:    cfg.Method,
+			Path:      cfg.Path,
+			Requested: rateLimitFrom(cfg.rateLimitConfig()),
+			Applied:   rateLimitFrom(applied[key]),
+		})
+	}
+	return out
+}
+
+func (cfg *RouteConfig) rateLimitConfig() *pb.RateLimitConfig {
+	if cfg.RateLimitPreset == "" && cfg.RateLimitRPM <= 0 {
+		return nil
+	}
+	return &pb.RateLimitConfig{
+		Preset:            cfg.RateLimitPreset,
+		RequestsPerMinute: int32(cfg.RateLimitRPM),
+		BurstLimit:        int32(cfg.RateLimitBurst),
+		Scope:             string(cfg.RateLimitScope),
+		SdkEnforced:       cfg.RateLimitKey != nil,
+	}
+}
+
+func rateLimitFrom(c *pb.RateLimitConfig) *RateLimit {
+	if c == nil {
+		return nil
+	}
+	return &RateLimit{
+		Preset:      c.Preset,
+		RPM:         int(c.RequestsPerMinute),
+		Burst:       int(c.BurstLimit),
+		Scope:       RateLimitScope(c.Scope),
+		SDKEnforced: c.SdkEnforced,
+	}
+}
+
+func (p *Plugin) setAppliedRoutes(routes []*pb.AppliedRoute) {
+	if len(routes) == 0 {
+		return
+	}
+	applied := make(map[string]*pb.RateLimitConfig, len(routes))
+	for _, r := range routes {
+		applied[r.Method+":"+r.Path] = r.RateLimit
+	}
+	p.panelMu.Lock()
+	p.appliedRoutes = applied
+	p.panelMu.Unlock()
+}
+
+func (p *Plugin) routeThrottled(t *pb.RouteThrottled) {
+	p.fireThrottled(RouteThrottleInfo{
+		Method:     t.Method,
+		Path:       t.Path,
+		Route:      t.Route,
+		UserID:     t.UserId,
+		ClientIP:   t.ClientIp,
+		Limit:      int(t.Limit),
+		Scope:      RateLimitScope(t.Scope),
+		RetryAfter: time.Duration(t.RetryAfterMs) * time.Millisecond,
+		Count:      max(int(t.Count), 1),
+	})
+}
+
+func (p *Plugin) fireThrottled(info RouteThrottleInfo) {
+	p.throttled.Add(uint64(info.Count))
+	p.regMu.RLock()
+	handlers := p.onThrottled
+	p.regMu.RUnlock()
+	for _, fn := range handlers {
+		fn := fn
+		p.lifecycle.post(func() {
+			defer func() {
+				if r := recover(); r != nil {
+					p.reportPanic(r, "throttled:"+info.Method+" "+info.Route, "")
+				}
+			}()
+			fn(info)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package birdactyl_test
+
+import (
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func TestThrottledHandlerDoesNotBlockReceiveLoop(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	fired := make(chan birdactyl.RouteThrottleInfo, 1)
+	p := birdactyl.New("throttle", "1.0.0").OnRouteThrottled(func(info birdactyl.RouteThrottleInfo) {
+		fired <- info
+		<-release
+	})
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	tp.SendThrottled(birdactyl.RouteThrottleInfo{Method: "GET", Route: "/x", Count: 1})
+	select {
+	case info := <-fired:
+		if info.Route != "/x" {
+			t.Fatalf("route = %q, want /x", info.Route)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("throttled handler did not run")
+	}
+
+	if status := tp.ProbeHealth(); status.State != birdactyl.HealthHealthy {
+		t.Fatalf("health probe while throttled handler blocks = %+v, want healthy", status)
+	}
+}
+
+func TestThrottledHandlerPanicIsRecovered(t *testing.T) {
+	fired := make(chan struct{}, 1)
+	p := birdactyl.New("throttle", "1.0.0").
+		OnRouteThrottled(func(birdactyl.RouteThrottleInfo) { panic("boom") }).
+		OnRouteThrottled(func(birdactyl.RouteThrottleInfo) { fired <- struct{}{} })
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	tp.SendThrottled(birdactyl.RouteThrottleInfo{Method: "GET", Route: "/x", Count: 1})
+	select {
+	case <-fired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler after a panicking one did not run")
+	}
+}
@@ -0,0 +1,148 @@
+package scaffold
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"text/template"
+)
+
+const sdkModule = "github.com/Birdactyl/Birdactyl-Go-SDK"
+
+type Feature uint
+
+const (
+	FeatureRoute Feature = 1 << iota
+	FeatureEvent
+	FeatureSchedule
+	FeatureConfig
+	FeatureUI
+	FeatureTest
+
+	FeatureAll = FeatureRoute | FeatureEvent | FeatureSchedule | FeatureConfig | FeatureUI | FeatureTest
+)
+
+type Options struct {
+	ModulePath string
+	PluginID   string
+	Name       string
+	Version    string
+	Features   Feature
+	SDKVersion string
+	SDKReplace string
+}
+
+var pluginID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+func (o Options) withDefaults() (Options, error) {
+	if o.PluginID == "" {
+		return o, errors.New("scaffold: plugin ID is required")
+	}
+	if !pluginID.MatchString(o.PluginID) {
+		return o, fmt.Errorf("scaffold: plugin ID %q must be lowercase letters, digits, '-' or '_'", o.PluginID)
+	}
+	if o.ModulePath == "" {
+		o.ModulePath = o.PluginID
+	}
+	if o.Name == "" {
+		o.Name = o.PluginID
+	}
+	if o.Version == "" {
+		o.Version = "0.1.0"
+	}
+	if o.Features == 0 {
+		o.Features = FeatureAll
+	}
+	if o.SDKVersion == "" {
+		o.SDKVersion = "v0.0.0"
+	}
+	return o, nil
+}
+
+type view struct {
+	Options
+	SDK      string
+	Route    bool
+	Event    bool
+	Schedule bool
+	Config   bool
+	UI       bool
+}
+
+type file struct {
+	name  string
+	tmpl  string
+	when  Feature
+	gofmt bool
+}
+
+var files = []file{
+	{name: "go.mod", tmpl: goModTmpl},
+	{name: ".gitignore", tmpl: gitignoreTmpl},
+	{name: "main.go", tmpl: mainTmpl, gofmt: true},
+	{name: "plugin.go", tmpl: pluginTmpl, gofmt: true},
+	{name: "plugin_test.go", tmpl: testTmpl, when: FeatureTest, gofmt: true},
+}
+
+func Generate(dir string, opts Options) error {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return err
+	}
+	v := view{
+		Options:  opts,
+		SDK:      sdkModule,
+		Route:    opts.Features&FeatureRoute != 0,
+		Event:    opts.Features&FeatureEvent != 0,
+		Schedule: opts.Features&FeatureSchedule != 0,
+		Config:   opts.Features&FeatureConfig != 0,
+		UI:       opts.Features&FeatureUI != 0,
+	}
+	rendered := make(map[string][]byte, len(files))
+	for _, f := range files {
+		if f.when != 0 && opts.Features&f.when == 0 {
+			continue
+		}
+		out, err := render(f, v)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, f.name)
+		if _, err := os.Stat(target); err == nil {
+			return fmt.Errorf("scaffold: %s: %w", target, os.ErrExist)
+		}
+		rendered[target] = out
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for target, out := range rendered {
+		if err := os.WriteFile(target, out, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func render(f file, v view) ([]byte, error) {
+	t, err := template.New(f.name).Parse(f.tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("scaffold: parse %s: %w", f.name, err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, v); err != nil {
+		return nil, fmt.Errorf("scaffold: render %s: %w", f.name, err)
+	}
+	if !f.gofmt {
+		return buf.Bytes(), nil
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("scaffold: format %s: %w", f.name, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,78 @@
+package scaffold_test
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/Birdactyl/Birdactyl-Go-SDK/scaffold"
+)
+
+func goTool(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go %v: %v\n%s", args, err, out)
+	}
+}
+
+func TestGeneratedPluginBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the go tool")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool not available")
+	}
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		features scaffold.Feature
+	}{
+		{"all", scaffold.FeatureAll},
+		{"route", scaffold.FeatureRoute},
+		{"config", scaffold.FeatureConfig | scaffold.FeatureTest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := scaffold.Generate(dir, scaffold.Options{
+				ModulePath: "example.com/demo",
+				PluginID:   "demo",
+				Features:   tt.features,
+				SDKReplace: root,
+			})
+			if err != nil {
+				t.Fatalf("Generate: %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, "go.sum"), sum, 0644); err != nil {
+				t.Fatal(err)
+			}
+			goTool(t, dir, "mod", "tidy")
+			goTool(t, dir, "build", "./...")
+			goTool(t, dir, "vet", "./...")
+		})
+	}
+}
+
+func TestGenerateRefusesToOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	opts := scaffold.Options{PluginID: "demo"}
+	if err := scaffold.Generate(dir, opts); err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if err := scaffold.Generate(dir, opts); !errors.Is(err, os.ErrExist) {
+		t.Fatalf("second Generate = %v, want an already-exists error", err)
+	}
+}
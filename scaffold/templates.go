@@ -0,0 +1,110 @@
+package scaffold
+
+const goModTmpl = `module {{.ModulePath}}
+
+go 1.22
+
+require {{.SDK}} {{.SDKVersion}}
+{{- if .SDKReplace}}
+
+replace {{.SDK}} => {{.SDKReplace}}
+{{- end}}
+`
+
+const gitignoreTmpl = `/{{.PluginID}}_data/
+/{{.PluginID}}
+`
+
+const mainTmpl = `package main
+
+import (
+	"log"
+	"os"
+)
+
+const defaultPanelAddr = "localhost:50050"
+
+func main() {
+	if err := newPlugin().Start(defaultPanelAddr); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+`
+
+const pluginTmpl = `package main
+
+import (
+	birdactyl "{{.SDK}}"
+)
+{{if .Config}}
+type Config struct {
+	Greeting string ` + "`json:\"greeting\"`" + `
+}
+{{end}}
+func newPlugin() *birdactyl.Plugin {
+	p := birdactyl.New({{printf "%q" .PluginID}}, {{printf "%q" .Version}})
+	p.SetName({{printf "%q" .Name}})
+{{if .Config}}
+	cfg := Config{Greeting: "Hello"}
+	p.UseConfig(&cfg)
+{{end}}{{if .Route}}
+	p.Route("GET", "/hello", func(r birdactyl.Request) birdactyl.Response {
+		{{- if .Config}}
+		return birdactyl.JSON(map[string]string{"message": cfg.Greeting})
+		{{- else}}
+		return birdactyl.JSON(map[string]string{"message": "Hello"})
+		{{- end}}
+	})
+{{end}}{{if .Event}}
+	p.OnEvent("server.start", func(e birdactyl.Event) birdactyl.EventResult {
+		e.Logf("server %s is starting", e.Data["server_id"])
+		return birdactyl.Allow()
+	})
+{{end}}{{if .Schedule}}
+	p.Schedule("heartbeat", "*/5 * * * *", func() {
+		p.Log("still running")
+	})
+{{end}}{{if .UI}}
+	p.UI().Page("/", "MainPage").Title({{printf "%q" .Name}}).Done()
+{{end}}
+	return p
+}
+`
+
+const testTmpl = `package main
+
+import (
+{{- if .Route}}
+	"encoding/json"
+{{- end}}
+	"testing"
+
+	"{{.SDK}}/birdactyltest"
+)
+
+func TestPlugin(t *testing.T) {
+	panel := birdactyltest.NewPanel(t)
+	panel.StartPlugin(newPlugin())
+{{if .Route}}
+	resp := panel.DoHTTP("GET", "/hello", nil)
+	if resp.Status != 200 {
+		t.Fatalf("GET /hello: status %d", resp.Status)
+	}
+	var body struct {
+		Data map[string]string ` + "`json:\"data\"`" + `
+	}
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Data["message"] != "Hello" {
+		t.Fatalf("unexpected message %q", body.Data["message"])
+	}
+{{end}}{{if .Event}}
+	if res := panel.SendEvent("server.start", map[string]string{"server_id": "test"}, true); !res.Allowed() {
+		t.Fatalf("server.start was blocked: %s", res.Message())
+	}
+{{end}}{{if .Schedule}}
+	panel.TriggerSchedule("heartbeat")
+{{end}}}
+`
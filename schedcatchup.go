@@ -0,0 +1,170 @@
+package birdactyl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync"
+	"time"
+)
+
+const scheduleStateFile = ".birdactyl/schedules.json"
+
+type CatchUpPolicy int
+
+const (
+	SkipMissed CatchUpPolicy = iota
+	RunOnceOnReconnect
+)
+
+type ScheduleBuilder struct {
+	config *ScheduleConfig
+	plugin *Plugin
+}
+
+func (p *Plugin) Scheduled(id, cron string, handler SchedHandler) *ScheduleBuilder {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	cfg := &ScheduleConfig{ID: id, Cron: cron, Handler: handler}
+	if _, ok := p.schedules[id]; ok {
+		p.regErrs = append(p.regErrs, fmt.Errorf("schedule %q registered twice", id))
+		return &ScheduleBuilder{config: cfg, plugin: p}
+	}
+	p.schedules[id] = cfg
+	return &ScheduleBuilder{config: cfg, plugin: p}
+}
+
+func (sb *ScheduleBuilder) CatchUp(policy CatchUpPolicy) *ScheduleBuilder {
+	sb.plugin.regMu.Lock()
+	sb.config.CatchUp = policy
+	sb.plugin.regMu.Unlock()
+	return sb
+}
+
+func (s Sched) IsCatchUp() bool {
+	return s.catchUp
+}
+
+func (s Sched) ScheduledAt() time.Time {
+	return s.scheduledAt
+}
+
+type scheduleRuns struct {
+	mu     sync.Mutex
+	loaded bool
+	last   map[string]time.Time
+}
+
+func (p *Plugin) loadScheduleRuns() map[string]time.Time {
+	if p.schedRuns.loaded {
+		return p.schedRuns.last
+	}
+	p.schedRuns.loaded = true
+	p.schedRuns.last = make(map[string]time.Time)
+	data, err := p.LoadData(scheduleStateFile)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			p.printf(LevelWarn, "could not read schedule state: %v", err)
+		}
+		return p.schedRuns.last
+	}
+	if err := json.Unmarshal(data, &p.schedRuns.last); err != nil {
+		p.printf(LevelWarn, "could not parse schedule state: %v", err)
+	}
+	return p.schedRuns.last
+}
+
+func (p *Plugin) recordScheduleRun(id string, at time.Time) {
+	p.schedRuns.mu.Lock()
+	defer p.schedRuns.mu.Unlock()
+	last := p.loadScheduleRuns()
+	if prev, ok := last[id]; ok && !at.After(prev) {
+		return
+	}
+	last[id] = at
+	p.saveScheduleRuns(last)
+}
+
+func (p *Plugin) saveScheduleRuns(last map[string]time.Time) {
+	data, _ := json.Marshal(last)
+	if err := p.SaveData(scheduleStateFile, data); err != nil {
+		p.printf(LevelWarn, "could not save schedule state: %v", err)
+	}
+}
+
+func (p *Plugin) runSchedule(cfg *ScheduleConfig, s Sched) {
+	if cfg.CatchUp == RunOnceOnReconnect && !s.catchUp {
+		p.recordScheduleRun(cfg.ID, s.scheduledAt)
+	}
+	cfg.Handler(s)
+}
+
+type missedRun struct {
+	config *ScheduleConfig
+	at     time.Time
+}
+
+func (p *Plugin) missedSchedules() []missedRun {
+	p.regMu.RLock()
+	var configs []*ScheduleConfig
+	for _, id := range sortedKeys(p.schedules) {
+		if cfg := p.schedules[id]; cfg.CatchUp == RunOnceOnReconnect {
+			configs = append(configs, cfg)
+		}
+	}
+	p.regMu.RUnlock()
+	if len(configs) == 0 {
+		return nil
+	}
+
+	now := p.clock().Now()
+	current := now.Truncate(time.Minute)
+	p.schedRuns.mu.Lock()
+	defer p.schedRuns.mu.Unlock()
+	last := p.loadScheduleRuns()
+	var missed []missedRun
+	dirty := false
+	for _, cfg := range configs {
+		prev, ok := last[cfg.ID]
+		if !ok {
+			last[cfg.ID] = current
+			dirty = true
+			continue
+		}
+		cron, err := parseCron(cfg.Cron)
+		if err != nil {
+			continue
+		}
+		var latest time.Time
+		for t := cron.Next(prev); !t.IsZero() && t.Before(current); t = cron.Next(t) {
+			latest = t
+		}
+		if !latest.IsZero() {
+			missed = append(missed, missedRun{config: cfg, at: latest})
+			last[cfg.ID] = latest
+			dirty = true
+		}
+	}
+	if dirty {
+		p.saveScheduleRuns(last)
+	}
+	return missed
+}
+
+func (p *Plugin) catchUpSchedules(ctx context.Context) {
+	for _, m := range p.missedSchedules() {
+		cfg := m.config
+		p.printf(LevelInfo, "schedule %s missed its run at %s, catching up", cfg.ID, m.at.Format(time.RFC3339))
+		s := Sched{ID: cfg.ID, Cron: cfg.Cron, ctx: ctx, flags: p.flags.Snapshot(), plugin: p, catchUp: true, scheduledAt: m.at}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					p.reportPanic(r, "schedule:"+cfg.ID, "")
+				}
+			}()
+			p.runSchedule(cfg, s)
+		}()
+	}
+}
@@ -0,0 +1,98 @@
+package birdactyl
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type stepClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *stepClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *stepClock) set(t time.Time) {
+	c.mu.Lock()
+	c.now = t
+	c.mu.Unlock()
+}
+
+func (c *stepClock) NewTimer(d time.Duration) Timer   { return realTimer{time.NewTimer(d)} }
+func (c *stepClock) NewTicker(d time.Duration) Ticker { return realTicker{time.NewTicker(d)} }
+
+func newCatchUpPlugin(t *testing.T, dir string, clk Clock, handler SchedHandler) *Plugin {
+	t.Helper()
+	p := New("sched", "1.0.0", WithClock(clk))
+	p.dataDir = dir
+	p.Scheduled("job", "* * * * *", handler).CatchUp(RunOnceOnReconnect)
+	return p
+}
+
+func TestCatchUpRunsMissedSlotOnce(t *testing.T) {
+	dir := t.TempDir()
+	clk := &stepClock{now: time.Date(2026, 1, 1, 12, 0, 30, 0, time.UTC)}
+	var runs atomic.Int32
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	p := newCatchUpPlugin(t, dir, clk, func(s Sched) {
+		if runs.Add(1) == 1 {
+			started <- struct{}{}
+			<-release
+		}
+	})
+
+	p.catchUpSchedules(context.Background())
+	if n := runs.Load(); n != 0 {
+		t.Fatalf("first connect ran %d catch-ups, want 0", n)
+	}
+
+	clk.set(time.Date(2026, 1, 1, 12, 5, 30, 0, time.UTC))
+	done := make(chan struct{})
+	go func() {
+		p.catchUpSchedules(context.Background())
+		close(done)
+	}()
+	<-started
+
+	p.catchUpSchedules(context.Background())
+	restarted := newCatchUpPlugin(t, dir, clk, func(Sched) { runs.Add(1) })
+	restarted.catchUpSchedules(context.Background())
+	close(release)
+	<-done
+
+	if n := runs.Load(); n != 1 {
+		t.Fatalf("missed slot ran %d times across reconnects, want 1", n)
+	}
+}
+
+func TestScheduledRunRecordedBeforeHandler(t *testing.T) {
+	dir := t.TempDir()
+	clk := &stepClock{now: time.Date(2026, 1, 1, 12, 0, 30, 0, time.UTC)}
+	var catchUps atomic.Int32
+	p := newCatchUpPlugin(t, dir, clk, func(s Sched) {
+		if s.IsCatchUp() {
+			catchUps.Add(1)
+			return
+		}
+		clk.set(time.Date(2026, 1, 1, 12, 3, 30, 0, time.UTC))
+		s.plugin.catchUpSchedules(context.Background())
+	})
+	p.catchUpSchedules(context.Background())
+
+	p.regMu.RLock()
+	cfg := p.schedules["job"]
+	p.regMu.RUnlock()
+	p.runSchedule(cfg, Sched{ID: "job", plugin: p, scheduledAt: time.Date(2026, 1, 1, 12, 3, 0, 0, time.UTC)})
+
+	if n := catchUps.Load(); n != 0 {
+		t.Fatalf("reconnect during a running slot caught up %d times, want 0", n)
+	}
+}
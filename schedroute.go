@@ -0,0 +1,67 @@
+package birdactyl
+
+import (
+	"fmt"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const ScheduledHeader = "X-Birdactyl-Scheduled"
+
+func (p *Plugin) ScheduleRoute(id, cron, method, path string) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if _, ok := p.schedules[id]; ok {
+		p.regErrs = append(p.regErrs, fmt.Errorf("schedule %q registered twice", id))
+		return p
+	}
+	if method == "*" || !validMethods[method] {
+		p.regErrs = append(p.regErrs, fmt.Errorf("schedule %q: invalid route method %q", id, method))
+		return p
+	}
+	if !p.routeRegistered(method, path) {
+		p.regErrs = append(p.regErrs, fmt.Errorf("schedule %q: no route registered for %s %s", id, method, path))
+		return p
+	}
+	p.schedules[id] = &ScheduleConfig{
+		ID:     id,
+		Cron:   cron,
+		Method: method,
+		Path:   path,
+		Handler: func(s Sched) {
+			p.runScheduledRoute(s, method, path)
+		},
+	}
+	return p
+}
+
+func (p *Plugin) routeRegistered(method, path string) bool {
+	if _, ok := p.routes[method+":"+path]; ok {
+		return true
+	}
+	for _, c := range p.routes {
+		if (c.Method == "*" || c.Method == method) && matchPath(c.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Request) ScheduleID() string {
+	return headerValue(r.Headers, ScheduledHeader)
+}
+
+func (p *Plugin) runScheduledRoute(s Sched, method, path string) {
+	msg := &pb.PanelMessage{
+		RequestId: s.RequestID,
+		Payload: &pb.PanelMessage_Http{Http: &pb.HTTPRequest{
+			Method:  method,
+			Path:    path,
+			Headers: map[string]string{ScheduledHeader: s.ID},
+		}},
+	}
+	resp := p.dispatch(s.Context(), msg).GetHttpResponse()
+	if code := resp.GetStatus(); code >= 400 {
+		p.printf(LevelWarn, "scheduled route %s %s (%s) returned %d", method, path, s.ID, code)
+	}
+}
@@ -0,0 +1,23 @@
+package birdactyl
+
+import (
+	"context"
+	"testing"
+)
+
+type schedKey struct{}
+
+func TestScheduledRouteUsesScheduleContext(t *testing.T) {
+	p := New("sched", "1.0.0")
+	got := make(chan interface{}, 1)
+	p.Route("POST", "/prune", func(r Request) Response {
+		got <- r.Context().Value(schedKey{})
+		return Text("ok")
+	})
+
+	ctx := context.WithValue(context.Background(), schedKey{}, "tick")
+	p.runScheduledRoute(Sched{ID: "prune", RequestID: "r1", ctx: ctx}, "POST", "/prune")
+	if v := <-got; v != "tick" {
+		t.Fatalf("route context value = %v, want the schedule's context", v)
+	}
+}
@@ -0,0 +1,223 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const MaxTaskDelay = 15 * time.Minute
+
+type TaskAction string
+
+const (
+	TaskPower   TaskAction = "power"
+	TaskCommand TaskAction = "command"
+	TaskBackup  TaskAction = "backup"
+)
+
+type ScheduleSpec struct {
+	Name           string
+	Cron           string
+	Disabled       bool
+	OnlyWhenOnline bool
+	Tasks          []TaskSpec
+}
+
+type TaskSpec struct {
+	Action            TaskAction
+	Power             PowerAction
+	Command           string
+	BackupName        string
+	Delay             time.Duration
+	ContinueOnFailure bool
+}
+
+type ServerSchedule struct {
+	ID             string
+	ServerID       string
+	Name           string
+	Cron           string
+	Enabled        bool
+	OnlyWhenOnline bool
+	Tasks          []ScheduleTask
+	LastRun        time.Time
+	NextRun        time.Time
+}
+
+type ScheduleTask struct {
+	ID                string
+	ScheduleID        string
+	Sequence          int
+	Action            TaskAction
+	Payload           string
+	Delay             time.Duration
+	ContinueOnFailure bool
+}
+
+func PowerTask(action PowerAction) TaskSpec {
+	return TaskSpec{Action: TaskPower, Power: action}
+}
+
+func CommandTask(command string) TaskSpec {
+	return TaskSpec{Action: TaskCommand, Command: command}
+}
+
+func BackupTask(name string) TaskSpec {
+	return TaskSpec{Action: TaskBackup, BackupName: name}
+}
+
+func (t TaskSpec) After(d time.Duration) TaskSpec {
+	t.Delay = d
+	return t
+}
+
+func (t TaskSpec) payload() string {
+	switch t.Action {
+	case TaskPower:
+		return string(t.Power)
+	case TaskCommand:
+		return t.Command
+	case TaskBackup:
+		return t.BackupName
+	}
+	return ""
+}
+
+func (t TaskSpec) violations(field string) []FieldViolation {
+	var v []FieldViolation
+	switch t.Action {
+	case TaskPower:
+		switch t.Power {
+		case PowerStart, PowerStop, PowerRestart, PowerKill:
+		default:
+			v = append(v, FieldViolation{Field: field + ".power", Description: fmt.Sprintf("unknown power action %q", t.Power)})
+		}
+	case TaskCommand:
+		if strings.TrimSpace(t.Command) == "" {
+			v = append(v, FieldViolation{Field: field + ".command", Description: "must not be empty"})
+		}
+	case TaskBackup:
+	default:
+		v = append(v, FieldViolation{Field: field + ".action", Description: fmt.Sprintf("unknown task action %q", t.Action)})
+	}
+	if t.Delay < 0 || t.Delay > MaxTaskDelay {
+		v = append(v, FieldViolation{Field: field + ".delay", Description: fmt.Sprintf("must be between 0 and %s", MaxTaskDelay)})
+	}
+	if t.Delay%time.Second != 0 {
+		v = append(v, FieldViolation{Field: field + ".delay", Description: "must be a whole number of seconds"})
+	}
+	return v
+}
+
+func (s ScheduleSpec) validate() error {
+	var v []FieldViolation
+	if strings.TrimSpace(s.Name) == "" {
+		v = append(v, FieldViolation{Field: "name", Description: "must not be empty"})
+	}
+	if _, err := parseCron(s.Cron); err != nil {
+		v = append(v, FieldViolation{Field: "cron", Description: err.Error()})
+	}
+	stopped := false
+	for i, t := range s.Tasks {
+		field := fmt.Sprintf("tasks[%d]", i)
+		v = append(v, t.violations(field)...)
+		switch {
+		case t.Action == TaskPower && (t.Power == PowerStop || t.Power == PowerKill):
+			stopped = true
+		case t.Action == TaskPower:
+			stopped = false
+		case t.Action == TaskCommand && stopped:
+			v = append(v, FieldViolation{Field: field, Description: "command runs after the server was stopped"})
+		}
+	}
+	if len(v) > 0 {
+		return &SpecError{Violations: v}
+	}
+	return nil
+}
+
+func (t TaskSpec) toProto() *pb.ScheduleTask {
+	return &pb.ScheduleTask{Action: string(t.Action), Payload: t.payload(), DelaySeconds: int32(t.Delay / time.Second), ContinueOnFailure: t.ContinueOnFailure}
+}
+
+func scheduleTaskFromProto(t *pb.ScheduleTask) ScheduleTask {
+	return ScheduleTask{
+		ID:                t.Id,
+		ScheduleID:        t.ScheduleId,
+		Sequence:          int(t.Sequence),
+		Action:            TaskAction(t.Action),
+		Payload:           t.Payload,
+		Delay:             time.Duration(t.DelaySeconds) * time.Second,
+		ContinueOnFailure: t.ContinueOnFailure,
+	}
+}
+
+func serverScheduleFromProto(s *pb.ServerSchedule) *ServerSchedule {
+	out := &ServerSchedule{
+		ID:             s.Id,
+		ServerID:       s.ServerId,
+		Name:           s.Name,
+		Cron:           s.Cron,
+		Enabled:        s.Enabled,
+		OnlyWhenOnline: s.OnlyWhenOnline,
+		LastRun:        unixTime(s.LastRun),
+		NextRun:        unixTime(s.NextRun),
+	}
+	for _, t := range s.Tasks {
+		out.Tasks = append(out.Tasks, scheduleTaskFromProto(t))
+	}
+	return out
+}
+
+func (a *API) ServerSchedules(ctx context.Context, serverID string) ([]ServerSchedule, error) {
+	r, err := a.panel.ListServerSchedules(a.outgoing(ctx), &pb.IDRequest{Id: serverID})
+	if err != nil {
+		return nil, serverErr(err)
+	}
+	out := make([]ServerSchedule, len(r.Schedules))
+	for i, s := range r.Schedules {
+		out[i] = *serverScheduleFromProto(s)
+	}
+	return out, nil
+}
+
+func (a *API) CreateServerSchedule(ctx context.Context, serverID string, spec ScheduleSpec) (*ServerSchedule, error) {
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+	req := &pb.CreateServerScheduleRequest{ServerId: serverID, Name: spec.Name, Cron: spec.Cron, Enabled: !spec.Disabled, OnlyWhenOnline: spec.OnlyWhenOnline}
+	for _, t := range spec.Tasks {
+		req.Tasks = append(req.Tasks, t.toProto())
+	}
+	r, err := a.panel.CreateServerSchedule(a.outgoing(ctx), req)
+	if err != nil {
+		return nil, specErr(err)
+	}
+	return serverScheduleFromProto(r), nil
+}
+
+func (a *API) DeleteServerSchedule(ctx context.Context, scheduleID string) error {
+	_, err := a.panel.DeleteServerSchedule(a.outgoing(ctx), &pb.IDRequest{Id: scheduleID})
+	return panelErr(err)
+}
+
+func (a *API) AddScheduleTask(ctx context.Context, scheduleID string, task TaskSpec) (*ScheduleTask, error) {
+	if v := task.violations("task"); len(v) > 0 {
+		return nil, &SpecError{Violations: v}
+	}
+	r, err := a.panel.AddScheduleTask(a.outgoing(ctx), &pb.AddScheduleTaskRequest{ScheduleId: scheduleID, Task: task.toProto()})
+	if err != nil {
+		return nil, specErr(err)
+	}
+	t := scheduleTaskFromProto(r)
+	return &t, nil
+}
+
+func (a *API) DeleteScheduleTask(ctx context.Context, taskID string) error {
+	_, err := a.panel.DeleteScheduleTask(a.outgoing(ctx), &pb.IDRequest{Id: taskID})
+	return panelErr(err)
+}
@@ -0,0 +1,73 @@
+package birdactyl
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const EventServerSettingsDeleted = "server.settings_deleted"
+
+type ServerSettingChange struct {
+	ServerID      string
+	Key           string
+	Value         string
+	Deleted       bool
+	ServerDeleted bool
+	Time          time.Time
+	Dropped       uint64
+}
+
+func (a *API) ServerSettings(ctx context.Context, serverID string) (map[string]string, error) {
+	r, err := a.panel.GetServerSettings(a.outgoing(ctx), &pb.IDRequest{Id: serverID})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	if r.Values == nil {
+		return map[string]string{}, nil
+	}
+	return r.Values, nil
+}
+
+func (a *API) ServerSetting(ctx context.Context, serverID, key string) (string, bool, error) {
+	values, err := a.ServerSettings(ctx, serverID)
+	if err != nil {
+		return "", false, err
+	}
+	v, ok := values[key]
+	return v, ok, nil
+}
+
+func (a *API) SetServerSetting(ctx context.Context, serverID, key, value string) error {
+	return a.SetServerSettings(ctx, serverID, map[string]string{key: value})
+}
+
+func (a *API) SetServerSettings(ctx context.Context, serverID string, values map[string]string) error {
+	_, err := a.panel.SetServerSettings(a.outgoing(ctx), &pb.SetServerSettingsRequest{ServerId: serverID, Values: values})
+	return panelErr(err)
+}
+
+func (a *API) DeleteServerSettings(ctx context.Context, serverID string, keys ...string) error {
+	_, err := a.panel.SetServerSettings(a.outgoing(ctx), &pb.SetServerSettingsRequest{ServerId: serverID, DeleteKeys: keys})
+	return panelErr(err)
+}
+
+func (a *API) WatchServerSettings(ctx context.Context, serverID string) (<-chan ServerSettingChange, error) {
+	ctx, cancel := context.WithCancel(a.outgoing(ctx))
+	stream, err := a.panel.WatchServerSettings(ctx, &pb.IDRequest{Id: serverID})
+	if err != nil {
+		cancel()
+		return nil, panelErr(err)
+	}
+	return pump(cancel, stream.Recv, func(c *pb.ServerSettingsChange, dropped uint64) ServerSettingChange {
+		return ServerSettingChange{ServerID: c.ServerId, Key: c.Key, Value: c.Value, Deleted: c.Deleted, ServerDeleted: c.ServerDeleted, Time: unixTime(c.Timestamp), Dropped: dropped}
+	}), nil
+}
+
+func (p *Plugin) OnServerSettingsDeleted(fn func(serverID string)) *Plugin {
+	return p.OnEvent(EventServerSettingsDeleted, func(e Event) EventResult {
+		fn(e.Data["server_id"])
+		return Allow()
+	})
+}
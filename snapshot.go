@@ -0,0 +1,43 @@
+package birdactyl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func (p *Plugin) RegistrationJSON() ([]byte, error) {
+	info := proto.Clone(p.buildInfo()).(*pb.PluginInfo)
+	hashes := make([]string, 0, len(info.GetUi().GetAssets()))
+	if ui := info.Ui; ui != nil {
+		ui.BundleData = nil
+		for _, a := range ui.Assets {
+			sum := sha256.Sum256(a.Data)
+			hashes = append(hashes, hex.EncodeToString(sum[:]))
+			a.Data = nil
+		}
+	}
+
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	var v map[string]interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	if ui, ok := v["ui"].(map[string]interface{}); ok {
+		if assets, ok := ui["assets"].([]interface{}); ok {
+			for i, a := range assets {
+				if m, ok := a.(map[string]interface{}); ok && i < len(hashes) {
+					m["sha256"] = hashes[i]
+				}
+			}
+		}
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
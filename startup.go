@@ -0,0 +1,105 @@
+package birdactyl
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type StartContext struct {
+	Config      interface{}
+	Panel       PanelInfo
+	Permissions []Permission
+	Flags       FlagSnapshot
+	API         *API
+	plugin      *Plugin
+}
+
+func (s StartContext) Fail(err error) {
+	if err != nil {
+		s.plugin.failStart(err)
+	}
+}
+
+func (p *Plugin) OnStartContext(fn func(StartContext)) *Plugin {
+	p.regMu.Lock()
+	p.onStart = fn
+	p.regMu.Unlock()
+	return p
+}
+
+func (p *Plugin) UseConfig(v interface{}) *Plugin {
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Pointer || rv.IsNil() {
+		p.regErrs = append(p.regErrs, fmt.Errorf("UseConfig: expected a non-nil pointer, got %T", v))
+		return p
+	}
+	p.config = v
+	p.useDataDir = true
+	return p
+}
+
+func (p *Plugin) OnStartError(fn func(err error)) *Plugin {
+	p.regMu.Lock()
+	p.onStartError = append(p.onStartError, fn)
+	p.regMu.Unlock()
+	return p
+}
+
+func (p *Plugin) StartError() error {
+	p.health.mu.Lock()
+	defer p.health.mu.Unlock()
+	return p.health.startErr
+}
+
+func (p *Plugin) startPlugin() bool {
+	p.regMu.RLock()
+	config, onStart := p.config, p.onStart
+	p.regMu.RUnlock()
+	if config != nil {
+		if err := p.LoadConfigOrDefault(config); err != nil {
+			p.failStart(fmt.Errorf("load config: %w", err))
+			return false
+		}
+	}
+	if onStart == nil {
+		return true
+	}
+	sc := StartContext{
+		Config:      config,
+		Panel:       p.PanelInfo(),
+		Permissions: p.GrantedPermissions(),
+		Flags:       p.flags.Snapshot(),
+		API:         p.api,
+		plugin:      p,
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				p.reportPanic(r, "start", "")
+				p.failStart(fmt.Errorf("OnStart panicked: %v", r))
+			}
+		}()
+		onStart(sc)
+	}()
+	return p.StartError() == nil
+}
+
+func (p *Plugin) failStart(err error) {
+	p.health.mu.Lock()
+	if p.health.startErr != nil {
+		p.health.mu.Unlock()
+		return
+	}
+	p.health.startErr = err
+	p.health.mu.Unlock()
+	p.printf(LevelError, "startup failed: %v", err)
+	p.sendHealth(p.Health(), "")
+	p.regMu.RLock()
+	handlers := p.onStartError
+	p.regMu.RUnlock()
+	for _, fn := range handlers {
+		fn := fn
+		p.lifecycle.post(func() { fn(err) })
+	}
+}
@@ -0,0 +1,81 @@
+package birdactyl_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+	"github.com/Birdactyl/Birdactyl-Go-SDK/birdactyltest"
+)
+
+func chdirTemp(t *testing.T) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func TestOnStart(t *testing.T) {
+	chdirTemp(t)
+	started := make(chan struct{}, 1)
+	p := birdactyl.New("startup", "1.0.0").OnStart(func() { started <- struct{}{} })
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnStart did not run")
+	}
+}
+
+func TestOnStartContext(t *testing.T) {
+	chdirTemp(t)
+	type config struct{ Greeting string }
+	cfg := config{Greeting: "hi"}
+	started := make(chan struct{}, 1)
+	var got birdactyl.StartContext
+	p := birdactyl.New("startup", "1.0.0").
+		UseConfig(&cfg).
+		OnStartContext(func(sc birdactyl.StartContext) {
+			got = sc
+			started <- struct{}{}
+		})
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnStartContext did not run")
+	}
+	if c, ok := got.Config.(*config); !ok || c.Greeting != "hi" {
+		t.Fatalf("start context config = %#v, want the UseConfig pointer", got.Config)
+	}
+}
+
+func TestOnStartErrorReceivesFailure(t *testing.T) {
+	chdirTemp(t)
+	failed := make(chan error, 1)
+	p := birdactyl.New("startup", "1.0.0").
+		OnStartContext(func(sc birdactyl.StartContext) { sc.Fail(errors.New("no database")) }).
+		OnStartError(func(err error) { failed <- err })
+	tp := birdactyltest.NewPanel(t)
+	tp.StartPlugin(p)
+
+	select {
+	case err := <-failed:
+		if err.Error() != "no database" {
+			t.Fatalf("start error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnStartError did not run")
+	}
+}
@@ -0,0 +1,84 @@
+package birdactyl
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const streamBuffer = 256
+
+type ConsoleLine struct {
+	Line    string
+	Time    time.Time
+	Dropped uint64
+}
+
+type StatusChange struct {
+	ServerID string
+	Old      string
+	New      string
+	Time     time.Time
+	Dropped  uint64
+}
+
+func (a *API) StreamConsoleContext(ctx context.Context, serverID string) (<-chan ConsoleLine, error) {
+	ctx, cancel := context.WithCancel(a.outgoing(ctx))
+	stream, err := a.panel.StreamConsole(ctx, &pb.StreamConsoleRequest{ServerId: serverID})
+	if err != nil {
+		cancel()
+		return nil, serverErr(err)
+	}
+	return pump(cancel, stream.Recv, func(l *pb.ConsoleLine, dropped uint64) ConsoleLine {
+		return ConsoleLine{Line: l.Line, Time: unixTime(l.Timestamp), Dropped: dropped}
+	}), nil
+}
+
+func (a *API) StreamStatus(ctx context.Context, serverID string) (<-chan StatusChange, error) {
+	ctx, cancel := context.WithCancel(a.outgoing(ctx))
+	stream, err := a.panel.StreamStatus(ctx, &pb.IDRequest{Id: serverID})
+	if err != nil {
+		cancel()
+		return nil, serverErr(err)
+	}
+	return pump(cancel, stream.Recv, func(c *pb.ServerStatusChange, dropped uint64) StatusChange {
+		return StatusChange{ServerID: c.ServerId, Old: c.OldStatus, New: c.NewStatus, Time: unixTime(c.Timestamp), Dropped: dropped}
+	}), nil
+}
+
+func pump[M any, T any](cancel context.CancelFunc, recv func() (M, error), conv func(M, uint64) T) <-chan T {
+	out := make(chan T, streamBuffer)
+	go func() {
+		defer close(out)
+		defer cancel()
+		var dropped uint64
+		for {
+			msg, err := recv()
+			if err != nil {
+				return
+			}
+			v := conv(msg, dropped)
+			select {
+			case out <- v:
+				continue
+			default:
+			}
+			select {
+			case <-out:
+				dropped++
+				v = conv(msg, dropped)
+			default:
+			}
+			out <- v
+		}
+	}()
+	return out
+}
+
+func unixTime(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
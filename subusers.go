@@ -0,0 +1,173 @@
+package birdactyl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	SubuserConsole           = "control.console"
+	SubuserStart             = "control.start"
+	SubuserStop              = "control.stop"
+	SubuserRestart           = "control.restart"
+	SubuserFileRead          = "file.read"
+	SubuserFileReadContent   = "file.read-content"
+	SubuserFileCreate        = "file.create"
+	SubuserFileUpdate        = "file.update"
+	SubuserFileDelete        = "file.delete"
+	SubuserFileArchive       = "file.archive"
+	SubuserBackupRead        = "backup.read"
+	SubuserBackupCreate      = "backup.create"
+	SubuserBackupDelete      = "backup.delete"
+	SubuserBackupDownload    = "backup.download"
+	SubuserBackupRestore     = "backup.restore"
+	SubuserDatabaseRead      = "database.read"
+	SubuserDatabaseCreate    = "database.create"
+	SubuserDatabaseUpdate    = "database.update"
+	SubuserDatabaseDelete    = "database.delete"
+	SubuserDatabasePassword  = "database.view_password"
+	SubuserScheduleRead      = "schedule.read"
+	SubuserScheduleCreate    = "schedule.create"
+	SubuserScheduleUpdate    = "schedule.update"
+	SubuserScheduleDelete    = "schedule.delete"
+	SubuserUserRead          = "user.read"
+	SubuserUserCreate        = "user.create"
+	SubuserUserUpdate        = "user.update"
+	SubuserUserDelete        = "user.delete"
+	SubuserAllocationRead    = "allocation.read"
+	SubuserAllocationCreate  = "allocation.create"
+	SubuserAllocationUpdate  = "allocation.update"
+	SubuserAllocationDelete  = "allocation.delete"
+	SubuserStartupRead       = "startup.read"
+	SubuserStartupUpdate     = "startup.update"
+	SubuserSettingsRename    = "settings.rename"
+	SubuserSettingsReinstall = "settings.reinstall"
+	SubuserActivityRead      = "activity.read"
+)
+
+var SubuserPermissions = []string{
+	SubuserConsole, SubuserStart, SubuserStop, SubuserRestart,
+	SubuserFileRead, SubuserFileReadContent, SubuserFileCreate, SubuserFileUpdate, SubuserFileDelete, SubuserFileArchive,
+	SubuserBackupRead, SubuserBackupCreate, SubuserBackupDelete, SubuserBackupDownload, SubuserBackupRestore,
+	SubuserDatabaseRead, SubuserDatabaseCreate, SubuserDatabaseUpdate, SubuserDatabaseDelete, SubuserDatabasePassword,
+	SubuserScheduleRead, SubuserScheduleCreate, SubuserScheduleUpdate, SubuserScheduleDelete,
+	SubuserUserRead, SubuserUserCreate, SubuserUserUpdate, SubuserUserDelete,
+	SubuserAllocationRead, SubuserAllocationCreate, SubuserAllocationUpdate, SubuserAllocationDelete,
+	SubuserStartupRead, SubuserStartupUpdate,
+	SubuserSettingsRename, SubuserSettingsReinstall,
+	SubuserActivityRead,
+}
+
+type SubuserPermissionInfo struct {
+	Key         string
+	Group       string
+	Description string
+}
+
+type subuserPermCache struct {
+	mu    sync.Mutex
+	known map[string]bool
+}
+
+func (c *subuserPermCache) get(ctx context.Context, a *API) (map[string]bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.known != nil {
+		return c.known, nil
+	}
+	perms, err := a.SubuserPermissionInfo(ctx)
+	if status.Code(err) == codes.Unimplemented {
+		perms, err = nil, nil
+		for _, k := range SubuserPermissions {
+			perms = append(perms, SubuserPermissionInfo{Key: k})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(perms))
+	for _, p := range perms {
+		known[p.Key] = true
+	}
+	c.known = known
+	return known, nil
+}
+
+func subuserFromProto(s *pb.Subuser) Subuser {
+	return Subuser{ID: s.Id, UserID: s.UserId, Username: s.Username, Email: s.Email, Permissions: s.Permissions}
+}
+
+func (a *API) SubuserPermissionInfo(ctx context.Context) ([]SubuserPermissionInfo, error) {
+	r, err := a.panel.ListSubuserPermissions(a.outgoing(ctx), &pb.Empty{})
+	if err != nil {
+		return nil, panelErr(err)
+	}
+	out := make([]SubuserPermissionInfo, len(r.Permissions))
+	for i, p := range r.Permissions {
+		out[i] = SubuserPermissionInfo{Key: p.Key, Group: p.Group, Description: p.Description}
+	}
+	return out, nil
+}
+
+func (a *API) ValidateSubuserPermissions(ctx context.Context, permissions []string) error {
+	known, err := a.subuserPerms.get(ctx, a)
+	if err != nil {
+		return err
+	}
+	var v []FieldViolation
+	for i, p := range permissions {
+		if !known[p] {
+			v = append(v, FieldViolation{Field: fmt.Sprintf("permissions[%d]", i), Description: fmt.Sprintf("unknown subuser permission %q", p)})
+		}
+	}
+	if len(v) > 0 {
+		return &SpecError{Violations: v}
+	}
+	return nil
+}
+
+func (a *API) Subusers(ctx context.Context, serverID string) ([]Subuser, error) {
+	r, err := a.panel.ListSubusers(a.outgoing(ctx), &pb.IDRequest{Id: serverID})
+	if err != nil {
+		return nil, serverErr(err)
+	}
+	out := make([]Subuser, len(r.Subusers))
+	for i, s := range r.Subusers {
+		out[i] = subuserFromProto(s)
+	}
+	return out, nil
+}
+
+func (a *API) AddSubuserContext(ctx context.Context, serverID, email string, permissions []string) (*Subuser, error) {
+	if strings.TrimSpace(email) == "" {
+		return nil, &SpecError{Violations: []FieldViolation{{Field: "email", Description: "must not be empty"}}}
+	}
+	if err := a.ValidateSubuserPermissions(ctx, permissions); err != nil {
+		return nil, err
+	}
+	r, err := a.panel.AddSubuser(a.outgoing(ctx), &pb.AddSubuserRequest{ServerId: serverID, Email: email, Permissions: permissions})
+	if err != nil {
+		return nil, specErr(err)
+	}
+	s := subuserFromProto(r)
+	return &s, nil
+}
+
+func (a *API) UpdateSubuserPermissions(ctx context.Context, serverID, subuserID string, permissions []string) error {
+	if err := a.ValidateSubuserPermissions(ctx, permissions); err != nil {
+		return err
+	}
+	_, err := a.panel.UpdateSubuser(a.outgoing(ctx), &pb.UpdateSubuserRequest{ServerId: serverID, SubuserId: subuserID, Permissions: permissions})
+	return specErr(err)
+}
+
+func (a *API) RemoveSubuserContext(ctx context.Context, serverID, subuserID string) error {
+	_, err := a.panel.RemoveSubuser(a.outgoing(ctx), &pb.RemoveSubuserRequest{ServerId: serverID, SubuserId: subuserID})
+	return serverErr(err)
+}
@@ -0,0 +1,312 @@
+package birdactyl
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	traceBatchSize     = 512
+	traceQueueSize     = 4096
+	traceFlushInterval = 5 * time.Second
+	traceExportTimeout = 10 * time.Second
+)
+
+const (
+	spanKindInternal = 1
+	spanKindServer   = 2
+	spanKindClient   = 3
+)
+
+type spanKey struct{}
+
+type Span struct {
+	tracer *tracer
+	trace  [16]byte
+	id     [8]byte
+	parent [8]byte
+	name   string
+	kind   int
+	start  time.Time
+	end    time.Time
+	attrs  map[string]string
+	err    string
+	mu     sync.Mutex
+	ended  bool
+}
+
+type tracer struct {
+	plugin   *Plugin
+	endpoint string
+	mu       sync.Mutex
+	queue    []*Span
+	dropped  uint64
+	wake     chan struct{}
+	start    sync.Once
+}
+
+func WithTracing(endpoint string) Option {
+	return func(p *Plugin) {
+		if endpoint == "" {
+			p.tracer = nil
+			return
+		}
+		if u, err := url.Parse(endpoint); err == nil && (u.Path == "" || u.Path == "/") {
+			endpoint = strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+		}
+		p.tracer = &tracer{plugin: p, endpoint: endpoint, wake: make(chan struct{}, 1)}
+	}
+}
+
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	parent := SpanFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+	s := parent.tracer.child(parent, name, spanKindInternal)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func (t *tracer) child(parent *Span, name string, kind int) *Span {
+	s := &Span{tracer: t, trace: parent.trace, parent: parent.id, name: name, kind: kind, start: t.plugin.clock().Now()}
+	rand.Read(s.id[:])
+	return s
+}
+
+func (t *tracer) startMessage(ctx context.Context, msg *pb.PanelMessage) (context.Context, *Span) {
+	s := &Span{tracer: t, name: messageSource(msg), kind: spanKindServer, start: t.plugin.clock().Now()}
+	if !parseTraceparent(msg.Traceparent, s) {
+		rand.Read(s.trace[:])
+	}
+	rand.Read(s.id[:])
+	s.SetAttribute("birdactyl.request_id", msg.RequestId)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func parseTraceparent(header string, s *Span) bool {
+	parts := strings.Split(header, "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return false
+	}
+	if _, err := hex.Decode(s.trace[:], []byte(parts[1])); err != nil {
+		return false
+	}
+	if _, err := hex.Decode(s.parent[:], []byte(parts[2])); err != nil {
+		return false
+	}
+	return s.trace != [16]byte{}
+}
+
+func (s *Span) TraceID() string {
+	if s == nil {
+		return ""
+	}
+	return hex.EncodeToString(s.trace[:])
+}
+
+func (s *Span) SpanID() string {
+	if s == nil {
+		return ""
+	}
+	return hex.EncodeToString(s.id[:])
+}
+
+func (s *Span) traceparent() string {
+	return "00-" + s.TraceID() + "-" + s.SpanID() + "-01"
+}
+
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil || value == "" {
+		return
+	}
+	s.mu.Lock()
+	if s.attrs == nil {
+		s.attrs = make(map[string]string)
+	}
+	s.attrs[key] = value
+	s.mu.Unlock()
+}
+
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	s.err = err.Error()
+	s.mu.Unlock()
+}
+
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.end = s.tracer.plugin.clock().Now()
+	s.mu.Unlock()
+	s.tracer.enqueue(s)
+}
+
+func (s *Span) finish(resp *pb.PluginMessage, panicked bool) {
+	var failure string
+	switch payload := resp.GetPayload().(type) {
+	case *pb.PluginMessage_HttpResponse:
+		code := int(payload.HttpResponse.GetStatus())
+		s.SetAttribute("http.status_code", strconv.Itoa(code))
+		if code >= 500 {
+			failure = http.StatusText(code)
+		}
+	case *pb.PluginMessage_EventResponse:
+		s.SetAttribute("birdactyl.event.allow", strconv.FormatBool(payload.EventResponse.GetAllow()))
+	case *pb.PluginMessage_MixinResponse:
+		s.SetAttribute("birdactyl.mixin.action", payload.MixinResponse.GetAction().String())
+		failure = payload.MixinResponse.GetError()
+	}
+	if panicked {
+		failure = "panic"
+	}
+	s.mu.Lock()
+	if s.err == "" {
+		s.err = failure
+	}
+	s.mu.Unlock()
+	s.End()
+}
+
+func (t *tracer) enqueue(s *Span) {
+	t.start.Do(func() { go t.loop() })
+	t.mu.Lock()
+	if len(t.queue) >= traceQueueSize {
+		t.dropped++
+		t.mu.Unlock()
+		return
+	}
+	t.queue = append(t.queue, s)
+	full := len(t.queue) >= traceBatchSize
+	t.mu.Unlock()
+	if full {
+		select {
+		case t.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *tracer) loop() {
+	tick := t.plugin.clock().NewTicker(traceFlushInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.C():
+		case <-t.wake:
+		}
+		t.flush()
+	}
+}
+
+func (t *tracer) flush() {
+	for {
+		t.mu.Lock()
+		n := min(len(t.queue), traceBatchSize)
+		batch := t.queue[:n:n]
+		t.queue = t.queue[n:]
+		t.mu.Unlock()
+		if n == 0 {
+			return
+		}
+		if err := t.export(batch); err != nil {
+			t.plugin.printf(LevelWarn, "trace export failed, dropping %d spans: %v", n, err)
+		}
+	}
+}
+
+func (t *tracer) export(spans []*Span) error {
+	out := make([]map[string]interface{}, len(spans))
+	for i, s := range spans {
+		out[i] = s.otlp()
+	}
+	p := t.plugin
+	body, err := json.Marshal(map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{"attributes": otlpAttributes(map[string]string{
+				"service.name":    p.id,
+				"service.version": p.version,
+			})},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]string{"name": "birdactyl"},
+				"spans": out,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), traceExportTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.HTTPClient().Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return &HTTPError{URL: t.endpoint, StatusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+func (s *Span) otlp() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := map[string]interface{}{
+		"traceId":           hex.EncodeToString(s.trace[:]),
+		"spanId":            hex.EncodeToString(s.id[:]),
+		"name":              s.name,
+		"kind":              s.kind,
+		"startTimeUnixNano": strconv.FormatInt(s.start.UnixNano(), 10),
+		"endTimeUnixNano":   strconv.FormatInt(s.end.UnixNano(), 10),
+		"attributes":        otlpAttributes(s.attrs),
+	}
+	if s.parent != [8]byte{} {
+		m["parentSpanId"] = hex.EncodeToString(s.parent[:])
+	}
+	if s.err != "" {
+		m["status"] = map[string]interface{}{"code": 2, "message": s.err}
+	}
+	return m
+}
+
+func otlpAttributes(attrs map[string]string) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(attrs))
+	for _, k := range sortedKeys(attrs) {
+		out = append(out, map[string]interface{}{"key": k, "value": map[string]string{"stringValue": attrs[k]}})
+	}
+	return out
+}
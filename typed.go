@@ -0,0 +1,359 @@
+package birdactyl
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+type TypedHandler[In, Out any] func(r Request, in In) (Out, error)
+
+func Typed[In, Out any](p *Plugin, method, path string, handler TypedHandler[In, Out]) *RouteBuilder {
+	inType := reflect.TypeOf((*In)(nil)).Elem()
+	outType := reflect.TypeOf((*Out)(nil)).Elem()
+	fields, err := typedFields(inType, path)
+	rb := p.RouteE(method, path, func(r Request) (Response, error) {
+		var in In
+		if err := r.bindTyped(&in, fields); err != nil {
+			return Response{}, err
+		}
+		out, err := handler(r, in)
+		if err != nil {
+			return Response{}, err
+		}
+		if resp, ok := any(out).(Response); ok {
+			return resp, nil
+		}
+		return JSON(out), nil
+	})
+	p.regMu.Lock()
+	defer p.regMu.Unlock()
+	if err != nil {
+		p.regErrs = append(p.regErrs, fmt.Errorf("route %s %s: %w", method, path, err))
+		return rb
+	}
+	rb.config.schema = typedSchema(method, inType, outType, fields)
+	return rb
+}
+
+type typedField struct {
+	index []int
+	name  string
+	in    string
+	field reflect.StructField
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+func typedFields(t reflect.Type, path string) ([]typedField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, nil
+	}
+	params := make(map[string]bool)
+	for _, seg := range strings.Split(path, "/") {
+		if len(seg) > 1 && seg[0] == ':' {
+			params[seg[1:]] = true
+		}
+	}
+	var fields []typedField
+	for _, f := range reflect.VisibleFields(t) {
+		if f.Anonymous || !f.IsExported() {
+			continue
+		}
+		for _, in := range []string{"path", "query"} {
+			name := f.Tag.Get(in)
+			if name == "" {
+				continue
+			}
+			if !bindable(f.Type) {
+				return nil, fmt.Errorf("field %s: type %s cannot be bound from %s", f.Name, f.Type, in)
+			}
+			if in == "path" && !params[name] {
+				return nil, fmt.Errorf("field %s: path parameter %q is not in the route pattern", f.Name, name)
+			}
+			fields = append(fields, typedField{index: f.Index, name: name, in: in, field: f})
+		}
+	}
+	return fields, nil
+}
+
+func bindable(t reflect.Type) bool {
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return bindable(t.Elem())
+	case reflect.Slice:
+		return t.Elem().Kind() != reflect.Slice && bindable(t.Elem())
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func (r Request) bindTyped(dst interface{}, fields []typedField) error {
+	if len(r.RawBody) > 0 {
+		if err := json.Unmarshal(r.RawBody, dst); err != nil {
+			return NewAPIError(400, CodeInvalidArgument, "invalid request body: "+err.Error())
+		}
+	}
+	v := reflect.ValueOf(dst).Elem()
+	for _, f := range fields {
+		src := r.Query
+		if f.in == "path" {
+			src = r.params
+		}
+		raw, ok := src[f.name]
+		if !ok {
+			continue
+		}
+		if err := setString(v.FieldByIndex(f.index), raw); err != nil {
+			return NewAPIError(400, CodeInvalidArgument, fmt.Sprintf("invalid %s parameter %q: %v", f.in, f.name, err))
+		}
+	}
+	return r.plugin.ValidateStruct(dst)
+}
+
+func setString(v reflect.Value, s string) error {
+	if v.CanAddr() {
+		if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(s))
+		}
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(v.Type().Elem())
+		if err := setString(elem.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(elem)
+	case reflect.Slice:
+		parts := strings.Split(s, ",")
+		out := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setString(out.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		v.Set(out)
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("must be a boolean")
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be an integer")
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a non-negative integer")
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		v.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func typedSchema(method string, in, out reflect.Type, fields []typedField) *pb.RouteSchema {
+	s := &pb.RouteSchema{}
+	bound := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		bound[f.field.Name] = true
+		schema := jsonSchema(f.field.Type, nil)
+		required := f.in == "path"
+		if rules := f.field.Tag.Get("validate"); rules != "" {
+			required = required || applyRules(schema, f.field.Type, rules)
+		}
+		s.Params = append(s.Params, &pb.RouteParam{Name: f.name, In: f.in, Required: required, Schema: marshalJSON(schema)})
+	}
+	if method != "GET" && method != "HEAD" && hasBody(in, bound) {
+		s.RequestBody = marshalJSON(structSchema(in, bound, map[reflect.Type]bool{}))
+	}
+	if out != reflect.TypeOf(Response{}) {
+		s.Response = marshalJSON(map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"success": map[string]interface{}{"type": "boolean"},
+				"data":    jsonSchema(out, nil),
+			},
+			"required": []string{"success", "data"},
+		})
+	}
+	return s
+}
+
+func hasBody(t reflect.Type, bound map[string]bool) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return true
+	}
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.Anonymous && f.IsExported() && !bound[f.Name] && fieldName(f) != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func jsonSchema(t reflect.Type, seen map[reflect.Type]bool) map[string]interface{} {
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case t == reflect.TypeOf(json.RawMessage(nil)):
+		return map[string]interface{}{}
+	case t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return map[string]interface{}{"type": "string"}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": jsonSchema(t.Elem(), seen)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": jsonSchema(t.Elem(), seen)}
+	case reflect.Struct:
+		return structSchema(t, nil, seen)
+	}
+	return map[string]interface{}{}
+}
+
+func structSchema(t reflect.Type, skip map[string]bool, seen map[reflect.Type]bool) map[string]interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return jsonSchema(t, seen)
+	}
+	if seen[t] {
+		return map[string]interface{}{"type": "object"}
+	}
+	seen[t] = true
+	defer delete(seen, t)
+
+	props := make(map[string]interface{})
+	var required []string
+	for _, f := range reflect.VisibleFields(t) {
+		if f.Anonymous || !f.IsExported() || skip[f.Name] {
+			continue
+		}
+		name := fieldName(f)
+		if name == "-" {
+			continue
+		}
+		schema := jsonSchema(f.Type, seen)
+		if rules := f.Tag.Get("validate"); rules != "" && applyRules(schema, f.Type, rules) {
+			required = append(required, name)
+		}
+		props[name] = schema
+	}
+	out := map[string]interface{}{"type": "object", "properties": props}
+	if len(required) > 0 {
+		out["required"] = required
+	}
+	return out
+}
+
+func applyRules(schema map[string]interface{}, t reflect.Type, rules string) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	required := false
+	for _, rule := range strings.Split(rules, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch name {
+		case "required":
+			required = true
+		case "min", "max", "len":
+			n, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				continue
+			}
+			for _, key := range limitKeys(t, name) {
+				schema[key] = n
+			}
+		case "oneof":
+			opts := strings.Fields(param)
+			enum := make([]interface{}, len(opts))
+			for i, o := range opts {
+				enum[i] = o
+				if schema["type"] == "integer" || schema["type"] == "number" {
+					if n, err := strconv.ParseFloat(o, 64); err == nil {
+						enum[i] = n
+					}
+				}
+			}
+			schema["enum"] = enum
+		case "email":
+			schema["format"] = "email"
+		case "url":
+			schema["format"] = "uri"
+		}
+	}
+	return required
+}
+
+func limitKeys(t reflect.Type, rule string) []string {
+	var lo, hi string
+	switch t.Kind() {
+	case reflect.String:
+		lo, hi = "minLength", "maxLength"
+	case reflect.Slice, reflect.Array:
+		lo, hi = "minItems", "maxItems"
+	case reflect.Map:
+		lo, hi = "minProperties", "maxProperties"
+	default:
+		lo, hi = "minimum", "maximum"
+	}
+	switch rule {
+	case "min":
+		return []string{lo}
+	case "max":
+		return []string{hi}
+	}
+	return []string{lo, hi}
+}
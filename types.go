@@ -1,11 +1,22 @@
 package birdactyl
 
-import "encoding/json"
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+)
 
 type Event struct {
-	Type string
-	Data map[string]string
-	Sync bool
+	Type      string
+	Data      map[string]string
+	Sync      bool
+	Seq       uint64
+	Replayed  bool
+	RequestID string
+	ctx       context.Context
+	flags     FlagSnapshot
+	plugin    *Plugin
 }
 
 type EventResult struct {
@@ -21,14 +32,50 @@ func Block(message string) EventResult {
 	return EventResult{allow: false, message: message}
 }
 
+func (r EventResult) Allowed() bool {
+	return r.allow
+}
+
+func (r EventResult) Message() string {
+	return r.message
+}
+
 type Request struct {
-	Method  string
-	Path    string
-	Headers map[string]string
-	Query   map[string]string
-	Body    map[string]interface{}
-	RawBody []byte
-	UserID  string
+	Method         string
+	Path           string
+	Headers        map[string]string
+	Query          map[string]string
+	Body           map[string]interface{}
+	RawBody        []byte
+	UserID         string
+	RequestID      string
+	CallerPluginID string
+	route          string
+	params         map[string]string
+	rateLimit      rateLimitState
+	flags          FlagSnapshot
+	plugin         *Plugin
+	stream         *bodyStream
+	values         *requestValues
+	ctx            context.Context
+}
+
+func (r Request) BodyReader() io.Reader {
+	if r.stream != nil {
+		return r.stream
+	}
+	return bytes.NewReader(r.RawBody)
+}
+
+type Sched struct {
+	ID          string
+	Cron        string
+	RequestID   string
+	ctx         context.Context
+	flags       FlagSnapshot
+	plugin      *Plugin
+	catchUp     bool
+	scheduledAt time.Time
 }
 
 type Response struct {
@@ -38,12 +85,12 @@ type Response struct {
 }
 
 func JSON(data interface{}) Response {
-	b, _ := json.Marshal(map[string]interface{}{"success": true, "data": data})
+	b := marshalJSON(map[string]interface{}{"success": true, "data": data})
 	return Response{Status: 200, Headers: map[string]string{"Content-Type": "application/json"}, body: b}
 }
 
 func Error(status int, msg string) Response {
-	b, _ := json.Marshal(map[string]interface{}{"success": false, "error": msg})
+	b := marshalJSON(map[string]interface{}{"success": false, "error": msg})
 	return Response{Status: status, Headers: map[string]string{"Content-Type": "application/json"}, body: b}
 }
 
@@ -51,16 +98,31 @@ func Text(text string) Response {
 	return Response{Status: 200, Headers: map[string]string{"Content-Type": "text/plain"}, body: []byte(text)}
 }
 
+func (r Response) Body() []byte {
+	return r.body
+}
+
+func (r Response) Size() int {
+	return len(r.body)
+}
+
+func (r Response) WithBody(b []byte) Response {
+	r.body = b
+	return r
+}
+
 func (r Response) WithStatus(status int) Response {
 	r.Status = status
 	return r
 }
 
 func (r Response) WithHeader(key, value string) Response {
-	if r.Headers == nil {
-		r.Headers = make(map[string]string)
+	headers := make(map[string]string, len(r.Headers)+1)
+	for k, v := range r.Headers {
+		headers[k] = v
 	}
-	r.Headers[key] = value
+	headers[key] = value
+	r.Headers = headers
 	return r
 }
 
@@ -73,6 +135,10 @@ type AddonTypeRequest struct {
 	InstallPath     string
 	SourceInfo      map[string]string
 	ServerVariables map[string]string
+	RequestID       string
+	ctx             context.Context
+	flags           FlagSnapshot
+	plugin          *Plugin
 }
 
 type AddonTypeResponse struct {
@@ -91,18 +157,20 @@ type AddonInstallAction struct {
 	Headers      map[string]string
 	NodePayload  []byte
 	NodeEndpoint string
+	Conditions   []ActionCondition
+	reader       io.Reader
 }
 
 type AddonActionType int32
 
 const (
-	ActionDownloadFile  AddonActionType = 0
+	ActionDownloadFile   AddonActionType = 0
 	ActionExtractArchive AddonActionType = 1
-	ActionDeleteFile    AddonActionType = 2
-	ActionCreateFolder  AddonActionType = 3
-	ActionWriteFile     AddonActionType = 4
-	ActionRunCommand    AddonActionType = 5
-	ActionProxyToNode   AddonActionType = 6
+	ActionDeleteFile     AddonActionType = 2
+	ActionCreateFolder   AddonActionType = 3
+	ActionWriteFile      AddonActionType = 4
+	ActionRunCommand     AddonActionType = 5
+	ActionProxyToNode    AddonActionType = 6
 )
 
 func AddonSuccess(message string, actions ...AddonInstallAction) AddonTypeResponse {
@@ -136,3 +204,46 @@ func WriteFile(path string, content []byte) AddonInstallAction {
 func ProxyToNode(endpoint string, payload []byte) AddonInstallAction {
 	return AddonInstallAction{Type: ActionProxyToNode, NodeEndpoint: endpoint, NodePayload: payload}
 }
+
+func (e Event) Flags() FlagSnapshot {
+	return e.flags
+}
+
+func (r Request) Flags() FlagSnapshot {
+	return r.flags
+}
+
+func (r Request) Param(name string) string {
+	return r.params[name]
+}
+
+func (s Sched) Flags() FlagSnapshot {
+	return s.flags
+}
+
+func (r AddonTypeRequest) Flags() FlagSnapshot {
+	return r.flags
+}
+
+func (e Event) Context() context.Context {
+	return orBackground(e.ctx)
+}
+
+func (r Request) Context() context.Context {
+	return orBackground(r.ctx)
+}
+
+func (s Sched) Context() context.Context {
+	return orBackground(s.ctx)
+}
+
+func (r AddonTypeRequest) Context() context.Context {
+	return orBackground(r.ctx)
+}
+
+func orBackground(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
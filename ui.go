@@ -2,13 +2,27 @@ package birdactyl
 
 import (
 	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
 
 	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/protobuf/proto"
 )
 
 type UIBuilder struct {
+	mu           sync.Mutex
+	bundleMu     sync.RWMutex
 	hasBundle    bool
 	bundleData   []byte
+	assets       []*pb.PluginUIAsset
+	devDir       string
+	locales      map[string]map[string]string
 	pages        []*pb.PluginUIPage
 	tabs         []*pb.PluginUITab
 	sidebarItems []*pb.PluginUISidebarItem
@@ -23,27 +37,116 @@ func newUIBuilder() *UIBuilder {
 }
 
 func (u *UIBuilder) HasBundle() *UIBuilder {
+	u.bundleMu.Lock()
 	u.hasBundle = true
+	u.bundleMu.Unlock()
 	return u
 }
 
-func (u *UIBuilder) EmbedBundle(fs embed.FS, path string) *UIBuilder {
-	data, err := fs.ReadFile(path)
-	if err == nil {
-		u.bundleData = data
-		u.hasBundle = true
+func (u *UIBuilder) EmbedBundle(fsys embed.FS, file string) *UIBuilder {
+	if err := u.EmbedBundleE(fsys, file); err != nil {
+		log.Printf("[UI] %v", err)
 	}
 	return u
 }
 
+func (u *UIBuilder) EmbedBundleE(fsys embed.FS, file string) error {
+	data, err := fsys.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to embed bundle %s: %w", file, err)
+	}
+	u.bundleMu.Lock()
+	u.bundleData = data
+	u.hasBundle = true
+	u.bundleMu.Unlock()
+	return nil
+}
+
+func (u *UIBuilder) MustEmbedBundle(fsys embed.FS, file string) *UIBuilder {
+	if err := u.EmbedBundleE(fsys, file); err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func (u *UIBuilder) EmbedBundleDir(fsys embed.FS, dir string) error {
+	assets, err := readAssets(fsys, dir)
+	if err != nil {
+		return fmt.Errorf("failed to embed bundle dir %s: %w", dir, err)
+	}
+	u.setAssets(assets)
+	return nil
+}
+
+func (u *UIBuilder) DevBundleDir(dir string) *UIBuilder {
+	u.devDir = dir
+	return u
+}
+
+func (u *UIBuilder) setAssets(assets []*pb.PluginUIAsset) {
+	var data []byte
+	for _, a := range assets {
+		if a.Path == "index.js" {
+			data = a.Data
+		}
+	}
+	u.bundleMu.Lock()
+	u.assets = assets
+	u.bundleData = data
+	u.hasBundle = true
+	u.bundleMu.Unlock()
+}
+
+func (u *UIBuilder) bundle() ([]byte, []*pb.PluginUIAsset) {
+	u.bundleMu.RLock()
+	defer u.bundleMu.RUnlock()
+	return u.bundleData, u.assets
+}
+
+func readAssets(fsys fs.FS, dir string) ([]*pb.PluginUIAsset, error) {
+	var assets []*pb.PluginUIAsset
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		rel := p
+		if dir != "." {
+			rel = strings.TrimPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+		}
+		assets = append(assets, &pb.PluginUIAsset{Path: rel, ContentType: contentType(rel, data), Data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(assets) == 0 {
+		return nil, fmt.Errorf("no files found")
+	}
+	return assets, nil
+}
+
+func contentType(name string, data []byte) string {
+	if ct := mime.TypeByExtension(path.Ext(name)); ct != "" {
+		return ct
+	}
+	return http.DetectContentType(data)
+}
+
 func (u *UIBuilder) BundleBytes(data []byte) *UIBuilder {
+	u.bundleMu.Lock()
 	u.bundleData = data
 	u.hasBundle = true
+	u.bundleMu.Unlock()
 	return u
 }
 
 func (u *UIBuilder) GetBundleData() []byte {
-	return u.bundleData
+	data, _ := u.bundle()
+	return data
 }
 
 func (u *UIBuilder) Page(path, component string) *UIPageBuilder {
@@ -51,7 +154,9 @@ func (u *UIBuilder) Page(path, component string) *UIPageBuilder {
 		Path:      path,
 		Component: component,
 	}
+	u.mu.Lock()
 	u.pages = append(u.pages, page)
+	u.mu.Unlock()
 	return &UIPageBuilder{page: page, ui: u}
 }
 
@@ -62,7 +167,9 @@ func (u *UIBuilder) Tab(id, component, target, label string) *UITabBuilder {
 		Target:    target,
 		Label:     label,
 	}
+	u.mu.Lock()
 	u.tabs = append(u.tabs, tab)
+	u.mu.Unlock()
 	return &UITabBuilder{tab: tab, ui: u}
 }
 
@@ -73,18 +180,146 @@ func (u *UIBuilder) SidebarItem(id, label, href, section string) *UISidebarBuild
 		Href:    href,
 		Section: section,
 	}
+	u.mu.Lock()
 	u.sidebarItems = append(u.sidebarItems, item)
+	u.mu.Unlock()
 	return &UISidebarBuilder{item: item, ui: u}
 }
 
 func (u *UIBuilder) build() *pb.PluginUIInfo {
+	u.bundleMu.RLock()
+	hasBundle, data, assets := u.hasBundle, u.bundleData, u.assets
+	u.bundleMu.RUnlock()
+	u.mu.Lock()
+	defer u.mu.Unlock()
 	return &pb.PluginUIInfo{
-		HasBundle:    u.hasBundle,
-		Pages:        u.pages,
-		Tabs:         u.tabs,
-		SidebarItems: u.sidebarItems,
-		BundleData:   u.bundleData,
+		HasBundle:    hasBundle,
+		Pages:        cloneAll(u.pages),
+		Tabs:         cloneAll(u.tabs),
+		SidebarItems: cloneAll(u.sidebarItems),
+		BundleData:   data,
+		Assets:       assets,
+		BundleHash:   bundleHash(data, assets),
+		Locales:      u.buildLocales(),
+	}
+}
+
+func cloneAll[T proto.Message](in []T) []T {
+	out := make([]T, len(in))
+	for i, m := range in {
+		out[i] = proto.Clone(m).(T)
 	}
+	return out
+}
+
+func (u *UIBuilder) Build() *pb.PluginUIInfo {
+	return proto.Clone(u.build()).(*pb.PluginUIInfo)
+}
+
+type PageInfo struct {
+	Path      string
+	Component string
+	Title     string
+	Icon      string
+	Guard     string
+	HasForm   bool
+}
+
+type TabInfo struct {
+	ID         string
+	Component  string
+	Target     string
+	Label      string
+	Icon       string
+	Order      int
+	BadgeRoute string
+}
+
+type SidebarItemInfo struct {
+	ID         string
+	Label      string
+	Icon       string
+	Href       string
+	Section    string
+	Order      int
+	Guard      string
+	Permission string
+	Children   []SidebarChildInfo
+}
+
+type SidebarChildInfo struct {
+	Label string
+	Href  string
+}
+
+func (u *UIBuilder) Pages() []PageInfo {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	out := make([]PageInfo, len(u.pages))
+	for i, p := range u.pages {
+		out[i] = PageInfo{Path: p.Path, Component: p.Component, Title: p.Title, Icon: p.Icon, Guard: p.Guard, HasForm: p.Form != nil}
+	}
+	return out
+}
+
+func (u *UIBuilder) Tabs() []TabInfo {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	out := make([]TabInfo, len(u.tabs))
+	for i, t := range u.tabs {
+		out[i] = TabInfo{ID: t.Id, Component: t.Component, Target: t.Target, Label: t.Label, Icon: t.Icon, Order: int(t.Order), BadgeRoute: t.GetBadge().GetRoute()}
+	}
+	return out
+}
+
+func (u *UIBuilder) SidebarItems() []SidebarItemInfo {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	out := make([]SidebarItemInfo, len(u.sidebarItems))
+	for i, s := range u.sidebarItems {
+		children := make([]SidebarChildInfo, len(s.Children))
+		for j, c := range s.Children {
+			children[j] = SidebarChildInfo{Label: c.Label, Href: c.Href}
+		}
+		out[i] = SidebarItemInfo{ID: s.Id, Label: s.Label, Icon: s.Icon, Href: s.Href, Section: s.Section, Order: int(s.Order), Guard: s.Guard, Permission: s.Permission, Children: children}
+	}
+	return out
+}
+
+func (u *UIBuilder) RemovePage(path string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for i, p := range u.pages {
+		if p.Path == path {
+			u.pages = append(u.pages[:i], u.pages[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (u *UIBuilder) RemoveTab(id string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for i, t := range u.tabs {
+		if t.Id == id {
+			u.tabs = append(u.tabs[:i], u.tabs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (u *UIBuilder) RemoveSidebarItem(id string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for i, s := range u.sidebarItems {
+		if s.Id == id {
+			u.sidebarItems = append(u.sidebarItems[:i], u.sidebarItems[i+1:]...)
+			return true
+		}
+	}
+	return false
 }
 
 type UIPageBuilder struct {
@@ -93,22 +328,30 @@ type UIPageBuilder struct {
 }
 
 func (p *UIPageBuilder) Title(title string) *UIPageBuilder {
+	p.ui.mu.Lock()
 	p.page.Title = title
+	p.ui.mu.Unlock()
 	return p
 }
 
 func (p *UIPageBuilder) Icon(icon string) *UIPageBuilder {
+	p.ui.mu.Lock()
 	p.page.Icon = icon
+	p.ui.mu.Unlock()
 	return p
 }
 
 func (p *UIPageBuilder) AdminOnly() *UIPageBuilder {
+	p.ui.mu.Lock()
 	p.page.Guard = "admin"
+	p.ui.mu.Unlock()
 	return p
 }
 
 func (p *UIPageBuilder) Guard(guard string) *UIPageBuilder {
+	p.ui.mu.Lock()
 	p.page.Guard = guard
+	p.ui.mu.Unlock()
 	return p
 }
 
@@ -122,12 +365,36 @@ type UITabBuilder struct {
 }
 
 func (t *UITabBuilder) Icon(icon string) *UITabBuilder {
+	t.ui.mu.Lock()
 	t.tab.Icon = icon
+	t.ui.mu.Unlock()
 	return t
 }
 
 func (t *UITabBuilder) Order(order int) *UITabBuilder {
+	t.ui.mu.Lock()
 	t.tab.Order = int32(order)
+	t.ui.mu.Unlock()
+	return t
+}
+
+func (t *UITabBuilder) Badge(sourceRoute string) *UITabBuilder {
+	t.ui.mu.Lock()
+	if t.tab.Badge == nil {
+		t.tab.Badge = &pb.PluginUITabBadge{}
+	}
+	t.tab.Badge.Route = sourceRoute
+	t.ui.mu.Unlock()
+	return t
+}
+
+func (t *UITabBuilder) BadgeColor(color string) *UITabBuilder {
+	t.ui.mu.Lock()
+	if t.tab.Badge == nil {
+		t.tab.Badge = &pb.PluginUITabBadge{}
+	}
+	t.tab.Badge.Color = color
+	t.ui.mu.Unlock()
 	return t
 }
 
@@ -141,25 +408,33 @@ type UISidebarBuilder struct {
 }
 
 func (s *UISidebarBuilder) Icon(icon string) *UISidebarBuilder {
+	s.ui.mu.Lock()
 	s.item.Icon = icon
+	s.ui.mu.Unlock()
 	return s
 }
 
 func (s *UISidebarBuilder) Order(order int) *UISidebarBuilder {
+	s.ui.mu.Lock()
 	s.item.Order = int32(order)
+	s.ui.mu.Unlock()
 	return s
 }
 
 func (s *UISidebarBuilder) AdminOnly() *UISidebarBuilder {
+	s.ui.mu.Lock()
 	s.item.Guard = "admin"
+	s.ui.mu.Unlock()
 	return s
 }
 
 func (s *UISidebarBuilder) Child(label, href string) *UISidebarBuilder {
+	s.ui.mu.Lock()
 	s.item.Children = append(s.item.Children, &pb.PluginUISidebarChild{
 		Label: label,
 		Href:  href,
 	})
+	s.ui.mu.Unlock()
 	return s
 }
 
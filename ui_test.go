@@ -0,0 +1,44 @@
+package birdactyl
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestUIBuildReturnsCopies(t *testing.T) {
+	u := newUIBuilder()
+	page := u.Page("/a", "A").Title("before")
+	info := u.build()
+	page.Title("after")
+	u.Page("/b", "B")
+	if len(info.Pages) != 1 || info.Pages[0].Title != "before" {
+		t.Fatalf("built pages changed after build: %v", info.Pages)
+	}
+}
+
+func TestUIBuilderConcurrentUse(t *testing.T) {
+	p := New("ui", "1.0.0")
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			path := fmt.Sprintf("/p%d", i)
+			p.ui.Page(path, "Page").Title("title").Form().Text("name", "Name").Submit("POST", path)
+			p.ui.SidebarItem(path, "Item", path, SidebarSectionNav).Child("child", path)
+			p.ui.RemovePage(path)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			p.ui.build()
+			p.regMu.RLock()
+			p.ui.Validate()
+			p.validateUIRoutes()
+			p.regMu.RUnlock()
+		}
+	}()
+	wg.Wait()
+}
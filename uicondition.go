@@ -0,0 +1,67 @@
+package birdactyl
+
+import pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+
+type UICondition struct {
+	Type  string
+	Key   string
+	Op    string
+	Value string
+}
+
+type VariableCondition struct {
+	name string
+}
+
+func Variable(name string) VariableCondition {
+	return VariableCondition{name: name}
+}
+
+func (v VariableCondition) Equals(value string) UICondition {
+	return UICondition{Type: "variable", Key: v.name, Op: "eq", Value: value}
+}
+
+func (v VariableCondition) NotEquals(value string) UICondition {
+	return UICondition{Type: "variable", Key: v.name, Op: "ne", Value: value}
+}
+
+func (v VariableCondition) Matches(pattern string) UICondition {
+	return UICondition{Type: "variable", Key: v.name, Op: "matches", Value: pattern}
+}
+
+func FeatureFlag(name string) UICondition {
+	return UICondition{Type: "flag", Key: name, Op: "enabled"}
+}
+
+func NamedGuard(name string) UICondition {
+	return UICondition{Type: "guard", Key: name}
+}
+
+func (c UICondition) proto() *pb.PluginUICondition {
+	return &pb.PluginUICondition{Type: c.Type, Key: c.Key, Op: c.Op, Value: c.Value}
+}
+
+func (t *UITabBuilder) VisibleWhen(conditions ...UICondition) *UITabBuilder {
+	t.ui.mu.Lock()
+	for _, c := range conditions {
+		t.tab.VisibleWhen = append(t.tab.VisibleWhen, c.proto())
+	}
+	t.ui.mu.Unlock()
+	return t
+}
+
+func (s *UISidebarBuilder) VisibleWhen(conditions ...UICondition) *UISidebarBuilder {
+	s.ui.mu.Lock()
+	for _, c := range conditions {
+		s.item.VisibleWhen = append(s.item.VisibleWhen, c.proto())
+	}
+	s.ui.mu.Unlock()
+	return s
+}
+
+func (s *UISidebarBuilder) Permission(perm string) *UISidebarBuilder {
+	s.ui.mu.Lock()
+	s.item.Permission = perm
+	s.ui.mu.Unlock()
+	return s
+}
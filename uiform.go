@@ -0,0 +1,140 @@
+package birdactyl
+
+import (
+	"fmt"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	FieldText   = "text"
+	FieldSelect = "select"
+	FieldToggle = "toggle"
+	FieldNumber = "number"
+)
+
+type UIFormBuilder struct {
+	form  *pb.PluginUIForm
+	field *pb.PluginUIFormField
+	page  *UIPageBuilder
+}
+
+func (p *UIPageBuilder) Form() *UIFormBuilder {
+	p.ui.mu.Lock()
+	if p.page.Form == nil {
+		p.page.Form = &pb.PluginUIForm{SubmitMethod: "POST"}
+	}
+	p.ui.mu.Unlock()
+	return &UIFormBuilder{form: p.page.Form, page: p}
+}
+
+func (f *UIFormBuilder) add(fieldType, name, label string) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.field = &pb.PluginUIFormField{Type: fieldType, Name: name, Label: label}
+	f.form.Fields = append(f.form.Fields, f.field)
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Text(name, label string) *UIFormBuilder {
+	return f.add(FieldText, name, label)
+}
+
+func (f *UIFormBuilder) Select(name, label string, options []string) *UIFormBuilder {
+	f.add(FieldSelect, name, label)
+	f.page.ui.mu.Lock()
+	f.field.Options = options
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Toggle(name, label string) *UIFormBuilder {
+	return f.add(FieldToggle, name, label)
+}
+
+func (f *UIFormBuilder) Number(name, label string) *UIFormBuilder {
+	return f.add(FieldNumber, name, label)
+}
+
+func (f *UIFormBuilder) current(method string) *pb.PluginUIFormField {
+	if f.field == nil {
+		panic(fmt.Sprintf("birdactyl: form %s() called before any field was added", method))
+	}
+	return f.field
+}
+
+func (f *UIFormBuilder) Required() *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.current("Required").Required = true
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Secret() *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.current("Secret").Secret = true
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Min(min float64) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	field := f.current("Min")
+	field.HasMin = true
+	field.Min = min
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Max(max float64) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	field := f.current("Max")
+	field.HasMax = true
+	field.Max = max
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Default(value string) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.current("Default").DefaultValue = value
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Placeholder(text string) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.current("Placeholder").Placeholder = text
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Description(text string) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.current("Description").Description = text
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Submit(method, path string) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.form.SubmitMethod = method
+	f.form.SubmitPath = path
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) SubmitLabel(label string) *UIFormBuilder {
+	f.page.ui.mu.Lock()
+	f.form.SubmitLabel = label
+	f.page.ui.mu.Unlock()
+	return f
+}
+
+func (f *UIFormBuilder) Page() *UIPageBuilder {
+	return f.page
+}
+
+func (f *UIFormBuilder) Done() *UIBuilder {
+	return f.page.ui
+}
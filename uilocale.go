@@ -0,0 +1,89 @@
+package birdactyl
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"sort"
+	"strings"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const I18nPrefix = "i18n:"
+
+func I18n(key string) string {
+	return I18nPrefix + key
+}
+
+func (u *UIBuilder) Locale(lang string, entries map[string]string) *UIBuilder {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.locales == nil {
+		u.locales = make(map[string]map[string]string)
+	}
+	if u.locales[lang] == nil {
+		u.locales[lang] = make(map[string]string)
+	}
+	for k, v := range entries {
+		u.locales[lang][k] = v
+	}
+	return u
+}
+
+func (u *UIBuilder) LocaleFS(fsys embed.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return fmt.Errorf("failed to read locale dir %s: %w", dir, err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".json" {
+			continue
+		}
+		data, err := fsys.ReadFile(path.Join(dir, e.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read locale %s: %w", e.Name(), err)
+		}
+		var raw map[string]interface{}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("failed to parse locale %s: %w", e.Name(), err)
+		}
+		flat := make(map[string]string)
+		flattenLocale("", raw, flat)
+		u.Locale(strings.TrimSuffix(e.Name(), ".json"), flat)
+	}
+	return nil
+}
+
+func flattenLocale(prefix string, in map[string]interface{}, out map[string]string) {
+	for k, v := range in {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case map[string]interface{}:
+			flattenLocale(key, val, out)
+		case string:
+			out[key] = val
+		default:
+			out[key] = fmt.Sprint(val)
+		}
+	}
+}
+
+func (u *UIBuilder) buildLocales() []*pb.PluginUILocale {
+	langs := make([]string, 0, len(u.locales))
+	for lang := range u.locales {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	out := make([]*pb.PluginUILocale, 0, len(langs))
+	for _, lang := range langs {
+		out = append(out, &pb.PluginUILocale{Lang: lang, Strings: maps.Clone(u.locales[lang])})
+	}
+	return out
+}
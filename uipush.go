@@ -0,0 +1,63 @@
+package birdactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+)
+
+const (
+	capUIPush = "ui.push"
+
+	DefaultUIPushRate    = 10
+	DefaultUIPushBurst   = 20
+	DefaultUIPushMaxSize = 64 << 10
+)
+
+var uiChannelPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+func WithUIPushLimit(perSecond float64, burst int) Option {
+	return func(p *Plugin) {
+		p.uiPush = newUIPushLimiter(perSecond, burst)
+	}
+}
+
+func WithUIPushMaxSize(bytes int) Option {
+	return func(p *Plugin) {
+		p.uiPushMax = bytes
+	}
+}
+
+func newUIPushLimiter(perSecond float64, burst int) *routeLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &routeLimiter{rate: perSecond, burst: float64(max(burst, 1)), buckets: make(map[string]*bucket)}
+}
+
+func (p *Plugin) UIPush(channel string, payload interface{}) error {
+	if !uiChannelPattern.MatchString(channel) {
+		return fmt.Errorf("%w: invalid ui channel %q", ErrInvalidArgument, channel)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if p.uiPushMax > 0 && len(data) > p.uiPushMax {
+		return fmt.Errorf("%w: ui push payload is %d bytes, limit is %d", ErrValueTooLarge, len(data), p.uiPushMax)
+	}
+	if p.ConnectionState() != StateConnected {
+		return ErrUnavailable
+	}
+	if !p.PanelHasCapability(capUIPush) {
+		return fmt.Errorf("%w: ui push", ErrUnsupported)
+	}
+	if p.uiPush != nil {
+		if _, ok := p.uiPush.take(channel, p.clock().Now()); !ok {
+			return fmt.Errorf("%w: ui channel %q", ErrRateLimited, channel)
+		}
+	}
+	return p.send(&pb.PluginMessage{Payload: &pb.PluginMessage_UiPush{UiPush: &pb.UIPush{Channel: channel, Payload: data}}})
+}
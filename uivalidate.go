@@ -0,0 +1,147 @@
+package birdactyl
+
+import (
+	"fmt"
+	"strings"
+)
+
+type UIValidationError struct {
+	Problems []string
+}
+
+func (e *UIValidationError) Error() string {
+	return "invalid UI registration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+var knownTabTargets = map[string]bool{
+	TabTargetServer:       true,
+	TabTargetUserSettings: true,
+}
+
+func (u *UIBuilder) Validate() error {
+	var problems []string
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	pages := make(map[string]bool)
+	for i, page := range u.pages {
+		if page.Path == "" {
+			add("page #%d has an empty path", i+1)
+		} else if pages[page.Path] {
+			add("duplicate page path %q", page.Path)
+		}
+		pages[page.Path] = true
+		if page.Component == "" && page.Form == nil {
+			add("page %q has neither a component nor a form", page.Path)
+		}
+		if page.Form != nil {
+			if page.Form.SubmitPath == "" {
+				add("form on page %q has no submit route", page.Path)
+			}
+			fields := make(map[string]bool)
+			for _, f := range page.Form.Fields {
+				if f.Name == "" {
+					add("form on page %q has a %s field with an empty name", page.Path, f.Type)
+				} else if fields[f.Name] {
+					add("form on page %q has duplicate field %q", page.Path, f.Name)
+				}
+				fields[f.Name] = true
+				if f.Type == FieldSelect && len(f.Options) == 0 {
+					add("select field %q on page %q has no options", f.Name, page.Path)
+				}
+			}
+		}
+		u.checkLabel(page.Title, "page "+page.Path, add)
+	}
+
+	tabs := make(map[string]bool)
+	for i, tab := range u.tabs {
+		if tab.Id == "" {
+			add("tab #%d has an empty id", i+1)
+		} else if tabs[tab.Id] {
+			add("duplicate tab id %q", tab.Id)
+		}
+		tabs[tab.Id] = true
+		if tab.Component == "" {
+			add("tab %q has an empty component", tab.Id)
+		}
+		if tab.Label == "" {
+			add("tab %q has an empty label", tab.Id)
+		}
+		if !knownTabTargets[tab.Target] {
+			add("tab %q references unknown target %q", tab.Id, tab.Target)
+		}
+		u.checkLabel(tab.Label, "tab "+tab.Id, add)
+	}
+
+	items := make(map[string]bool)
+	for i, item := range u.sidebarItems {
+		if item.Id == "" {
+			add("sidebar item #%d has an empty id", i+1)
+		} else if items[item.Id] {
+			add("duplicate sidebar item id %q", item.Id)
+		}
+		items[item.Id] = true
+		if item.Label == "" {
+			add("sidebar item %q has an empty label", item.Id)
+		}
+		if item.Href == "" {
+			add("sidebar item %q has an empty href", item.Id)
+		}
+		for _, child := range item.Children {
+			if child.Href == "" {
+				add("sidebar item %q has a child %q with an empty href", item.Id, child.Label)
+			}
+		}
+		u.checkLabel(item.Label, "sidebar item "+item.Id, add)
+	}
+
+	if len(problems) > 0 {
+		return &UIValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func (u *UIBuilder) checkLabel(label, owner string, add func(string, ...interface{})) {
+	if !strings.HasPrefix(label, I18nPrefix) || len(u.locales) == 0 {
+		return
+	}
+	key := strings.TrimPrefix(label, I18nPrefix)
+	for _, strs := range u.locales {
+		if _, ok := strs[key]; ok {
+			return
+		}
+	}
+	add("%s uses translation key %q that no locale defines", owner, key)
+}
+
+func (p *Plugin) validateUIRoutes() error {
+	var problems []string
+	p.ui.mu.Lock()
+	defer p.ui.mu.Unlock()
+	for _, tab := range p.ui.tabs {
+		if tab.Badge == nil {
+			continue
+		}
+		if tab.Badge.Route == "" {
+			problems = append(problems, fmt.Sprintf("badge on tab %q has no source route", tab.Id))
+		} else if p.findRouteLocked("GET", tab.Badge.Route) == nil {
+			problems = append(problems, fmt.Sprintf("badge on tab %q references unregistered route GET %s", tab.Id, tab.Badge.Route))
+		}
+	}
+	for _, page := range p.ui.pages {
+		if page.Form == nil || page.Form.SubmitPath == "" {
+			continue
+		}
+		if p.findRouteLocked(page.Form.SubmitMethod, page.Form.SubmitPath) == nil {
+			problems = append(problems, fmt.Sprintf("form on page %q submits to unregistered route %s %s", page.Path, page.Form.SubmitMethod, page.Form.SubmitPath))
+		}
+	}
+	if len(problems) > 0 {
+		return &UIValidationError{Problems: problems}
+	}
+	return nil
+}
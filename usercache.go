@@ -0,0 +1,163 @@
+package birdactyl
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultUserCacheTTL  = 30 * time.Second
+	DefaultUserCacheSize = 1000
+
+	EventUserUpdated = "user.updated"
+	EventUserDeleted = "user.deleted"
+)
+
+type UserCacheMetrics struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+type userCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	max      int
+	gen      uint64
+	lru      *list.List
+	entries  map[string]*list.Element
+	inflight map[string]*userFetch
+	stats    UserCacheMetrics
+}
+
+type userEntry struct {
+	id      string
+	user    User
+	expires time.Time
+}
+
+type userFetch struct {
+	done chan struct{}
+	user User
+	err  error
+}
+
+func WithUserCache(ttl time.Duration, maxEntries int) Option {
+	return func(p *Plugin) {
+		p.users.ttl = ttl
+		p.users.max = maxEntries
+	}
+}
+
+func (r Request) User(ctx context.Context) (*User, error) {
+	if r.UserID == "" {
+		return nil, fmt.Errorf("%w: request has no user", ErrNotFound)
+	}
+	if r.plugin == nil {
+		return nil, ErrUnavailable
+	}
+	return r.plugin.cachedUser(orBackground(ctx), r.UserID)
+}
+
+func (p *Plugin) InvalidateUser(id string) *Plugin {
+	c := &p.users
+	c.mu.Lock()
+	c.gen++
+	if el, ok := c.entries[id]; ok {
+		c.lru.Remove(el)
+		delete(c.entries, id)
+	}
+	delete(c.inflight, id)
+	c.mu.Unlock()
+	return p
+}
+
+func (p *Plugin) cachedUser(ctx context.Context, id string) (*User, error) {
+	c := &p.users
+	if c.ttl <= 0 || c.max <= 0 {
+		return p.api.User(ctx, id)
+	}
+	now := p.clock().Now()
+
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+		c.inflight = make(map[string]*userFetch)
+		c.lru = list.New()
+	}
+	if el, ok := c.entries[id]; ok {
+		e := el.Value.(*userEntry)
+		if now.Before(e.expires) {
+			c.lru.MoveToFront(el)
+			c.stats.Hits++
+			u := e.user
+			c.mu.Unlock()
+			return &u, nil
+		}
+		c.lru.Remove(el)
+		delete(c.entries, id)
+	}
+	c.stats.Misses++
+	gen := c.gen
+	f, waiting := c.inflight[id]
+	if !waiting {
+		f = &userFetch{done: make(chan struct{})}
+		c.inflight[id] = f
+	}
+	c.mu.Unlock()
+
+	if waiting {
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if code := status.Code(f.err); code == codes.Canceled || code == codes.DeadlineExceeded {
+			return p.api.User(ctx, id)
+		}
+		if f.err != nil {
+			return nil, f.err
+		}
+		u := f.user
+		return &u, nil
+	}
+
+	u, err := p.api.User(ctx, id)
+	if err == nil {
+		f.user = *u
+	}
+	f.err = err
+
+	c.mu.Lock()
+	if c.inflight[id] == f {
+		delete(c.inflight, id)
+	}
+	if err == nil && gen == c.gen {
+		if el, ok := c.entries[id]; ok {
+			c.lru.Remove(el)
+		}
+		c.entries[id] = c.lru.PushFront(&userEntry{id: id, user: *u, expires: now.Add(c.ttl)})
+		for c.lru.Len() > c.max {
+			delete(c.entries, c.lru.Remove(c.lru.Back()).(*userEntry).id)
+			c.stats.Evictions++
+		}
+	}
+	c.mu.Unlock()
+	close(f.done)
+	return u, err
+}
+
+func (c *userCache) metrics() UserCacheMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m := c.stats
+	m.Entries = len(c.entries)
+	return m
+}
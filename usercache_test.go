@@ -0,0 +1,65 @@
+package birdactyl
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/grpc"
+)
+
+type userPanel struct {
+	pb.PanelServiceClient
+	mu      sync.Mutex
+	name    string
+	calls   int
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (u *userPanel) GetUser(ctx context.Context, req *pb.IDRequest, opts ...grpc.CallOption) (*pb.User, error) {
+	u.mu.Lock()
+	u.calls++
+	first, name := u.calls == 1, u.name
+	u.mu.Unlock()
+	if first {
+		close(u.entered)
+		<-u.release
+	}
+	return &pb.User{Id: req.Id, Username: name}, nil
+}
+
+func (u *userPanel) rename(name string) {
+	u.mu.Lock()
+	u.name = name
+	u.mu.Unlock()
+}
+
+func TestInvalidateUserDuringFetch(t *testing.T) {
+	panel := &userPanel{name: "old", entered: make(chan struct{}), release: make(chan struct{})}
+	p := New("users", "1.0.0", WithUserCache(time.Minute, 10))
+	p.api = &API{panel: panel}
+
+	done := make(chan *User, 1)
+	go func() {
+		u, _ := p.cachedUser(context.Background(), "u1")
+		done <- u
+	}()
+	<-panel.entered
+	panel.rename("new")
+	p.InvalidateUser("u1")
+	close(panel.release)
+	if u := <-done; u == nil || u.Username != "old" {
+		t.Fatalf("in-flight fetch = %+v, want the old user", u)
+	}
+
+	u, err := p.cachedUser(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("cachedUser: %v", err)
+	}
+	if u.Username != "new" {
+		t.Fatalf("Username = %q after invalidation, want new", u.Username)
+	}
+}
@@ -0,0 +1,111 @@
+package birdactyl
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var validMethods = map[string]bool{
+	"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true, "HEAD": true, "OPTIONS": true, "*": true,
+}
+
+func (p *Plugin) Validate() []error {
+	p.regMu.RLock()
+	defer p.regMu.RUnlock()
+
+	errs := append([]error(nil), p.regErrs...)
+	errs = append(errs, p.validateGRPC()...)
+	add := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if p.id == "" {
+		add("plugin id is empty")
+	}
+	if err := p.validateInstance(); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, key := range sortedKeys(p.events) {
+		if key == "" {
+			add("event handler registered for an empty event type")
+		}
+		if p.events[key] == nil {
+			add("event %q has a nil handler", key)
+		}
+	}
+
+	for _, key := range sortedKeys(p.routes) {
+		cfg := p.routes[key]
+		if !validMethods[cfg.Method] {
+			add("route %s %s: unknown method %q", cfg.Method, cfg.Path, cfg.Method)
+		}
+		if !strings.HasPrefix(cfg.Path, "/") {
+			add("route %s %s: path must start with /", cfg.Method, cfg.Path)
+		}
+		if cfg.Handler == nil {
+			add("route %s %s has a nil handler", cfg.Method, cfg.Path)
+		}
+		if cfg.RateLimitRPM < 0 || cfg.RateLimitBurst < 0 {
+			add("route %s %s: rate limits must not be negative", cfg.Method, cfg.Path)
+		}
+	}
+
+	for _, id := range sortedKeys(p.schedules) {
+		cfg := p.schedules[id]
+		if id == "" {
+			add("schedule with cron %q has an empty id", cfg.Cron)
+		}
+		if _, err := parseCron(cfg.Cron); err != nil {
+			add("schedule %q: %v", id, err)
+		}
+		if cfg.Handler == nil {
+			add("schedule %q has a nil handler", id)
+		}
+	}
+
+	mixins := make(map[string]bool)
+	for _, m := range p.mixins {
+		if m.Target == "" {
+			add("mixin registered for an empty target")
+		} else if mixins[m.Target] {
+			add("mixin target %q registered twice", m.Target)
+		}
+		mixins[m.Target] = true
+		if m.Handler == nil {
+			add("mixin %q has a nil handler", m.Target)
+		}
+	}
+
+	for _, key := range sortedKeys(p.addonTypes) {
+		if key == "" {
+			add("addon type registered with an empty id")
+		}
+		if p.addonTypes[key] == nil {
+			add("addon type %q has a nil handler", key)
+		}
+	}
+
+	for _, err := range []error{p.ui.Validate(), p.validateUIRoutes()} {
+		var uerr *UIValidationError
+		if errors.As(err, &uerr) {
+			for _, problem := range uerr.Problems {
+				add("ui: %s", problem)
+			}
+		} else if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,78 @@
+package birdactyl_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+)
+
+func ok(r birdactyl.Request) birdactyl.Response {
+	return birdactyl.JSON(nil)
+}
+
+func TestValidateConcurrentRegistration(t *testing.T) {
+	p := birdactyl.New("validate", "1.0.0")
+	p.Route("GET", "/badge", ok)
+	p.UI().Tab("stats", "Stats", birdactyl.TabTargetServer, "Stats").Badge("/badge")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var wg sync.WaitGroup
+		for w := 0; w < 4; w++ {
+			wg.Add(2)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					p.Route("GET", fmt.Sprintf("/r/%d/%d", w, i), ok)
+				}
+			}(w)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					if errs := p.Validate(); len(errs) > 0 {
+						t.Errorf("unexpected validation errors: %v", errs)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Validate deadlocked against concurrent route registration")
+	}
+}
+
+func TestValidateUIRouteReferences(t *testing.T) {
+	p := birdactyl.New("validate", "1.0.0")
+	p.UI().Tab("stats", "Stats", birdactyl.TabTargetServer, "Stats").Badge("/missing")
+
+	errs := p.Validate()
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "unregistered route GET /missing") {
+		t.Fatalf("Validate() = %v, want unregistered badge route", errs)
+	}
+
+	p.Route("GET", "/missing", ok)
+	if errs := p.Validate(); len(errs) > 0 {
+		t.Fatalf("Validate() after registering route = %v", errs)
+	}
+}
+
+func TestRateLimitScopeRejectsUnknownScope(t *testing.T) {
+	p := birdactyl.New("validate", "1.0.0")
+	p.Route("GET", "/limited", ok).RateLimit(60, 10).RateLimitScope("tenant")
+	p.Route("GET", "/user", ok).RateLimit(60, 10).RateLimitScope(birdactyl.ScopeUser)
+
+	errs := p.Validate()
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), `unknown rate limit scope "tenant"`) {
+		t.Fatalf("Validate() = %v, want one unknown scope error", errs)
+	}
+}
@@ -0,0 +1,276 @@
+package birdactyl
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWebhookSignatureHeader = "X-Birdactyl-Signature"
+	defaultWebhookWorkers         = 4
+	defaultWebhookQueue           = 256
+	defaultWebhookRetries         = 5
+	defaultWebhookRate            = 5
+	webhookBaseBackoff            = 500 * time.Millisecond
+	webhookMaxBackoff             = 30 * time.Second
+	webhookShutdownFlush          = 10 * time.Second
+)
+
+var errWebhookThrottled = errors.New("birdactyl: webhook rate limited")
+
+type WebhookOption func(*webhookJob)
+
+func WebhookSecret(secret string) WebhookOption {
+	return func(j *webhookJob) { j.secret = secret }
+}
+
+func WebhookSignatureHeader(name string) WebhookOption {
+	return func(j *webhookJob) { j.sigHeader = name }
+}
+
+func WebhookHeader(key, value string) WebhookOption {
+	return func(j *webhookJob) { j.headers.Set(key, value) }
+}
+
+func WebhookRetries(n int) WebhookOption {
+	return func(j *webhookJob) { j.retries = n }
+}
+
+type WebhookFailure struct {
+	URL      string
+	Payload  []byte
+	Attempts int
+	Err      error
+}
+
+type webhookJob struct {
+	url       string
+	body      []byte
+	secret    string
+	sigHeader string
+	headers   http.Header
+	retries   int
+}
+
+type Webhooks struct {
+	plugin     *Plugin
+	client     *http.Client
+	queue      chan *webhookJob
+	inflight   sync.WaitGroup
+	start      sync.Once
+	mu         sync.Mutex
+	draining   bool
+	next       map[string]time.Time
+	interval   time.Duration
+	deadLetter func(WebhookFailure)
+}
+
+func (p *Plugin) Webhooks() *Webhooks {
+	p.webhooksOnce.Do(func() {
+		p.webhooks = &Webhooks{
+			plugin:   p,
+			client:   &http.Client{Transport: p.HTTPClient().Transport, Timeout: 15 * time.Second},
+			queue:    make(chan *webhookJob, defaultWebhookQueue),
+			next:     make(map[string]time.Time),
+			interval: time.Second / defaultWebhookRate,
+		}
+	})
+	return p.webhooks
+}
+
+func (w *Webhooks) OnDeadLetter(fn func(WebhookFailure)) *Webhooks {
+	w.deadLetter = fn
+	return w
+}
+
+func (w *Webhooks) RateLimit(perSecond int) *Webhooks {
+	if perSecond > 0 {
+		w.interval = time.Second / time.Duration(perSecond)
+	} else {
+		w.interval = 0
+	}
+	return w
+}
+
+func (w *Webhooks) Send(ctx context.Context, target string, payload interface{}, opts ...WebhookOption) error {
+	body, ok := payload.([]byte)
+	if !ok {
+		var err error
+		if body, err = json.Marshal(payload); err != nil {
+			return err
+		}
+	}
+	if _, err := url.ParseRequestURI(target); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	job := &webhookJob{url: target, body: body, sigHeader: DefaultWebhookSignatureHeader, headers: http.Header{}, retries: defaultWebhookRetries}
+	for _, opt := range opts {
+		opt(job)
+	}
+
+	w.start.Do(func() {
+		for i := 0; i < defaultWebhookWorkers; i++ {
+			go w.worker()
+		}
+	})
+
+	w.mu.Lock()
+	if w.draining {
+		w.mu.Unlock()
+		return fmt.Errorf("%w: webhooks are shutting down", ErrUnavailable)
+	}
+	w.inflight.Add(1)
+	w.mu.Unlock()
+	select {
+	case w.queue <- job:
+		return nil
+	case <-ctx.Done():
+		w.inflight.Done()
+		return ctx.Err()
+	default:
+		w.inflight.Done()
+		return ErrQueueFull
+	}
+}
+
+func (w *Webhooks) Flush() {
+	w.mu.Lock()
+	w.draining = true
+	w.mu.Unlock()
+	w.inflight.Wait()
+}
+
+func (w *Webhooks) flushTimeout(d time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		w.Flush()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-w.plugin.clock().NewTimer(d).C():
+		return false
+	}
+}
+
+func (w *Webhooks) worker() {
+	for job := range w.queue {
+		w.deliver(job)
+		w.inflight.Done()
+	}
+}
+
+func (w *Webhooks) deliver(job *webhookJob) {
+	var err error
+	attempt := 0
+	for ; attempt <= job.retries; attempt++ {
+		if attempt > 0 && !errors.Is(err, errWebhookThrottled) {
+			sleep(w.plugin.clock(), min(webhookBaseBackoff<<(attempt-1), webhookMaxBackoff))
+		}
+		w.wait(job.url)
+		var retry bool
+		retry, err = w.post(job)
+		if err == nil {
+			return
+		}
+		if !retry {
+			attempt++
+			break
+		}
+	}
+	w.plugin.printf(LevelWarn, "webhook to %s failed after %d attempts: %v", job.url, attempt, err)
+	if w.deadLetter != nil {
+		w.deadLetter(WebhookFailure{URL: job.url, Payload: job.body, Attempts: attempt, Err: err})
+	}
+}
+
+func (w *Webhooks) post(job *webhookJob) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, job.url, bytes.NewReader(job.body))
+	if err != nil {
+		return false, err
+	}
+	for k, v := range job.headers {
+		req.Header[k] = v
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if job.secret != "" {
+		mac := hmac.New(sha256.New, []byte(job.secret))
+		mac.Write(job.body)
+		req.Header.Set(job.sigHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests:
+		w.throttle(job.url, retryAfter(resp.Header.Get("Retry-After"), w.plugin.clock().Now()))
+		return true, fmt.Errorf("%w: webhook returned %d", errWebhookThrottled, resp.StatusCode)
+	case resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook returned %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("webhook returned %d", resp.StatusCode)
+	}
+}
+
+func webhookHost(target string) string {
+	if u, err := url.Parse(target); err == nil {
+		return u.Host
+	}
+	return target
+}
+
+func retryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return webhookBaseBackoff
+	}
+	if secs, err := strconv.ParseFloat(header, 64); err == nil {
+		return min(time.Duration(secs*float64(time.Second)), webhookMaxBackoff)
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return min(max(at.Sub(now), 0), webhookMaxBackoff)
+	}
+	return webhookBaseBackoff
+}
+
+func (w *Webhooks) throttle(target string, d time.Duration) {
+	host := webhookHost(target)
+	w.mu.Lock()
+	if at := w.plugin.clock().Now().Add(d); at.After(w.next[host]) {
+		w.next[host] = at
+	}
+	w.mu.Unlock()
+}
+
+func (w *Webhooks) wait(target string) {
+	host := webhookHost(target)
+	w.mu.Lock()
+	now := w.plugin.clock().Now()
+	at := w.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	w.next[host] = at.Add(w.interval)
+	w.mu.Unlock()
+	sleep(w.plugin.clock(), at.Sub(now))
+}
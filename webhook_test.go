@@ -0,0 +1,52 @@
+package birdactyl_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	birdactyl "github.com/Birdactyl/Birdactyl-Go-SDK"
+)
+
+func TestWebhookFlushRejectsNewSends(t *testing.T) {
+	var received atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+	}))
+	defer srv.Close()
+
+	hooks := birdactyl.New("webhooks", "1.0.0").Webhooks().RateLimit(0)
+	var accepted atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 25; j++ {
+				err := hooks.Send(context.Background(), srv.URL, map[string]int{"n": j})
+				switch {
+				case err == nil:
+					accepted.Add(1)
+				case errors.Is(err, birdactyl.ErrUnavailable):
+					return
+				case !errors.Is(err, birdactyl.ErrQueueFull):
+					t.Errorf("Send: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	hooks.Flush()
+	wg.Wait()
+
+	if got, want := received.Load(), accepted.Load(); got != want {
+		t.Fatalf("delivered %d webhooks, accepted %d", got, want)
+	}
+	if err := hooks.Send(context.Background(), srv.URL, "late"); !errors.Is(err, birdactyl.ErrUnavailable) {
+		t.Fatalf("Send after Flush = %v, want ErrUnavailable", err)
+	}
+}
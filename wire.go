@@ -0,0 +1,203 @@
+package birdactyl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/Birdactyl/Birdactyl-Go-SDK/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	wireEnv       = "BIRDACTYL_DEBUG_WIRE"
+	wireBodyLimit = 512
+	wireBlobLimit = 64
+)
+
+var (
+	wireSensitive = regexp.MustCompile(`(?i)secret|password|passwd|token|authorization|cookie|api[_-]?key`)
+	wireBlobKeys  = map[string]bool{"bundleData": true, "data": true, "content": true, "nodePayload": true, "payload": true}
+
+	wireSecretMethods = map[string]bool{
+		"ListDatabases":          true,
+		"CreateDatabase":         true,
+		"RotateDatabasePassword": true,
+		"CreateDatabaseHost":     true,
+		"UpdateDatabaseHost":     true,
+	}
+)
+
+type wireLogger struct {
+	mu      sync.Mutex
+	w       io.Writer
+	starts  map[string]time.Time
+	secrets map[string]bool
+}
+
+type wireEntry struct {
+	Time       string  `json:"ts"`
+	Dir        string  `json:"dir"`
+	Type       string  `json:"type"`
+	RequestID  string  `json:"request_id,omitempty"`
+	Size       int     `json:"size"`
+	DurationMS float64 `json:"duration_ms,omitempty"`
+	Body       string  `json:"body,omitempty"`
+}
+
+func WithWireLogging(w io.Writer) Option {
+	return func(p *Plugin) {
+		p.wire = newWireLogger(w)
+	}
+}
+
+func newWireLogger(w io.Writer) *wireLogger {
+	return &wireLogger{w: w, starts: make(map[string]time.Time), secrets: make(map[string]bool)}
+}
+
+func (p *Plugin) setupWireLogging() {
+	if p.wire == nil && os.Getenv(wireEnv) == "1" {
+		p.wire = newWireLogger(os.Stderr)
+	}
+}
+
+func (l *wireLogger) in(msg *pb.PanelMessage) {
+	if l != nil {
+		l.log("in", msg, msg.RequestId)
+	}
+}
+
+func (l *wireLogger) out(msg *pb.PluginMessage) {
+	if l != nil {
+		l.log("out", msg, msg.RequestId)
+	}
+}
+
+func (l *wireLogger) log(dir string, msg proto.Message, requestID string) {
+	now := time.Now()
+	entry := wireEntry{Time: now.UTC().Format(time.RFC3339Nano), Dir: dir, RequestID: requestID, Size: proto.Size(msg), Type: payloadName(msg)}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.secret(msg, requestID) {
+		entry.Body = "[redacted]"
+	} else if fd := payloadField(msg); fd != nil {
+		entry.Body = wireBody(msg.ProtoReflect().Get(fd).Message().Interface())
+	}
+	if requestID != "" {
+		if start, ok := l.starts[requestID]; ok {
+			entry.DurationMS = float64(now.Sub(start).Microseconds()) / 1000
+			delete(l.starts, requestID)
+		} else {
+			l.starts[requestID] = now
+		}
+	}
+	enc := json.NewEncoder(l.w)
+	enc.SetEscapeHTML(false)
+	enc.Encode(entry)
+}
+
+func (l *wireLogger) secret(msg proto.Message, requestID string) bool {
+	switch m := msg.(type) {
+	case *pb.PluginMessage:
+		calls := m.GetApiBatch().GetCalls()
+		if c := m.GetApiCall(); c != nil {
+			calls = append(calls, c)
+		}
+		for _, c := range calls {
+			if wireSecretMethods[c.Method[strings.LastIndex(c.Method, "/")+1:]] {
+				l.secrets[requestID] = true
+				return true
+			}
+		}
+	case *pb.PanelMessage:
+		if m.GetApiResult() != nil || m.GetApiBatchResult() != nil {
+			secret := l.secrets[requestID]
+			delete(l.secrets, requestID)
+			return secret
+		}
+	}
+	return false
+}
+
+func payloadField(msg proto.Message) protoreflect.FieldDescriptor {
+	m := msg.ProtoReflect()
+	if oneof := m.Descriptor().Oneofs().ByName("payload"); oneof != nil {
+		return m.WhichOneof(oneof)
+	}
+	return nil
+}
+
+func payloadName(msg proto.Message) string {
+	if fd := payloadField(msg); fd != nil {
+		return string(fd.Name())
+	}
+	return "unknown"
+}
+
+func wireBody(msg proto.Message) string {
+	raw, err := protojson.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(wireRedact("", v))
+	out := bytes.TrimSpace(buf.Bytes())
+	if len(out) > wireBodyLimit {
+		return string(out[:wireBodyLimit]) + "...(" + strconv.Itoa(len(out)) + " bytes)"
+	}
+	return string(out)
+}
+
+func wireRedact(key string, v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			if wireSensitive.MatchString(k) {
+				val[k] = "[redacted]"
+				continue
+			}
+			val[k] = wireRedact(k, child)
+		}
+		if name, ok := val["key"].(string); ok && wireSensitive.MatchString(name) {
+			if _, ok := val["value"]; ok {
+				val["value"] = "[redacted]"
+			}
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = wireRedact(key, child)
+		}
+		return val
+	case string:
+		if wireBlobKeys[key] && len(val) > wireBlobLimit {
+			if b, err := base64.StdEncoding.DecodeString(val); err == nil {
+				return "<" + strconv.Itoa(len(b)) + " bytes elided>"
+			}
+		}
+		if key == "body" || key == "input" || key == "output" {
+			if b, err := base64.StdEncoding.DecodeString(val); err == nil && utf8.Valid(b) {
+				return string(b)
+			}
+		}
+		return val
+	}
+	return v
+}